@@ -0,0 +1,7 @@
+package main
+
+import "github.com/danielmesquitta/tasks-api/internal/app/purge"
+
+func main() {
+	purge.Start()
+}
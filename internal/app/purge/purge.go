@@ -0,0 +1,89 @@
+package purge
+
+import (
+	"context"
+	"log"
+
+	"go.uber.org/fx"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/tenant"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/mysqlrepo"
+)
+
+// Start permanently deletes the tasks kept in the trash for longer
+// than the retention period set in the environment, in every
+// organization, then exits.
+func Start() {
+	depsProvider := fx.Provide(
+		// Config
+		config.LoadEnv,
+
+		// PKGs
+		fx.Annotate(
+			validator.NewValidate,
+			fx.As(new(validator.Validator)),
+		),
+
+		// Providers
+		mysqlrepo.NewMySQLDBConn,
+		mysqlrepo.NewMySQLQueries,
+		fx.Annotate(
+			mysqlrepo.NewMySQLTaskRepo,
+			fx.As(new(repo.TaskRepo)),
+		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLOrganizationRepo,
+			fx.As(new(repo.OrganizationRepo)),
+		),
+
+		// Use cases
+		usecase.NewPurgeDeletedTasks,
+	)
+
+	var (
+		env                      *config.Env
+		organizationRepo         repo.OrganizationRepo
+		purgeDeletedTasksUseCase *usecase.PurgeDeletedTasks
+	)
+
+	container := fx.New(
+		depsProvider,
+		fx.NopLogger,
+		fx.Populate(&env, &organizationRepo, &purgeDeletedTasksUseCase),
+	)
+	if err := container.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	organizations, err := organizationRepo.ListOrganizations(
+		context.Background(),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, organization := range organizations {
+		ctx := tenant.WithOrganizationID(context.Background(), organization.ID)
+
+		count, err := purgeDeletedTasksUseCase.Execute(
+			ctx,
+			usecase.PurgeDeletedTasksParams{
+				RetentionPeriod: env.TaskTrashRetention,
+			},
+		)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		log.Printf(
+			"purged %d tasks of %s deleted more than %s ago",
+			count,
+			organization.Name,
+			env.TaskTrashRetention,
+		)
+	}
+}
@@ -0,0 +1,7 @@
+package dto
+
+import "github.com/danielmesquitta/tasks-api/internal/domain/entity"
+
+type ListAttachmentsResponseDTO struct {
+	Data []entity.Attachment `json:"data"`
+}
@@ -0,0 +1,26 @@
+package dto
+
+type BulkTaskItemDTO struct {
+	TaskID           string   `json:"task_id,omitempty"`
+	Summary          string   `json:"summary,omitempty"`
+	AssignedToUserID string   `json:"assigned_to_user_id,omitempty"`
+	LabelIDs         []string `json:"label_ids,omitempty"`
+}
+
+type BulkTasksRequestDTO struct {
+	// Mode is either all_or_nothing (default) or best_effort.
+	Mode  string            `json:"mode,omitempty"`
+	Items []BulkTaskItemDTO `json:"items,omitempty"`
+}
+
+type BulkTaskItemResultDTO struct {
+	Index  int    `json:"index"`
+	TaskID string `json:"task_id,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+type BulkTasksResponseDTO struct {
+	Succeeded int                     `json:"succeeded"`
+	Failed    int                     `json:"failed"`
+	Results   []BulkTaskItemResultDTO `json:"results"`
+}
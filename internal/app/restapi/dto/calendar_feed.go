@@ -0,0 +1,7 @@
+package dto
+
+type CreateCalendarFeedTokenResponseDTO struct {
+	Token string `json:"token"`
+	// URL is the address of the feed to subscribe to in calendar apps.
+	URL string `json:"url"`
+}
@@ -0,0 +1,12 @@
+package dto
+
+type CreateChecklistItemRequestDTO struct {
+	Title    string `json:"title,omitempty"`
+	Required bool   `json:"required,omitempty"`
+}
+
+type UpdateChecklistItemRequestDTO struct {
+	Title    *string `json:"title,omitempty"`
+	Required *bool   `json:"required,omitempty"`
+	Done     *bool   `json:"done,omitempty"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+import "github.com/danielmesquitta/tasks-api/internal/domain/entity"
+
+type CreateCommentRequestDTO struct {
+	Body string `json:"body,omitempty"`
+}
+
+type ListCommentsResponseDTO struct {
+	Data []entity.Comment `json:"data"`
+}
@@ -0,0 +1,9 @@
+package dto
+
+type CreateLabelRequestDTO struct {
+	Name string `json:"name,omitempty"`
+}
+
+type UpdateLabelRequestDTO struct {
+	Name string `json:"name,omitempty"`
+}
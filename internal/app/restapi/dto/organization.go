@@ -0,0 +1,5 @@
+package dto
+
+type CreateOrganizationRequestDTO struct {
+	Name string `json:"name,omitempty"`
+}
@@ -0,0 +1,16 @@
+package dto
+
+type CreateProjectRequestDTO struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type UpdateProjectRequestDTO struct {
+	Name          string `json:"name,omitempty"`
+	Description   string `json:"description,omitempty"`
+	ManagerUserID string `json:"manager_user_id,omitempty"`
+}
+
+type AddProjectMemberRequestDTO struct {
+	UserID string `json:"user_id,omitempty"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+type CreateRecurringTaskRequestDTO struct {
+	Summary          string `json:"summary,omitempty"`
+	Schedule         string `json:"schedule,omitempty"`
+	AssignedToUserID string `json:"assigned_to_user_id,omitempty"`
+}
@@ -0,0 +1,12 @@
+package dto
+
+import "time"
+
+type TaskReportRequestDTO struct {
+	GroupBy string    `query:"group_by"`
+	Bucket  string    `query:"bucket"`
+	From    time.Time `query:"from"`
+	To      time.Time `query:"to"`
+	// Format is either json, the default, or csv.
+	Format string `query:"format"`
+}
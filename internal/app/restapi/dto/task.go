@@ -1,6 +1,97 @@
 package dto
 
+import (
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+)
+
 type CreateTaskRequestDTO struct {
 	Summary          string `json:"summary,omitempty"`
 	AssignedToUserID string `json:"assigned_to_user_id,omitempty"`
+	// AssigneeIDs are assigned after AssignedToUserID, if both are given.
+	AssigneeIDs []string   `json:"assignee_ids,omitempty"`
+	LabelIDs    []string   `json:"label_ids,omitempty"`
+	DueAt       *time.Time `json:"due_at,omitempty"`
+	// Priority defaults to normal.
+	Priority entity.TaskPriority `json:"priority,omitempty"`
+	// FinishPolicy defaults to any, finishing the task as soon as one of
+	// the assignees finishes it, while all waits for every assignee.
+	FinishPolicy entity.TaskFinishPolicy `json:"finish_policy,omitempty"`
+	// ProjectID adds the task to a project the user is a member of.
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+type UpdateTaskRequestDTO struct {
+	Summary string `json:"summary,omitempty"`
+	// AssignedToUserID makes the user the only assignee of the task.
+	AssignedToUserID *string `json:"assigned_to_user_id,omitempty"`
+	// AssigneeIDs replaces the task assignees when given, an empty list
+	// unassigns the task.
+	AssigneeIDs []string `json:"assignee_ids,omitempty"`
+	// LabelIDs replaces the task labels when given, an empty list clears
+	// them.
+	LabelIDs []string `json:"label_ids,omitempty"`
+	// DueAt replaces the task due date when given, and ClearDueAt
+	// removes it instead.
+	DueAt        *time.Time              `json:"due_at,omitempty"`
+	ClearDueAt   bool                    `json:"clear_due_at,omitempty"`
+	Priority     entity.TaskPriority     `json:"priority,omitempty"`
+	FinishPolicy entity.TaskFinishPolicy `json:"finish_policy,omitempty"`
+	// ProjectID moves the task to another project the user is a member
+	// of when given.
+	ProjectID *string `json:"project_id,omitempty"`
+}
+
+// PatchTaskRequestDTO only changes the given fields of the task, keeping
+// the summary when it is left out.
+type PatchTaskRequestDTO struct {
+	Summary          *string                 `json:"summary,omitempty"`
+	AssignedToUserID *string                 `json:"assigned_to_user_id,omitempty"`
+	AssigneeIDs      []string                `json:"assignee_ids,omitempty"`
+	LabelIDs         []string                `json:"label_ids,omitempty"`
+	DueAt            *time.Time              `json:"due_at,omitempty"`
+	ClearDueAt       bool                    `json:"clear_due_at,omitempty"`
+	Priority         entity.TaskPriority     `json:"priority,omitempty"`
+	FinishPolicy     entity.TaskFinishPolicy `json:"finish_policy,omitempty"`
+	ProjectID        *string                 `json:"project_id,omitempty"`
+}
+
+type ListTasksRequestDTO struct {
+	Status          string     `query:"status"`
+	CreatedByUserID string     `query:"created_by_user_id"`
+	Unassigned      bool       `query:"unassigned"`
+	CreatedFrom     *time.Time `query:"created_from"`
+	CreatedTo       *time.Time `query:"created_to"`
+	FinishedFrom    *time.Time `query:"finished_from"`
+	FinishedTo      *time.Time `query:"finished_to"`
+	DueFrom         *time.Time `query:"due_from"`
+	DueTo           *time.Time `query:"due_to"`
+	Overdue         bool       `query:"overdue"`
+	Priorities      []string   `query:"priorities"`
+	LabelIDs        []string   `query:"label_ids"`
+	LabelMatch      string     `query:"label_match"`
+	ProjectID       string     `query:"project_id"`
+	SortBy          string     `query:"sort_by"`
+	SortDirection   string     `query:"sort_direction"`
+	Limit           int        `query:"limit"`
+	Cursor          string     `query:"cursor"`
+}
+
+type ListTasksResponseDTO struct {
+	Data       []entity.Task `json:"data"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+	PrevCursor string        `json:"prev_cursor,omitempty"`
+}
+
+type TransitionTaskRequestDTO struct {
+	Status entity.TaskStatus `json:"status,omitempty"`
+}
+
+type ReopenTaskRequestDTO struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+type TaskHistoryResponseDTO struct {
+	Data []entity.TaskEvent `json:"data"`
 }
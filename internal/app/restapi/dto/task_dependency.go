@@ -0,0 +1,11 @@
+package dto
+
+import "github.com/danielmesquitta/tasks-api/internal/domain/entity"
+
+type AddTaskDependencyRequestDTO struct {
+	BlockedByTaskID string `json:"blocked_by_task_id,omitempty"`
+}
+
+type ListTaskBlockersResponseDTO struct {
+	Data []entity.Task `json:"data"`
+}
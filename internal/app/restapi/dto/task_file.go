@@ -0,0 +1,23 @@
+package dto
+
+import "time"
+
+type ExportTasksRequestDTO struct {
+	Format       string     `query:"format"`
+	Status       string     `query:"status"`
+	CreatedFrom  *time.Time `query:"created_from"`
+	CreatedTo    *time.Time `query:"created_to"`
+	FinishedFrom *time.Time `query:"finished_from"`
+	FinishedTo   *time.Time `query:"finished_to"`
+}
+
+type ImportTaskLineErrorDTO struct {
+	Line  int    `json:"line"`
+	Error string `json:"error"`
+}
+
+type ImportTasksResponseDTO struct {
+	Imported int                      `json:"imported"`
+	Failed   int                      `json:"failed"`
+	Errors   []ImportTaskLineErrorDTO `json:"errors"`
+}
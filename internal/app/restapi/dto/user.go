@@ -7,4 +7,6 @@ type CreateUserRequestDTO struct {
 	Role     entity.Role `json:"role"`
 	Email    string      `json:"email"`
 	Password string      `json:"password"`
+	// OrganizationID is the organization the user belongs to.
+	OrganizationID string `json:"organization_id"`
 }
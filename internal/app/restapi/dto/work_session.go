@@ -0,0 +1,31 @@
+package dto
+
+import "time"
+
+type StartWorkSessionRequestDTO struct {
+	Note *string `json:"note,omitempty"`
+}
+
+type CreateWorkSessionRequestDTO struct {
+	StartedAt time.Time `json:"started_at,omitempty"`
+	EndedAt   time.Time `json:"ended_at,omitempty"`
+	Note      *string   `json:"note,omitempty"`
+}
+
+type UpdateWorkSessionRequestDTO struct {
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// EndedAt stops the timer of a running work session.
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// Note replaces the work session note when given, an empty note
+	// clears it.
+	Note *string `json:"note,omitempty"`
+}
+
+type SetWorkSessionsLockRequestDTO struct {
+	Locked bool `json:"locked"`
+}
+
+type ListWorkTimeTotalsRequestDTO struct {
+	From time.Time `query:"from"`
+	To   time.Time `query:"to"`
+}
@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"mime"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/danielmesquitta/tasks-api/internal/app/restapi/dto"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+)
+
+type AttachmentHandler struct {
+	uploadAttachmentUseCase   *usecase.UploadAttachment
+	downloadAttachmentUseCase *usecase.DownloadAttachment
+	listAttachmentsUseCase    *usecase.ListAttachments
+}
+
+func NewAttachmentHandler(
+	uploadAttachmentUseCase *usecase.UploadAttachment,
+	downloadAttachmentUseCase *usecase.DownloadAttachment,
+	listAttachmentsUseCase *usecase.ListAttachments,
+) *AttachmentHandler {
+	return &AttachmentHandler{
+		uploadAttachmentUseCase:   uploadAttachmentUseCase,
+		downloadAttachmentUseCase: downloadAttachmentUseCase,
+		listAttachmentsUseCase:    listAttachmentsUseCase,
+	}
+}
+
+// @Summary Upload attachment
+// @Description Attach a file to a task (only managers or the assigned technician can attach files). Accepts JPEG, PNG, GIF, WebP and PDF files up to 10 MiB.
+// @Tags Attachments
+// @Security BearerAuth
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param file formData file true "File to attach"
+// @Success 201 {object} entity.Attachment
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/attachments [post]
+func (h *AttachmentHandler) Upload(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return validationErr
+	}
+
+	if fileHeader.Size > usecase.MaxAttachmentSize {
+		return entity.ErrAttachmentTooLarge
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return entity.NewErr(err)
+	}
+	defer file.Close()
+
+	attachment, err := h.uploadAttachmentUseCase.Execute(
+		c.Request().Context(),
+		usecase.UploadAttachmentParams{
+			TaskID:   c.Param("id"),
+			UserID:   claims.Issuer,
+			UserRole: claims.Role,
+			FileName: fileHeader.Filename,
+			Content:  file,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusCreated, attachment)
+}
+
+// @Summary List attachments
+// @Description List the task attachments metadata, oldest first
+// @Tags Attachments
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} dto.ListAttachmentsResponseDTO
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/attachments [get]
+func (h *AttachmentHandler) List(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	attachments, err := h.listAttachmentsUseCase.Execute(
+		c.Request().Context(),
+		usecase.ListAttachmentsParams{
+			TaskID:   c.Param("id"),
+			UserID:   claims.Issuer,
+			UserRole: claims.Role,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		dto.ListAttachmentsResponseDTO{Data: attachments},
+	)
+}
+
+// @Summary Download attachment
+// @Description Download the content of a task attachment
+// @Tags Attachments
+// @Security BearerAuth
+// @Produce octet-stream
+// @Param id path string true "Task ID"
+// @Param attachment_id path string true "Attachment ID"
+// @Success 200 {file} binary
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/attachments/{attachment_id} [get]
+func (h *AttachmentHandler) Download(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	attachment, content, err := h.downloadAttachmentUseCase.Execute(
+		c.Request().Context(),
+		usecase.DownloadAttachmentParams{
+			TaskID:       c.Param("id"),
+			AttachmentID: c.Param("attachment_id"),
+			UserID:       claims.Issuer,
+			UserRole:     claims.Role,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	c.Response().Header().Set(
+		echo.HeaderContentDisposition,
+		mime.FormatMediaType(
+			"attachment",
+			map[string]string{"filename": attachment.FileName},
+		),
+	)
+
+	return c.Blob(http.StatusOK, attachment.ContentType, content)
+}
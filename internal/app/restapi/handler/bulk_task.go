@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/danielmesquitta/tasks-api/internal/app/restapi/dto"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+)
+
+type BulkTaskHandler struct {
+	bulkTasksUseCase *usecase.BulkTasks
+}
+
+func NewBulkTaskHandler(
+	bulkTasksUseCase *usecase.BulkTasks,
+) *BulkTaskHandler {
+	return &BulkTaskHandler{
+		bulkTasksUseCase: bulkTasksUseCase,
+	}
+}
+
+// @Summary Bulk create tasks
+// @Description Create up to 100 tasks (only managers can create tasks). In the all_or_nothing mode (default) no task is created if any item fails, in the best_effort mode the valid items are kept. Every item gets a result, in the same order as the request
+// @Tags Tasks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.BulkTasksRequestDTO true "Request body, items with summary, assigned_to_user_id and label_ids"
+// @Success 200 {object} dto.BulkTasksResponseDTO
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/bulk/create [post]
+func (h *BulkTaskHandler) Create(c echo.Context) error {
+	return h.execute(c, usecase.BulkTaskOperationCreate)
+}
+
+// @Summary Bulk reassign tasks
+// @Description Assign up to 100 tasks to technicians (only managers can reassign tasks). In the all_or_nothing mode (default) no task is changed if any item fails, in the best_effort mode the valid items are kept. Every item gets a result, in the same order as the request
+// @Tags Tasks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.BulkTasksRequestDTO true "Request body, items with task_id and assigned_to_user_id"
+// @Success 200 {object} dto.BulkTasksResponseDTO
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/bulk/reassign [post]
+func (h *BulkTaskHandler) Reassign(c echo.Context) error {
+	return h.execute(c, usecase.BulkTaskOperationReassign)
+}
+
+// @Summary Bulk finish tasks
+// @Description Move up to 100 tasks to done, following the same rules as the status transition. In the all_or_nothing mode (default) no task is changed if any item fails, in the best_effort mode the valid items are kept. Every item gets a result, in the same order as the request
+// @Tags Tasks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.BulkTasksRequestDTO true "Request body, items with task_id"
+// @Success 200 {object} dto.BulkTasksResponseDTO
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/bulk/finish [post]
+func (h *BulkTaskHandler) Finish(c echo.Context) error {
+	return h.execute(c, usecase.BulkTaskOperationFinish)
+}
+
+// @Summary Bulk delete tasks
+// @Description Move up to 100 tasks to the trash (only managers can delete tasks). In the all_or_nothing mode (default) no task is deleted if any item fails, in the best_effort mode the valid items are kept. Every item gets a result, in the same order as the request
+// @Tags Tasks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.BulkTasksRequestDTO true "Request body, items with task_id"
+// @Success 200 {object} dto.BulkTasksResponseDTO
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/bulk/delete [post]
+func (h *BulkTaskHandler) Delete(c echo.Context) error {
+	return h.execute(c, usecase.BulkTaskOperationDelete)
+}
+
+func (h *BulkTaskHandler) execute(
+	c echo.Context,
+	operation usecase.BulkTaskOperation,
+) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	params := dto.BulkTasksRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	items := make([]usecase.BulkTaskItem, len(params.Items))
+	for i, item := range params.Items {
+		items[i] = usecase.BulkTaskItem{
+			TaskID:           item.TaskID,
+			Summary:          item.Summary,
+			AssignedToUserID: item.AssignedToUserID,
+			LabelIDs:         item.LabelIDs,
+		}
+	}
+
+	result, err := h.bulkTasksUseCase.Execute(
+		c.Request().Context(),
+		usecase.BulkTasksParams{
+			UserID:    claims.Issuer,
+			UserRole:  claims.Role,
+			Operation: operation,
+			Mode:      usecase.BulkMode(params.Mode),
+			Items:     items,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	res := dto.BulkTasksResponseDTO{
+		Succeeded: result.Succeeded,
+		Failed:    result.Failed,
+		Results:   make([]dto.BulkTaskItemResultDTO, len(result.Results)),
+	}
+	for i, itemResult := range result.Results {
+		res.Results[i] = dto.BulkTaskItemResultDTO{
+			Index:  itemResult.Index,
+			TaskID: itemResult.TaskID,
+		}
+
+		if itemResult.Err != nil {
+			res.Results[i].Error = itemErrorMessage(
+				c,
+				itemResult.Err,
+				slog.Int("index", itemResult.Index),
+			)
+		}
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
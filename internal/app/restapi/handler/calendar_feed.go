@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/danielmesquitta/tasks-api/internal/app/restapi/dto"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+)
+
+type CalendarFeedHandler struct {
+	createTokenUseCase *usecase.CreateCalendarFeedToken
+	revokeTokenUseCase *usecase.RevokeCalendarFeedToken
+	getFeedUseCase     *usecase.GetCalendarFeed
+}
+
+func NewCalendarFeedHandler(
+	createTokenUseCase *usecase.CreateCalendarFeedToken,
+	revokeTokenUseCase *usecase.RevokeCalendarFeedToken,
+	getFeedUseCase *usecase.GetCalendarFeed,
+) *CalendarFeedHandler {
+	return &CalendarFeedHandler{
+		createTokenUseCase: createTokenUseCase,
+		revokeTokenUseCase: revokeTokenUseCase,
+		getFeedUseCase:     getFeedUseCase,
+	}
+}
+
+// @Summary Create calendar feed token
+// @Description Create the token of the user calendar feed, replacing the previous one, which stops working. The token is only shown once (only technicians can have a calendar feed)
+// @Tags Calendar feed
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 201 {object} dto.CreateCalendarFeedTokenResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /users/me/calendar-feed [post]
+func (h *CalendarFeedHandler) CreateToken(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	token, err := h.createTokenUseCase.Execute(
+		c.Request().Context(),
+		usecase.CreateCalendarFeedTokenParams{
+			UserID:   claims.Issuer,
+			UserRole: claims.Role,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	url := c.Scheme() + "://" + c.Request().Host +
+		c.Echo().Reverse("calendarFeed", token)
+
+	return c.JSON(http.StatusCreated, dto.CreateCalendarFeedTokenResponseDTO{
+		Token: token,
+		URL:   url,
+	})
+}
+
+// @Summary Revoke calendar feed token
+// @Description Revoke the token of the user calendar feed, if any
+// @Tags Calendar feed
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 204
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /users/me/calendar-feed [delete]
+func (h *CalendarFeedHandler) RevokeToken(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	err := h.revokeTokenUseCase.Execute(
+		c.Request().Context(),
+		usecase.RevokeCalendarFeedTokenParams{
+			UserID: claims.Issuer,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary Get calendar feed
+// @Description Get the iCalendar feed with the unfinished tasks assigned to the owner of the token that have a due date. It is authenticated by the token in the path, since calendar apps can not send bearer tokens
+// @Tags Calendar feed
+// @Produce text/calendar
+// @Param token path string true "Calendar feed token"
+// @Success 200 {file} binary
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /calendar-feeds/{token}/tasks.ics [get]
+func (h *CalendarFeedHandler) Get(c echo.Context) error {
+	feed, err := h.getFeedUseCase.Execute(
+		c.Request().Context(),
+		usecase.GetCalendarFeedParams{
+			Token: c.Param("token"),
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "private, no-cache")
+
+	return c.Blob(http.StatusOK, "text/calendar; charset=utf-8", feed)
+}
@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/danielmesquitta/tasks-api/internal/app/restapi/dto"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+)
+
+type ChecklistHandler struct {
+	createChecklistItemUseCase *usecase.CreateChecklistItem
+	updateChecklistItemUseCase *usecase.UpdateChecklistItem
+	deleteChecklistItemUseCase *usecase.DeleteChecklistItem
+	listChecklistItemsUseCase  *usecase.ListChecklistItems
+}
+
+func NewChecklistHandler(
+	createChecklistItemUseCase *usecase.CreateChecklistItem,
+	updateChecklistItemUseCase *usecase.UpdateChecklistItem,
+	deleteChecklistItemUseCase *usecase.DeleteChecklistItem,
+	listChecklistItemsUseCase *usecase.ListChecklistItems,
+) *ChecklistHandler {
+	return &ChecklistHandler{
+		createChecklistItemUseCase: createChecklistItemUseCase,
+		updateChecklistItemUseCase: updateChecklistItemUseCase,
+		deleteChecklistItemUseCase: deleteChecklistItemUseCase,
+		listChecklistItemsUseCase:  listChecklistItemsUseCase,
+	}
+}
+
+// @Summary Create checklist item
+// @Description Append an item to the task checklist (only managers or the assigned technician can edit the checklist). Required items must be done before the task can be finished.
+// @Tags Checklist
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body dto.CreateChecklistItemRequestDTO true "Request body"
+// @Success 201 {object} entity.ChecklistItem
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/checklist [post]
+func (h *ChecklistHandler) Create(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	params := dto.CreateChecklistItemRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	item, err := h.createChecklistItemUseCase.Execute(
+		c.Request().Context(),
+		usecase.CreateChecklistItemParams{
+			TaskID:   c.Param("id"),
+			UserID:   claims.Issuer,
+			UserRole: claims.Role,
+			Title:    params.Title,
+			Required: params.Required,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusCreated, item)
+}
+
+// @Summary List checklist items
+// @Description List the task checklist items ordered by position, with the checklist progress
+// @Tags Checklist
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} usecase.ListChecklistItemsResult
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/checklist [get]
+func (h *ChecklistHandler) List(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	result, err := h.listChecklistItemsUseCase.Execute(
+		c.Request().Context(),
+		usecase.ListChecklistItemsParams{
+			TaskID:   c.Param("id"),
+			UserID:   claims.Issuer,
+			UserRole: claims.Role,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// @Summary Update checklist item
+// @Description Rename, tick off or change whether a checklist item is required, only the given fields are changed
+// @Tags Checklist
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param item_id path string true "Checklist item ID"
+// @Param request body dto.UpdateChecklistItemRequestDTO true "Request body"
+// @Success 200 {object} entity.ChecklistItem
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/checklist/{item_id} [patch]
+func (h *ChecklistHandler) Update(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	params := dto.UpdateChecklistItemRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	item, err := h.updateChecklistItemUseCase.Execute(
+		c.Request().Context(),
+		usecase.UpdateChecklistItemParams{
+			TaskID:   c.Param("id"),
+			ItemID:   c.Param("item_id"),
+			UserID:   claims.Issuer,
+			UserRole: claims.Role,
+			Title:    params.Title,
+			Required: params.Required,
+			Done:     params.Done,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusOK, item)
+}
+
+// @Summary Delete checklist item
+// @Description Remove an item from the task checklist
+// @Tags Checklist
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param item_id path string true "Checklist item ID"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/checklist/{item_id} [delete]
+func (h *ChecklistHandler) Delete(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	err := h.deleteChecklistItemUseCase.Execute(
+		c.Request().Context(),
+		usecase.DeleteChecklistItemParams{
+			TaskID:   c.Param("id"),
+			ItemID:   c.Param("item_id"),
+			UserID:   claims.Issuer,
+			UserRole: claims.Role,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/danielmesquitta/tasks-api/internal/app/restapi/dto"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+)
+
+type CommentHandler struct {
+	createCommentUseCase *usecase.CreateComment
+	listCommentsUseCase  *usecase.ListComments
+}
+
+func NewCommentHandler(
+	createCommentUseCase *usecase.CreateComment,
+	listCommentsUseCase *usecase.ListComments,
+) *CommentHandler {
+	return &CommentHandler{
+		createCommentUseCase: createCommentUseCase,
+		listCommentsUseCase:  listCommentsUseCase,
+	}
+}
+
+// @Summary Create comment
+// @Description Comment on a task (only managers or the assigned technician can comment)
+// @Tags Comments
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body dto.CreateCommentRequestDTO true "Request body"
+// @Success 201
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/comments [post]
+func (h *CommentHandler) Create(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	params := dto.CreateCommentRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	useCaseParams := usecase.CreateCommentParams{
+		TaskID:   c.Param("id"),
+		UserID:   claims.Issuer,
+		UserRole: claims.Role,
+		Body:     params.Body,
+	}
+
+	err := h.createCommentUseCase.Execute(c.Request().Context(), useCaseParams)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.NoContent(http.StatusCreated)
+}
+
+// @Summary List comments
+// @Description List the task comments, oldest first
+// @Tags Comments
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} dto.ListCommentsResponseDTO
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/comments [get]
+func (h *CommentHandler) List(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	comments, err := h.listCommentsUseCase.Execute(
+		c.Request().Context(),
+		usecase.ListCommentsParams{
+			TaskID:   c.Param("id"),
+			UserID:   claims.Issuer,
+			UserRole: claims.Role,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusOK, dto.ListCommentsResponseDTO{Data: comments})
+}
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+)
+
+const (
+	headerETag    = "ETag"
+	headerIfMatch = "If-Match"
+)
+
+// taskETag formats the task version as a strong entity tag.
+func taskETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// ifMatchVersion parses the task version from the If-Match header, which
+// must hold a single entity tag returned by taskETag.
+func ifMatchVersion(c echo.Context) (int64, error) {
+	tag := strings.TrimSpace(c.Request().Header.Get(headerIfMatch))
+	tag = strings.TrimPrefix(tag, "W/")
+
+	value, err := strconv.Unquote(tag)
+	if err != nil {
+		return 0, entity.ErrTaskVersionRequired
+	}
+
+	version, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || version < 1 {
+		return 0, entity.ErrTaskVersionRequired
+	}
+
+	return version, nil
+}
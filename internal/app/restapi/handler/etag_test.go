@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/danielmesquitta/tasks-api/internal/app/restapi/middleware"
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestTaskHandler_ETag(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	managerID := uuid.NewString()
+
+	encryptedSummary, err := symCrypto.Encrypt("Loren ipsum dolor sit amet")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+	newTask := func(version int64) entity.Task {
+		task := entity.Task{
+			ID:              uuid.NewString(),
+			Summary:         encryptedSummary,
+			CreatedByUserID: managerID,
+			CreatedAt:       time.Now(),
+			UpdatedAt:       time.Now(),
+			Version:         version,
+		}
+		taskRepo.Tasks = append(taskRepo.Tasks, task)
+		return task
+	}
+
+	task := newTask(1)
+	updatedTask := newTask(1)
+	changedTask := newTask(2)
+
+	projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+	h := &TaskHandler{
+		getTaskByIDUseCase: usecase.NewGetTaskByID(
+			val,
+			symCrypto,
+			taskRepo,
+			inmemoryrepo.NewInMemoryChecklistRepo(),
+			inmemoryrepo.NewInMemoryLabelRepo(),
+			projectRepo,
+		),
+		updateTaskUseCase: usecase.NewUpdateTask(
+			val,
+			symCrypto,
+			taskRepo,
+			inmemoryrepo.NewInMemoryUserRepo(),
+			inmemoryrepo.NewInMemoryLabelRepo(),
+			projectRepo,
+			inmemoryrepo.NewInMemoryTaskEventRepo(),
+			transactioner.NewNoopTransactioner(),
+		),
+	}
+
+	e := echo.New()
+	e.HTTPErrorHandler = middleware.NewMiddleware(env, nil).
+		ErrorHandler(e.DefaultHTTPErrorHandler)
+
+	authenticate := func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set("claims", &jwtutil.UserClaims{
+				Role:           entity.RoleManager,
+				OrganizationID: uuid.NewString(),
+				RegisteredClaims: jwt.RegisteredClaims{
+					Issuer: managerID,
+				},
+			})
+			return next(c)
+		}
+	}
+	e.GET("/tasks/:id", h.Get, authenticate)
+	e.PUT("/tasks/:id", h.Update, authenticate)
+
+	body := `{"summary":"Loren ipsum"}`
+
+	tests := []struct {
+		name       string
+		method     string
+		taskID     string
+		ifMatch    string
+		wantStatus int
+		wantETag   string
+	}{
+		{
+			name:       "should return the task version as ETag",
+			method:     http.MethodGet,
+			taskID:     task.ID,
+			wantStatus: http.StatusOK,
+			wantETag:   `"1"`,
+		},
+		{
+			name:       "should update the task and return its new ETag",
+			method:     http.MethodPut,
+			taskID:     updatedTask.ID,
+			ifMatch:    `"1"`,
+			wantStatus: http.StatusOK,
+			wantETag:   `"2"`,
+		},
+		{
+			name:       "should not update the task with a stale If-Match",
+			method:     http.MethodPut,
+			taskID:     changedTask.ID,
+			ifMatch:    `"1"`,
+			wantStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:       "should not update the task without If-Match",
+			method:     http.MethodPut,
+			taskID:     task.ID,
+			wantStatus: http.StatusPreconditionRequired,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(
+				tt.method,
+				"/tasks/"+tt.taskID,
+				strings.NewReader(body),
+			)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tt.ifMatch != "" {
+				req.Header.Set(headerIfMatch, tt.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf(
+					"%s /tasks/:id status = %v, want %v, body %s",
+					tt.method,
+					rec.Code,
+					tt.wantStatus,
+					rec.Body.String(),
+				)
+			}
+
+			if got := rec.Header().Get(headerETag); got != tt.wantETag {
+				t.Errorf(
+					"%s /tasks/:id ETag = %v, want %v",
+					tt.method,
+					got,
+					tt.wantETag,
+				)
+			}
+		})
+	}
+}
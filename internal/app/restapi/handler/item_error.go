@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+)
+
+// itemErrorMessage returns the message reported for an item of a batch
+// request, logging the unexpected errors the same way the error
+// middleware does for whole requests.
+func itemErrorMessage(c echo.Context, err error, attrs ...any) string {
+	message, unexpected := entity.ItemErrorMessage(err)
+	if unexpected {
+		appErr := &entity.Err{}
+		_ = errors.As(err, &appErr)
+
+		attrs = append(
+			attrs,
+			slog.String("url", c.Request().URL.Path),
+			slog.String("stacktrace", appErr.StackTrace),
+		)
+		slog.Error(err.Error(), attrs...)
+	}
+
+	return message
+}
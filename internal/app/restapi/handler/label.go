@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/danielmesquitta/tasks-api/internal/app/restapi/dto"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+)
+
+type LabelHandler struct {
+	createLabelUseCase *usecase.CreateLabel
+	listLabelsUseCase  *usecase.ListLabels
+	updateLabelUseCase *usecase.UpdateLabel
+	deleteLabelUseCase *usecase.DeleteLabel
+}
+
+func NewLabelHandler(
+	createLabelUseCase *usecase.CreateLabel,
+	listLabelsUseCase *usecase.ListLabels,
+	updateLabelUseCase *usecase.UpdateLabel,
+	deleteLabelUseCase *usecase.DeleteLabel,
+) *LabelHandler {
+	return &LabelHandler{
+		createLabelUseCase: createLabelUseCase,
+		listLabelsUseCase:  listLabelsUseCase,
+		updateLabelUseCase: updateLabelUseCase,
+		deleteLabelUseCase: deleteLabelUseCase,
+	}
+}
+
+// @Summary Create label
+// @Description Create a label that can be added to tasks (only managers can manage labels)
+// @Tags Labels
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateLabelRequestDTO true "Request body"
+// @Success 201 {object} entity.Label
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /labels [post]
+func (h *LabelHandler) Create(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	params := dto.CreateLabelRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	label, err := h.createLabelUseCase.Execute(
+		c.Request().Context(),
+		usecase.CreateLabelParams{
+			UserRole: claims.Role,
+			Name:     params.Name,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusCreated, label)
+}
+
+// @Summary List labels
+// @Description List every label ordered by name
+// @Tags Labels
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {array} entity.Label
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /labels [get]
+func (h *LabelHandler) List(c echo.Context) error {
+	labels, err := h.listLabelsUseCase.Execute(c.Request().Context())
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusOK, labels)
+}
+
+// @Summary Update label
+// @Description Rename a label (only managers can manage labels)
+// @Tags Labels
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Label ID"
+// @Param request body dto.UpdateLabelRequestDTO true "Request body"
+// @Success 200 {object} entity.Label
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /labels/{id} [put]
+func (h *LabelHandler) Update(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	params := dto.UpdateLabelRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	label, err := h.updateLabelUseCase.Execute(
+		c.Request().Context(),
+		usecase.UpdateLabelParams{
+			ID:       c.Param("id"),
+			UserRole: claims.Role,
+			Name:     params.Name,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusOK, label)
+}
+
+// @Summary Delete label
+// @Description Delete a label, removing it from every task (only managers can manage labels)
+// @Tags Labels
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Label ID"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /labels/{id} [delete]
+func (h *LabelHandler) Delete(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	err := h.deleteLabelUseCase.Execute(
+		c.Request().Context(),
+		usecase.DeleteLabelParams{
+			ID:       c.Param("id"),
+			UserRole: claims.Role,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/danielmesquitta/tasks-api/internal/app/restapi/dto"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+)
+
+type OrganizationHandler struct {
+	createOrganizationUseCase *usecase.CreateOrganization
+	listOrganizationsUseCase  *usecase.ListOrganizations
+}
+
+func NewOrganizationHandler(
+	createOrganizationUseCase *usecase.CreateOrganization,
+	listOrganizationsUseCase *usecase.ListOrganizations,
+) *OrganizationHandler {
+	return &OrganizationHandler{
+		createOrganizationUseCase: createOrganizationUseCase,
+		listOrganizationsUseCase:  listOrganizationsUseCase,
+	}
+}
+
+// @Summary Create organization
+// @Description Create an organization to add users to, whose data is kept apart from the other organizations
+// @Tags Organizations
+// @Security BasicAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateOrganizationRequestDTO true "Request body"
+// @Success 201 {object} entity.Organization
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /organizations [post]
+func (h *OrganizationHandler) Create(c echo.Context) error {
+	params := dto.CreateOrganizationRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	organization, err := h.createOrganizationUseCase.Execute(
+		c.Request().Context(),
+		usecase.CreateOrganizationParams{
+			Name: params.Name,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusCreated, organization)
+}
+
+// @Summary List organizations
+// @Description List every organization ordered by name
+// @Tags Organizations
+// @Security BasicAuth
+// @Accept json
+// @Produce json
+// @Success 200 {array} entity.Organization
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /organizations [get]
+func (h *OrganizationHandler) List(c echo.Context) error {
+	organizations, err := h.listOrganizationsUseCase.Execute(
+		c.Request().Context(),
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusOK, organizations)
+}
@@ -0,0 +1,344 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/danielmesquitta/tasks-api/internal/app/restapi/dto"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+)
+
+type ProjectHandler struct {
+	createProjectUseCase       *usecase.CreateProject
+	listProjectsUseCase        *usecase.ListProjects
+	getProjectUseCase          *usecase.GetProject
+	updateProjectUseCase       *usecase.UpdateProject
+	deleteProjectUseCase       *usecase.DeleteProject
+	listProjectMembersUseCase  *usecase.ListProjectMembers
+	addProjectMemberUseCase    *usecase.AddProjectMember
+	removeProjectMemberUseCase *usecase.RemoveProjectMember
+}
+
+func NewProjectHandler(
+	createProjectUseCase *usecase.CreateProject,
+	listProjectsUseCase *usecase.ListProjects,
+	getProjectUseCase *usecase.GetProject,
+	updateProjectUseCase *usecase.UpdateProject,
+	deleteProjectUseCase *usecase.DeleteProject,
+	listProjectMembersUseCase *usecase.ListProjectMembers,
+	addProjectMemberUseCase *usecase.AddProjectMember,
+	removeProjectMemberUseCase *usecase.RemoveProjectMember,
+) *ProjectHandler {
+	return &ProjectHandler{
+		createProjectUseCase:       createProjectUseCase,
+		listProjectsUseCase:        listProjectsUseCase,
+		getProjectUseCase:          getProjectUseCase,
+		updateProjectUseCase:       updateProjectUseCase,
+		deleteProjectUseCase:       deleteProjectUseCase,
+		listProjectMembersUseCase:  listProjectMembersUseCase,
+		addProjectMemberUseCase:    addProjectMemberUseCase,
+		removeProjectMemberUseCase: removeProjectMemberUseCase,
+	}
+}
+
+// @Summary Create project
+// @Description Create a project managed by the user, who becomes its first member (only managers can manage projects)
+// @Tags Projects
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateProjectRequestDTO true "Request body"
+// @Success 201 {object} entity.Project
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /projects [post]
+func (h *ProjectHandler) Create(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	params := dto.CreateProjectRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	project, err := h.createProjectUseCase.Execute(
+		c.Request().Context(),
+		usecase.CreateProjectParams{
+			UserRole:    claims.Role,
+			UserID:      claims.Issuer,
+			Name:        params.Name,
+			Description: params.Description,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusCreated, project)
+}
+
+// @Summary List projects
+// @Description List the projects the user is a member of ordered by name
+// @Tags Projects
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {array} entity.Project
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /projects [get]
+func (h *ProjectHandler) List(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	projects, err := h.listProjectsUseCase.Execute(
+		c.Request().Context(),
+		usecase.ListProjectsParams{
+			UserRole: claims.Role,
+			UserID:   claims.Issuer,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusOK, projects)
+}
+
+// @Summary Get project
+// @Description Get a project the user is a member of
+// @Tags Projects
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} entity.Project
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /projects/{id} [get]
+func (h *ProjectHandler) Get(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	project, err := h.getProjectUseCase.Execute(
+		c.Request().Context(),
+		usecase.GetProjectParams{
+			ID:       c.Param("id"),
+			UserRole: claims.Role,
+			UserID:   claims.Issuer,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusOK, project)
+}
+
+// @Summary Update project
+// @Description Update a project or hand it over to another member (only the project manager can change the project)
+// @Tags Projects
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param request body dto.UpdateProjectRequestDTO true "Request body"
+// @Success 200 {object} entity.Project
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /projects/{id} [put]
+func (h *ProjectHandler) Update(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	params := dto.UpdateProjectRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	project, err := h.updateProjectUseCase.Execute(
+		c.Request().Context(),
+		usecase.UpdateProjectParams{
+			ID:            c.Param("id"),
+			UserRole:      claims.Role,
+			UserID:        claims.Issuer,
+			Name:          params.Name,
+			Description:   params.Description,
+			ManagerUserID: params.ManagerUserID,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusOK, project)
+}
+
+// @Summary Delete project
+// @Description Delete a project without tasks (only the project manager can change the project)
+// @Tags Projects
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /projects/{id} [delete]
+func (h *ProjectHandler) Delete(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	err := h.deleteProjectUseCase.Execute(
+		c.Request().Context(),
+		usecase.DeleteProjectParams{
+			ID:       c.Param("id"),
+			UserRole: claims.Role,
+			UserID:   claims.Issuer,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary List project members
+// @Description List the members of a project the user is a member of, in the order they were added
+// @Tags Projects
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {array} entity.ProjectMember
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /projects/{id}/members [get]
+func (h *ProjectHandler) ListMembers(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	members, err := h.listProjectMembersUseCase.Execute(
+		c.Request().Context(),
+		usecase.ListProjectMembersParams{
+			ProjectID: c.Param("id"),
+			UserRole:  claims.Role,
+			UserID:    claims.Issuer,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusOK, members)
+}
+
+// @Summary Add project member
+// @Description Add a manager to the project members (only the project manager can change the members)
+// @Tags Projects
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param request body dto.AddProjectMemberRequestDTO true "Request body"
+// @Success 201 {object} entity.ProjectMember
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /projects/{id}/members [post]
+func (h *ProjectHandler) AddMember(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	params := dto.AddProjectMemberRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	member, err := h.addProjectMemberUseCase.Execute(
+		c.Request().Context(),
+		usecase.AddProjectMemberParams{
+			ProjectID:    c.Param("id"),
+			UserRole:     claims.Role,
+			UserID:       claims.Issuer,
+			MemberUserID: params.UserID,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusCreated, member)
+}
+
+// @Summary Remove project member
+// @Description Remove a member from the project, other than the project manager (only the project manager can change the members)
+// @Tags Projects
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param user_id path string true "Member user ID"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /projects/{id}/members/{user_id} [delete]
+func (h *ProjectHandler) RemoveMember(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	err := h.removeProjectMemberUseCase.Execute(
+		c.Request().Context(),
+		usecase.RemoveProjectMemberParams{
+			ProjectID:    c.Param("id"),
+			UserRole:     claims.Role,
+			UserID:       claims.Issuer,
+			MemberUserID: c.Param("user_id"),
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
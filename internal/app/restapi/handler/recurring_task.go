@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/danielmesquitta/tasks-api/internal/app/restapi/dto"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+)
+
+type RecurringTaskHandler struct {
+	createRecurringTaskUseCase *usecase.CreateRecurringTask
+	listRecurringTasksUseCase  *usecase.ListRecurringTasks
+	deleteRecurringTaskUseCase *usecase.DeleteRecurringTask
+}
+
+func NewRecurringTaskHandler(
+	createRecurringTaskUseCase *usecase.CreateRecurringTask,
+	listRecurringTasksUseCase *usecase.ListRecurringTasks,
+	deleteRecurringTaskUseCase *usecase.DeleteRecurringTask,
+) *RecurringTaskHandler {
+	return &RecurringTaskHandler{
+		createRecurringTaskUseCase: createRecurringTaskUseCase,
+		listRecurringTasksUseCase:  listRecurringTasksUseCase,
+		deleteRecurringTaskUseCase: deleteRecurringTaskUseCase,
+	}
+}
+
+// @Summary Create recurring task
+// @Description Create a task template that is turned into a new task every time its schedule fires (only managers can manage recurring tasks). The schedule is a five field cron expression, such as "0 8 * * MON", or a descriptor, such as "@weekly", evaluated in UTC unless prefixed with CRON_TZ=<zone>
+// @Tags Recurring tasks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateRecurringTaskRequestDTO true "Request body"
+// @Success 201 {object} entity.RecurringTask
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /recurring-tasks [post]
+func (h *RecurringTaskHandler) Create(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	params := dto.CreateRecurringTaskRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	recurringTask, err := h.createRecurringTaskUseCase.Execute(
+		c.Request().Context(),
+		usecase.CreateRecurringTaskParams{
+			UserRole:         claims.Role,
+			CreatedByUserID:  claims.Issuer,
+			Summary:          params.Summary,
+			Schedule:         params.Schedule,
+			AssignedToUserID: params.AssignedToUserID,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusCreated, recurringTask)
+}
+
+// @Summary List recurring tasks
+// @Description List every recurring task with its next run (only managers can manage recurring tasks)
+// @Tags Recurring tasks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {array} entity.RecurringTask
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /recurring-tasks [get]
+func (h *RecurringTaskHandler) List(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	recurringTasks, err := h.listRecurringTasksUseCase.Execute(
+		c.Request().Context(),
+		claims.Role,
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusOK, recurringTasks)
+}
+
+// @Summary Delete recurring task
+// @Description Stop a recurring task, the tasks it already created are kept (only managers can manage recurring tasks)
+// @Tags Recurring tasks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Recurring task ID"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /recurring-tasks/{id} [delete]
+func (h *RecurringTaskHandler) Delete(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	err := h.deleteRecurringTaskUseCase.Execute(
+		c.Request().Context(),
+		usecase.DeleteRecurringTaskParams{
+			ID:       c.Param("id"),
+			UserRole: claims.Role,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
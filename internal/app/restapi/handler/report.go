@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/danielmesquitta/tasks-api/internal/app/restapi/dto"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+)
+
+type ReportHandler struct {
+	getTaskReportUseCase *usecase.GetTaskReport
+}
+
+func NewReportHandler(
+	getTaskReportUseCase *usecase.GetTaskReport,
+) *ReportHandler {
+	return &ReportHandler{
+		getTaskReportUseCase: getTaskReportUseCase,
+	}
+}
+
+// @Summary Task report
+// @Description Report how many tasks each technician or creator had created and finished in each day, week (starting on Monday) or month of the period, in UTC, with the median and p90 time from creation to finish of the finished ones (only managers). Tasks count for each of their assignees when grouping by technician
+// @Tags Reports
+// @Security BearerAuth
+// @Produce json
+// @Produce text/csv
+// @Param group_by query string true "Grouping" Enums(technician, creator)
+// @Param bucket query string true "Bucket" Enums(day, week, month)
+// @Param from query string true "Start of the period (RFC3339)"
+// @Param to query string true "End of the period, exclusive (RFC3339)"
+// @Param format query string false "Output format (default json)" Enums(json, csv)
+// @Success 200 {object} entity.TaskReport
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /reports/tasks [get]
+func (h *ReportHandler) Tasks(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	params := dto.TaskReportRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	if params.Format != "" && params.Format != "json" &&
+		params.Format != "csv" {
+		validationErr := entity.ErrValidation
+		validationErr.Message = "format must be one of [json csv]"
+		return validationErr
+	}
+
+	report, err := h.getTaskReportUseCase.Execute(
+		c.Request().Context(),
+		usecase.GetTaskReportParams{
+			UserID:   claims.Issuer,
+			UserRole: claims.Role,
+			GroupBy:  entity.ReportGroupBy(params.GroupBy),
+			Bucket:   entity.ReportBucket(params.Bucket),
+			From:     params.From,
+			To:       params.To,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if params.Format != "csv" {
+		return c.JSON(http.StatusOK, report)
+	}
+
+	w := &attachmentWriter{
+		res:         c.Response(),
+		contentType: "text/csv; charset=utf-8",
+		fileName:    "task-report.csv",
+	}
+
+	return usecase.WriteTaskReportCSV(w, report)
+}
@@ -13,25 +13,46 @@ import (
 )
 
 type TaskHandler struct {
-	createTaskUseCase *usecase.CreateTask
-	finishTaskUseCase *usecase.FinishTask
-	listTasksUseCase  *usecase.ListTasks
+	createTaskUseCase  *usecase.CreateTask
+	finishTaskUseCase  *usecase.FinishTask
+	listTasksUseCase   *usecase.ListTasks
+	getTaskByIDUseCase *usecase.GetTaskByID
+	updateTaskUseCase  *usecase.UpdateTask
+	deleteTaskUseCase  *usecase.DeleteTask
+	transitionUseCase  *usecase.TransitionTask
+	reopenUseCase      *usecase.ReopenTask
+	historyUseCase     *usecase.GetTaskHistory
+	restoreUseCase     *usecase.RestoreTask
 }
 
 func NewTaskHandler(
 	createTaskUseCase *usecase.CreateTask,
 	finishTaskUseCase *usecase.FinishTask,
 	listTasksUseCase *usecase.ListTasks,
+	getTaskByIDUseCase *usecase.GetTaskByID,
+	updateTaskUseCase *usecase.UpdateTask,
+	deleteTaskUseCase *usecase.DeleteTask,
+	transitionUseCase *usecase.TransitionTask,
+	reopenUseCase *usecase.ReopenTask,
+	historyUseCase *usecase.GetTaskHistory,
+	restoreUseCase *usecase.RestoreTask,
 ) *TaskHandler {
 	return &TaskHandler{
-		createTaskUseCase: createTaskUseCase,
-		finishTaskUseCase: finishTaskUseCase,
-		listTasksUseCase:  listTasksUseCase,
+		createTaskUseCase:  createTaskUseCase,
+		finishTaskUseCase:  finishTaskUseCase,
+		listTasksUseCase:   listTasksUseCase,
+		getTaskByIDUseCase: getTaskByIDUseCase,
+		updateTaskUseCase:  updateTaskUseCase,
+		deleteTaskUseCase:  deleteTaskUseCase,
+		transitionUseCase:  transitionUseCase,
+		reopenUseCase:      reopenUseCase,
+		historyUseCase:     historyUseCase,
+		restoreUseCase:     restoreUseCase,
 	}
 }
 
 // @Summary Create task
-// @Description Create new task
+// @Description Create new task, optionally with labels, a due date, a priority and a project the user is a member of
 // @Tags Tasks
 // @Security BearerAuth
 // @Accept json
@@ -61,7 +82,7 @@ func (h *TaskHandler) Create(c echo.Context) error {
 	useCaseParams.UserRole = claims.Role
 	useCaseParams.CreatedByUserID = claims.Issuer
 
-	err := h.createTaskUseCase.Execute(c.Request().Context(), useCaseParams)
+	_, err := h.createTaskUseCase.Execute(c.Request().Context(), useCaseParams)
 	if err != nil {
 		return entity.NewErr(err)
 	}
@@ -70,7 +91,7 @@ func (h *TaskHandler) Create(c echo.Context) error {
 }
 
 // @Summary Finish task
-// @Description Mark task as finished
+// @Description Submit task for review, moving it from in progress to in review, from where a manager accepts it into done by moving it to the done status. It no longer marks the task as done, so the task.finished message is only published once the task is accepted, and tasks that are not in progress are rejected. Under the all finish policy only the sign-off of the assignee is recorded until every assignee finished it
 // @Tags Tasks
 // @Security BearerAuth
 // @Accept json
@@ -101,32 +122,419 @@ func (h *TaskHandler) Finish(c echo.Context) error {
 	return c.NoContent(http.StatusOK)
 }
 
+// @Summary Transition task
+// @Description Move task to another status (open, in_progress, blocked, in_review or done). Moving it to in review submits it the same way as marking it as finished, so under the all finish policy it stays in progress until every assignee submitted it, and sending it back to in progress clears the sign-offs
+// @Tags Tasks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body dto.TransitionTaskRequestDTO true "Request body"
+// @Success 200
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/status [patch]
+func (h *TaskHandler) Transition(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	params := dto.TransitionTaskRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	useCaseParams := usecase.TransitionTaskParams{
+		TaskID:   c.Param("id"),
+		UserID:   claims.Issuer,
+		UserRole: claims.Role,
+		Status:   params.Status,
+	}
+
+	err := h.transitionUseCase.Execute(c.Request().Context(), useCaseParams)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// @Summary Reopen task
+// @Description Reopen a finished task, moving it back to open with the given reason
+// @Tags Tasks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body dto.ReopenTaskRequestDTO true "Request body"
+// @Success 200
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/reopened [patch]
+func (h *TaskHandler) Reopen(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	params := dto.ReopenTaskRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	useCaseParams := usecase.ReopenTaskParams{
+		TaskID:   c.Param("id"),
+		UserID:   claims.Issuer,
+		UserRole: claims.Role,
+		Reason:   params.Reason,
+	}
+
+	err := h.reopenUseCase.Execute(c.Request().Context(), useCaseParams)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
 // @Summary List tasks
-// @Description List tasks
+// @Description List tasks paginated with opaque cursors, ordered by creation date unless another sorting is given
 // @Tags Tasks
 // @Security BearerAuth
 // @Accept json
 // @Produce json
-// @Success 200 {object} []entity.Task
+// @Param status query string false "Task status" Enums(open, finished)
+// @Param created_by_user_id query string false "ID of the user who created the task"
+// @Param unassigned query bool false "Only tasks not assigned to any user"
+// @Param created_from query string false "Minimum creation date (RFC 3339)"
+// @Param created_to query string false "Maximum creation date (RFC 3339)"
+// @Param finished_from query string false "Minimum finish date (RFC 3339)"
+// @Param finished_to query string false "Maximum finish date (RFC 3339)"
+// @Param due_from query string false "Minimum due date (RFC 3339)"
+// @Param due_to query string false "Maximum due date (RFC 3339)"
+// @Param overdue query bool false "Only tasks past their due date and not done"
+// @Param priorities query []string false "Priorities the tasks must have any of" collectionFormat(multi) Enums(low, normal, high, critical)
+// @Param label_ids query []string false "IDs of the labels the tasks must have" collectionFormat(multi)
+// @Param label_match query string false "Whether tasks must have any or all of the labels (default any)" Enums(any, all)
+// @Param project_id query string false "ID of the project of the tasks"
+// @Param sort_by query string false "Sort field (default created_at)" Enums(created_at, updated_at, finished_at, due_at, priority)
+// @Param sort_direction query string false "Sort direction (default asc)" Enums(asc, desc)
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param cursor query string false "Cursor returned as next_cursor or prev_cursor"
+// @Success 200 {object} dto.ListTasksResponseDTO
 // @Failure 400 {object} dto.ErrorResponseDTO
 // @Failure 401 {object} dto.ErrorResponseDTO
 // @Failure 500 {object} dto.ErrorResponseDTO
 // @Router /tasks [get]
 func (h TaskHandler) List(c echo.Context) error {
+	return h.list(c, false)
+}
+
+// @Summary List deleted tasks
+// @Description List the tasks in the trash, which can be restored until purged (only managers can list them). Accepts the same filters and pagination as the task listing
+// @Tags Tasks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param sort_by query string false "Sort field (default created_at)" Enums(created_at, updated_at, finished_at, due_at, priority)
+// @Param sort_direction query string false "Sort direction (default asc)" Enums(asc, desc)
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param cursor query string false "Cursor returned as next_cursor or prev_cursor"
+// @Success 200 {object} dto.ListTasksResponseDTO
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/trash [get]
+func (h TaskHandler) Trash(c echo.Context) error {
+	return h.list(c, true)
+}
+
+func (h TaskHandler) list(c echo.Context, deleted bool) error {
 	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
 	if !ok {
 		return entity.NewErr("invalid claims")
 	}
 
-	tasks, err := h.listTasksUseCase.Execute(
+	params := dto.ListTasksRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	useCaseParams := usecase.ListTasksParams{}
+	if err := copier.Copy(&useCaseParams, params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	useCaseParams.UserRole = claims.Role
+	useCaseParams.UserID = claims.Issuer
+	useCaseParams.Deleted = deleted
+
+	result, err := h.listTasksUseCase.Execute(
 		c.Request().Context(),
-		usecase.ListTasksParams{
+		useCaseParams,
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	response := dto.ListTasksResponseDTO{}
+	if err := copier.Copy(&response, result); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// @Summary Get task
+// @Description Get task by ID, with its version in the ETag header to be sent back in the If-Match header of updates
+// @Tags Tasks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} entity.Task
+// @Header 200 {string} ETag "Task version"
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id} [get]
+func (h *TaskHandler) Get(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	task, err := h.getTaskByIDUseCase.Execute(
+		c.Request().Context(),
+		usecase.GetTaskByIDParams{
+			ID:       c.Param("id"),
+			UserID:   claims.Issuer,
 			UserRole: claims.Role,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	c.Response().Header().Set(headerETag, taskETag(task.Version))
+
+	return c.JSON(http.StatusOK, task)
+}
+
+// @Summary Get task history
+// @Description List the changes made to the task, oldest first (managers can also see the history of deleted tasks)
+// @Tags Tasks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} dto.TaskHistoryResponseDTO
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/history [get]
+func (h *TaskHandler) History(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	events, err := h.historyUseCase.Execute(
+		c.Request().Context(),
+		usecase.GetTaskHistoryParams{
+			ID:       c.Param("id"),
+			UserID:   claims.Issuer,
+			UserRole: claims.Role,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusOK, dto.TaskHistoryResponseDTO{Data: events})
+}
+
+// @Summary Update task
+// @Description Update task summary, assigned user, labels, due date, priority and project (only managers can change the assigned user, the labels, the due date, the priority or the project). The If-Match header must hold the ETag the task was read with, and the update fails if the task was changed since
+// @Tags Tasks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param If-Match header string true "Task ETag"
+// @Param request body dto.UpdateTaskRequestDTO true "Request body"
+// @Success 200
+// @Header 200 {string} ETag "Updated task version"
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 412 {object} dto.ErrorResponseDTO
+// @Failure 428 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id} [put]
+func (h *TaskHandler) Update(c echo.Context) error {
+	params := dto.UpdateTaskRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	useCaseParams := usecase.UpdateTaskParams{}
+	if err := copier.Copy(&useCaseParams, params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	useCaseParams.Summary = &params.Summary
+	useCaseParams.AssigneeIDs = params.AssigneeIDs
+	useCaseParams.LabelIDs = params.LabelIDs
+
+	return h.update(c, useCaseParams)
+}
+
+// @Summary Patch task
+// @Description Change only the given fields of the task, leaving out the summary keeps it (only managers can change the assigned user, the labels, the due date, the priority or the project). The If-Match header must hold the ETag the task was read with, and the update fails if the task was changed since
+// @Tags Tasks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param If-Match header string true "Task ETag"
+// @Param request body dto.PatchTaskRequestDTO true "Request body"
+// @Success 200
+// @Header 200 {string} ETag "Updated task version"
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 412 {object} dto.ErrorResponseDTO
+// @Failure 428 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id} [patch]
+func (h *TaskHandler) Patch(c echo.Context) error {
+	params := dto.PatchTaskRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	useCaseParams := usecase.UpdateTaskParams{}
+	if err := copier.Copy(&useCaseParams, params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	useCaseParams.Summary = params.Summary
+	useCaseParams.AssigneeIDs = params.AssigneeIDs
+	useCaseParams.LabelIDs = params.LabelIDs
+
+	return h.update(c, useCaseParams)
+}
+
+// update runs the update of the task in the path with the changes of
+// useCaseParams, on the version of the task in the If-Match header.
+func (h *TaskHandler) update(
+	c echo.Context,
+	useCaseParams usecase.UpdateTaskParams,
+) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+
+	useCaseParams.ID = c.Param("id")
+	useCaseParams.UserID = claims.Issuer
+	useCaseParams.UserRole = claims.Role
+	useCaseParams.Version = version
+
+	err = h.updateTaskUseCase.Execute(c.Request().Context(), useCaseParams)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	c.Response().Header().Set(headerETag, taskETag(version+1))
+
+	return c.NoContent(http.StatusOK)
+}
+
+// @Summary Delete task
+// @Description Move task to the trash, from where it can be restored until purged (only managers can delete tasks)
+// @Tags Tasks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id} [delete]
+func (h *TaskHandler) Delete(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	err := h.deleteTaskUseCase.Execute(
+		c.Request().Context(),
+		usecase.DeleteTaskParams{
+			TaskID:   c.Param("id"),
+			UserID:   claims.Issuer,
+			UserRole: claims.Role,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary Restore task
+// @Description Restore a deleted task from the trash (only managers can restore tasks)
+// @Tags Tasks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/restored [patch]
+func (h *TaskHandler) Restore(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	err := h.restoreUseCase.Execute(
+		c.Request().Context(),
+		usecase.RestoreTaskParams{
+			TaskID:   c.Param("id"),
 			UserID:   claims.Issuer,
+			UserRole: claims.Role,
 		},
 	)
 	if err != nil {
 		return entity.NewErr(err)
 	}
-	return c.JSON(http.StatusOK, tasks)
+
+	return c.NoContent(http.StatusOK)
 }
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/danielmesquitta/tasks-api/internal/app/restapi/dto"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+)
+
+type TaskDependencyHandler struct {
+	addTaskDependencyUseCase    *usecase.AddTaskDependency
+	removeTaskDependencyUseCase *usecase.RemoveTaskDependency
+	listTaskBlockersUseCase     *usecase.ListTaskBlockers
+}
+
+func NewTaskDependencyHandler(
+	addTaskDependencyUseCase *usecase.AddTaskDependency,
+	removeTaskDependencyUseCase *usecase.RemoveTaskDependency,
+	listTaskBlockersUseCase *usecase.ListTaskBlockers,
+) *TaskDependencyHandler {
+	return &TaskDependencyHandler{
+		addTaskDependencyUseCase:    addTaskDependencyUseCase,
+		removeTaskDependencyUseCase: removeTaskDependencyUseCase,
+		listTaskBlockersUseCase:     listTaskBlockersUseCase,
+	}
+}
+
+// @Summary Add task dependency
+// @Description Block a task by another task, which must be finished first (only managers can edit dependencies). Links that would create a cycle are rejected.
+// @Tags Dependencies
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body dto.AddTaskDependencyRequestDTO true "Request body"
+// @Success 201
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/dependencies [post]
+func (h *TaskDependencyHandler) Add(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	params := dto.AddTaskDependencyRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	err := h.addTaskDependencyUseCase.Execute(
+		c.Request().Context(),
+		usecase.AddTaskDependencyParams{
+			TaskID:          c.Param("id"),
+			BlockedByTaskID: params.BlockedByTaskID,
+			UserID:          claims.Issuer,
+			UserRole:        claims.Role,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.NoContent(http.StatusCreated)
+}
+
+// @Summary List task blockers
+// @Description List the tasks blocking a task
+// @Tags Dependencies
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} dto.ListTaskBlockersResponseDTO
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/dependencies [get]
+func (h *TaskDependencyHandler) List(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	blockers, err := h.listTaskBlockersUseCase.Execute(
+		c.Request().Context(),
+		usecase.ListTaskBlockersParams{
+			TaskID:   c.Param("id"),
+			UserID:   claims.Issuer,
+			UserRole: claims.Role,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		dto.ListTaskBlockersResponseDTO{Data: blockers},
+	)
+}
+
+// @Summary Remove task dependency
+// @Description Stop blocking a task by another task (only managers can edit dependencies)
+// @Tags Dependencies
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param blocked_by_task_id path string true "Blocking task ID"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/dependencies/{blocked_by_task_id} [delete]
+func (h *TaskDependencyHandler) Remove(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	err := h.removeTaskDependencyUseCase.Execute(
+		c.Request().Context(),
+		usecase.RemoveTaskDependencyParams{
+			TaskID:          c.Param("id"),
+			BlockedByTaskID: c.Param("blocked_by_task_id"),
+			UserID:          claims.Issuer,
+			UserRole:        claims.Role,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
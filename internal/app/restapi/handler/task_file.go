@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"cmp"
+	"log/slog"
+	"mime"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/danielmesquitta/tasks-api/internal/app/restapi/dto"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+)
+
+var mapTaskFileFormatToContentType = map[usecase.TaskFileFormat]string{
+	usecase.TaskFileFormatCSV:    "text/csv; charset=utf-8",
+	usecase.TaskFileFormatNDJSON: "application/x-ndjson",
+}
+
+type TaskFileHandler struct {
+	exportTasksUseCase *usecase.ExportTasks
+	importTasksUseCase *usecase.ImportTasks
+}
+
+func NewTaskFileHandler(
+	exportTasksUseCase *usecase.ExportTasks,
+	importTasksUseCase *usecase.ImportTasks,
+) *TaskFileHandler {
+	return &TaskFileHandler{
+		exportTasksUseCase: exportTasksUseCase,
+		importTasksUseCase: importTasksUseCase,
+	}
+}
+
+// @Summary Export tasks
+// @Description Download the tasks the user can see, ordered by creation date, as CSV or NDJSON (one JSON object per line). The file is streamed as it is read, label IDs are separated by semicolons in CSV files
+// @Tags Tasks
+// @Security BearerAuth
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Param format query string false "File format (default csv)" Enums(csv, ndjson)
+// @Param status query string false "Task status" Enums(open, finished)
+// @Param created_from query string false "Minimum creation date (RFC 3339)"
+// @Param created_to query string false "Maximum creation date (RFC 3339)"
+// @Param finished_from query string false "Minimum finish date (RFC 3339)"
+// @Param finished_to query string false "Maximum finish date (RFC 3339)"
+// @Success 200 {file} binary
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/export [get]
+func (h *TaskFileHandler) Export(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	params := dto.ExportTasksRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	format := cmp.Or(
+		usecase.TaskFileFormat(params.Format),
+		usecase.TaskFileFormatCSV,
+	)
+
+	w := &attachmentWriter{
+		res:         c.Response(),
+		contentType: mapTaskFileFormatToContentType[format],
+		fileName:    "tasks." + string(format),
+	}
+
+	err := h.exportTasksUseCase.Execute(
+		c.Request().Context(),
+		usecase.ExportTasksParams{
+			UserRole:     claims.Role,
+			UserID:       claims.Issuer,
+			Format:       format,
+			Status:       params.Status,
+			CreatedFrom:  params.CreatedFrom,
+			CreatedTo:    params.CreatedTo,
+			FinishedFrom: params.FinishedFrom,
+			FinishedTo:   params.FinishedTo,
+		},
+		w,
+	)
+	if err != nil && c.Response().Committed {
+		// The status was already sent, so the client only sees a
+		// truncated file and the error middleware skips the response.
+		slog.Error(
+			err.Error(),
+			slog.String("url", c.Request().URL.Path),
+		)
+		return nil
+	}
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+// @Summary Import tasks
+// @Description Create a task for every line of a CSV file, with a header holding a summary column and optionally assigned_to_user_id and label_ids (separated by semicolons), or of an NDJSON file with those fields. Other columns, such as the ones in exported files, are ignored. Every line follows the same rules as the task creation, and the ones that fail are reported with their line number without stopping the import
+// @Tags Tasks
+// @Security BearerAuth
+// @Accept text/csv
+// @Accept application/x-ndjson
+// @Produce json
+// @Param format query string false "File format (default csv)" Enums(csv, ndjson)
+// @Param request body string true "File content"
+// @Success 200 {object} dto.ImportTasksResponseDTO
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/import [post]
+func (h *TaskFileHandler) Import(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	format := cmp.Or(
+		usecase.TaskFileFormat(c.QueryParam("format")),
+		usecase.TaskFileFormatCSV,
+	)
+
+	result, err := h.importTasksUseCase.Execute(
+		c.Request().Context(),
+		usecase.ImportTasksParams{
+			UserRole: claims.Role,
+			UserID:   claims.Issuer,
+			Format:   format,
+		},
+		c.Request().Body,
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	res := dto.ImportTasksResponseDTO{
+		Imported: result.Imported,
+		Failed:   result.Failed,
+		Errors:   make([]dto.ImportTaskLineErrorDTO, len(result.Errors)),
+	}
+	for i, lineErr := range result.Errors {
+		res.Errors[i] = dto.ImportTaskLineErrorDTO{
+			Line: lineErr.Line,
+			Error: itemErrorMessage(
+				c,
+				lineErr.Err,
+				slog.Int("line", lineErr.Line),
+			),
+		}
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// attachmentWriter sends the headers of a file download on the first
+// write, so errors returned before anything is written are still sent
+// as JSON by the error middleware.
+type attachmentWriter struct {
+	res         *echo.Response
+	contentType string
+	fileName    string
+}
+
+func (w *attachmentWriter) Write(p []byte) (int, error) {
+	if !w.res.Committed {
+		header := w.res.Header()
+		header.Set(echo.HeaderContentType, w.contentType)
+		header.Set(
+			echo.HeaderContentDisposition,
+			mime.FormatMediaType(
+				"attachment",
+				map[string]string{"filename": w.fileName},
+			),
+		)
+		w.res.WriteHeader(http.StatusOK)
+	}
+
+	return w.res.Write(p)
+}
@@ -21,7 +21,7 @@ func NewUserHandler(
 }
 
 // @Summary Create user
-// @Description Create new user account (for role manager use 1 and for technician use 2)
+// @Description Create new user account in an organization (for role manager use 1 and for technician use 2)
 // @Tags Users
 // @Security BasicAuth
 // @Accept json
@@ -29,6 +29,7 @@ func NewUserHandler(
 // @Param request body dto.CreateUserRequestDTO true "Request body"
 // @Success 201
 // @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
 // @Failure 500 {object} dto.ErrorResponseDTO
 // @Router /users [post]
 func (h *UserHandler) Create(c echo.Context) error {
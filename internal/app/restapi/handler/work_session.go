@@ -0,0 +1,360 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/danielmesquitta/tasks-api/internal/app/restapi/dto"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+)
+
+type WorkSessionHandler struct {
+	startWorkSessionUseCase    *usecase.StartWorkSession
+	stopWorkSessionUseCase     *usecase.StopWorkSession
+	createWorkSessionUseCase   *usecase.CreateWorkSession
+	updateWorkSessionUseCase   *usecase.UpdateWorkSession
+	deleteWorkSessionUseCase   *usecase.DeleteWorkSession
+	listWorkSessionsUseCase    *usecase.ListWorkSessions
+	listWorkTimeTotalsUseCase  *usecase.ListWorkTimeTotals
+	setWorkSessionsLockUseCase *usecase.SetWorkSessionsLock
+}
+
+func NewWorkSessionHandler(
+	startWorkSessionUseCase *usecase.StartWorkSession,
+	stopWorkSessionUseCase *usecase.StopWorkSession,
+	createWorkSessionUseCase *usecase.CreateWorkSession,
+	updateWorkSessionUseCase *usecase.UpdateWorkSession,
+	deleteWorkSessionUseCase *usecase.DeleteWorkSession,
+	listWorkSessionsUseCase *usecase.ListWorkSessions,
+	listWorkTimeTotalsUseCase *usecase.ListWorkTimeTotals,
+	setWorkSessionsLockUseCase *usecase.SetWorkSessionsLock,
+) *WorkSessionHandler {
+	return &WorkSessionHandler{
+		startWorkSessionUseCase:    startWorkSessionUseCase,
+		stopWorkSessionUseCase:     stopWorkSessionUseCase,
+		createWorkSessionUseCase:   createWorkSessionUseCase,
+		updateWorkSessionUseCase:   updateWorkSessionUseCase,
+		deleteWorkSessionUseCase:   deleteWorkSessionUseCase,
+		listWorkSessionsUseCase:    listWorkSessionsUseCase,
+		listWorkTimeTotalsUseCase:  listWorkTimeTotalsUseCase,
+		setWorkSessionsLockUseCase: setWorkSessionsLockUseCase,
+	}
+}
+
+// @Summary Start work session timer
+// @Description Start a timer on the task (only the assigned technicians can track time). Technicians can only run one timer at a time, and the work sessions of finished tasks are locked unless a manager unlocks them.
+// @Tags Work sessions
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body dto.StartWorkSessionRequestDTO false "Request body"
+// @Success 201 {object} entity.WorkSession
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/work-sessions/timer/start [post]
+func (h *WorkSessionHandler) Start(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	params := dto.StartWorkSessionRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	session, err := h.startWorkSessionUseCase.Execute(
+		c.Request().Context(),
+		usecase.StartWorkSessionParams{
+			TaskID: c.Param("id"),
+			UserID: claims.Issuer,
+			Note:   params.Note,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusCreated, session)
+}
+
+// @Summary Stop work session timer
+// @Description Stop the timer the user is running on the task
+// @Tags Work sessions
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} entity.WorkSession
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/work-sessions/timer/stop [post]
+func (h *WorkSessionHandler) Stop(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	session, err := h.stopWorkSessionUseCase.Execute(
+		c.Request().Context(),
+		usecase.StopWorkSessionParams{
+			TaskID: c.Param("id"),
+			UserID: claims.Issuer,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusOK, session)
+}
+
+// @Summary Create work session
+// @Description Log time worked on the task without a timer (only the assigned technicians can track time)
+// @Tags Work sessions
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body dto.CreateWorkSessionRequestDTO true "Request body"
+// @Success 201 {object} entity.WorkSession
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/work-sessions [post]
+func (h *WorkSessionHandler) Create(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	params := dto.CreateWorkSessionRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	session, err := h.createWorkSessionUseCase.Execute(
+		c.Request().Context(),
+		usecase.CreateWorkSessionParams{
+			TaskID:    c.Param("id"),
+			UserID:    claims.Issuer,
+			StartedAt: params.StartedAt,
+			EndedAt:   params.EndedAt,
+			Note:      params.Note,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusCreated, session)
+}
+
+// @Summary List work sessions
+// @Description List the task work sessions ordered by start, with the time worked on the task in total and by technician
+// @Tags Work sessions
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} entity.TaskWorkTime
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/work-sessions [get]
+func (h *WorkSessionHandler) List(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	result, err := h.listWorkSessionsUseCase.Execute(
+		c.Request().Context(),
+		usecase.ListWorkSessionsParams{
+			TaskID:   c.Param("id"),
+			UserID:   claims.Issuer,
+			UserRole: claims.Role,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// @Summary Update work session
+// @Description Change the start, end or note of a work session the user logged, only the given fields are changed
+// @Tags Work sessions
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param session_id path string true "Work session ID"
+// @Param request body dto.UpdateWorkSessionRequestDTO true "Request body"
+// @Success 200 {object} entity.WorkSession
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/work-sessions/{session_id} [patch]
+func (h *WorkSessionHandler) Update(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	params := dto.UpdateWorkSessionRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	session, err := h.updateWorkSessionUseCase.Execute(
+		c.Request().Context(),
+		usecase.UpdateWorkSessionParams{
+			TaskID:    c.Param("id"),
+			SessionID: c.Param("session_id"),
+			UserID:    claims.Issuer,
+			StartedAt: params.StartedAt,
+			EndedAt:   params.EndedAt,
+			Note:      params.Note,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusOK, session)
+}
+
+// @Summary Delete work session
+// @Description Remove a work session the user logged
+// @Tags Work sessions
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param session_id path string true "Work session ID"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/work-sessions/{session_id} [delete]
+func (h *WorkSessionHandler) Delete(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	err := h.deleteWorkSessionUseCase.Execute(
+		c.Request().Context(),
+		usecase.DeleteWorkSessionParams{
+			TaskID:    c.Param("id"),
+			SessionID: c.Param("session_id"),
+			UserID:    claims.Issuer,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary Lock or unlock work sessions
+// @Description Unlock the work sessions of a finished task so that its assignees can fix them, or lock them again (only managers)
+// @Tags Work sessions
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body dto.SetWorkSessionsLockRequestDTO true "Request body"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 403 {object} dto.ErrorResponseDTO
+// @Failure 404 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /tasks/{id}/work-sessions/locked [patch]
+func (h *WorkSessionHandler) SetLock(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	params := dto.SetWorkSessionsLockRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	err := h.setWorkSessionsLockUseCase.Execute(
+		c.Request().Context(),
+		usecase.SetWorkSessionsLockParams{
+			TaskID:   c.Param("id"),
+			UserID:   claims.Issuer,
+			UserRole: claims.Role,
+			Locked:   params.Locked,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary List work time totals
+// @Description Sum the time worked by each technician in the work sessions started in the period. Managers see every technician, on the tasks without a project or in the projects they are members of, while technicians only see their own time.
+// @Tags Work sessions
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param from query string true "Start of the period (RFC3339)"
+// @Param to query string true "End of the period, exclusive (RFC3339)"
+// @Success 200 {object} usecase.ListWorkTimeTotalsResult
+// @Failure 400 {object} dto.ErrorResponseDTO
+// @Failure 401 {object} dto.ErrorResponseDTO
+// @Failure 500 {object} dto.ErrorResponseDTO
+// @Router /work-time [get]
+func (h *WorkSessionHandler) Totals(c echo.Context) error {
+	claims, ok := c.Get("claims").(*jwtutil.UserClaims)
+	if !ok {
+		return entity.NewErr("invalid claims")
+	}
+
+	params := dto.ListWorkTimeTotalsRequestDTO{}
+	if err := c.Bind(&params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	result, err := h.listWorkTimeTotalsUseCase.Execute(
+		c.Request().Context(),
+		usecase.ListWorkTimeTotalsParams{
+			UserID:   claims.Issuer,
+			UserRole: claims.Role,
+			From:     params.From,
+			To:       params.To,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
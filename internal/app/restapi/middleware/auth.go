@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/danielmesquitta/tasks-api/internal/app/restapi/dto"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/tenant"
 	"github.com/labstack/echo/v4"
 )
 
@@ -44,6 +45,14 @@ func (m *Middleware) EnsureAuthenticated(
 		// Set the claims in the context
 		c.Set("claims", claims)
 
+		// Scope the request to the organization of the user
+		c.SetRequest(c.Request().WithContext(
+			tenant.WithOrganizationID(
+				c.Request().Context(),
+				claims.OrganizationID,
+			),
+		))
+
 		// Token is valid, proceed with the request
 		return next(c)
 	}
@@ -11,11 +11,13 @@ import (
 )
 
 var mapErrTypeToStatusCode = map[entity.ErrType]int{
-	entity.ErrTypeForbidden:    http.StatusForbidden,
-	entity.ErrTypeUnauthorized: http.StatusUnauthorized,
-	entity.ErrTypeValidation:   http.StatusBadRequest,
-	entity.ErrTypeUnknown:      http.StatusInternalServerError,
-	entity.ErrTypeNotFound:     http.StatusNotFound,
+	entity.ErrTypeForbidden:            http.StatusForbidden,
+	entity.ErrTypeUnauthorized:         http.StatusUnauthorized,
+	entity.ErrTypeValidation:           http.StatusBadRequest,
+	entity.ErrTypeUnknown:              http.StatusInternalServerError,
+	entity.ErrTypeNotFound:             http.StatusNotFound,
+	entity.ErrTypeConflict:             http.StatusPreconditionFailed,
+	entity.ErrTypePreconditionRequired: http.StatusPreconditionRequired,
 }
 
 func (m *Middleware) ErrorHandler(
@@ -6,6 +6,8 @@ import (
 	"github.com/danielmesquitta/tasks-api/internal/app/restapi/handler"
 	"github.com/danielmesquitta/tasks-api/internal/app/restapi/middleware"
 	"github.com/danielmesquitta/tasks-api/internal/app/restapi/router"
+	"github.com/danielmesquitta/tasks-api/internal/app/scheduler"
+	"github.com/danielmesquitta/tasks-api/internal/app/subscriber"
 	"github.com/danielmesquitta/tasks-api/internal/config"
 	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/hasher"
@@ -13,6 +15,8 @@ import (
 	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/blobstore"
+	"github.com/danielmesquitta/tasks-api/internal/provider/blobstore/localblobstore"
 	"github.com/danielmesquitta/tasks-api/internal/provider/broker"
 	"github.com/danielmesquitta/tasks-api/internal/provider/broker/clibroker"
 	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
@@ -54,27 +58,147 @@ func Start() {
 			mysqlrepo.NewMySQLTaskRepo,
 			fx.As(new(repo.TaskRepo)),
 		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLTaskEventRepo,
+			fx.As(new(repo.TaskEventRepo)),
+		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLCommentRepo,
+			fx.As(new(repo.CommentRepo)),
+		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLAttachmentRepo,
+			fx.As(new(repo.AttachmentRepo)),
+		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLChecklistRepo,
+			fx.As(new(repo.ChecklistRepo)),
+		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLWorkSessionRepo,
+			fx.As(new(repo.WorkSessionRepo)),
+		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLReportRepo,
+			fx.As(new(repo.ReportRepo)),
+		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLTaskDependencyRepo,
+			fx.As(new(repo.TaskDependencyRepo)),
+		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLLabelRepo,
+			fx.As(new(repo.LabelRepo)),
+		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLProjectRepo,
+			fx.As(new(repo.ProjectRepo)),
+		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLRecurringTaskRepo,
+			fx.As(new(repo.RecurringTaskRepo)),
+		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLCalendarFeedRepo,
+			fx.As(new(repo.CalendarFeedRepo)),
+		),
 		fx.Annotate(
 			mysqlrepo.NewMySQLUserRepo,
 			fx.As(new(repo.UserRepo)),
 		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLOrganizationRepo,
+			fx.As(new(repo.OrganizationRepo)),
+		),
 
 		fx.Annotate(
 			clibroker.NewCLIMessageBroker,
 			fx.As(new(broker.MessageBroker)),
 		),
+		fx.Annotate(
+			localblobstore.NewLocalBlobStore,
+			fx.As(new(blobstore.BlobStore)),
+		),
 
 		// Use cases
 		usecase.NewListTasks,
 		usecase.NewAuthenticate,
 		usecase.NewCreateUser,
+		usecase.NewCreateOrganization,
+		usecase.NewListOrganizations,
 		usecase.NewCreateTask,
 		usecase.NewFinishTask,
+		usecase.NewTransitionTask,
+		usecase.NewReopenTask,
+		usecase.NewUnblockDependentTasks,
+		usecase.NewRunRecurringTasks,
+		usecase.NewNotifyOverdueTasks,
+		usecase.NewGetTaskByID,
+		usecase.NewGetTaskHistory,
+		usecase.NewUpdateTask,
+		usecase.NewDeleteTask,
+		usecase.NewRestoreTask,
+		usecase.NewReassignTask,
+		usecase.NewBulkTasks,
+		usecase.NewExportTasks,
+		usecase.NewImportTasks,
+		usecase.NewCreateComment,
+		usecase.NewListComments,
+		usecase.NewUploadAttachment,
+		usecase.NewDownloadAttachment,
+		usecase.NewListAttachments,
+		usecase.NewCreateChecklistItem,
+		usecase.NewUpdateChecklistItem,
+		usecase.NewDeleteChecklistItem,
+		usecase.NewListChecklistItems,
+		usecase.NewStartWorkSession,
+		usecase.NewStopWorkSession,
+		usecase.NewCreateWorkSession,
+		usecase.NewUpdateWorkSession,
+		usecase.NewDeleteWorkSession,
+		usecase.NewListWorkSessions,
+		usecase.NewListWorkTimeTotals,
+		usecase.NewSetWorkSessionsLock,
+		usecase.NewGetTaskReport,
+		usecase.NewAddTaskDependency,
+		usecase.NewRemoveTaskDependency,
+		usecase.NewListTaskBlockers,
+		usecase.NewCreateLabel,
+		usecase.NewListLabels,
+		usecase.NewUpdateLabel,
+		usecase.NewDeleteLabel,
+		usecase.NewCreateProject,
+		usecase.NewListProjects,
+		usecase.NewGetProject,
+		usecase.NewUpdateProject,
+		usecase.NewDeleteProject,
+		usecase.NewListProjectMembers,
+		usecase.NewAddProjectMember,
+		usecase.NewRemoveProjectMember,
+		usecase.NewCreateRecurringTask,
+		usecase.NewListRecurringTasks,
+		usecase.NewDeleteRecurringTask,
+		usecase.NewCreateCalendarFeedToken,
+		usecase.NewRevokeCalendarFeedToken,
+		usecase.NewGetCalendarFeed,
 
 		// Handlers
 		handler.NewAuthHandler,
 		handler.NewUserHandler,
+		handler.NewOrganizationHandler,
 		handler.NewTaskHandler,
+		handler.NewBulkTaskHandler,
+		handler.NewTaskFileHandler,
+		handler.NewCommentHandler,
+		handler.NewAttachmentHandler,
+		handler.NewChecklistHandler,
+		handler.NewWorkSessionHandler,
+		handler.NewReportHandler,
+		handler.NewTaskDependencyHandler,
+		handler.NewLabelHandler,
+		handler.NewProjectHandler,
+		handler.NewRecurringTaskHandler,
+		handler.NewCalendarFeedHandler,
 
 		// Middleware
 		middleware.NewMiddleware,
@@ -88,6 +212,8 @@ func Start() {
 
 	container := fx.New(
 		depsProvider,
+		fx.Invoke(subscriber.Register),
+		fx.Invoke(scheduler.Register),
 		fx.Invoke(func(*echo.Echo) {}),
 	)
 
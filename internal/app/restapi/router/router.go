@@ -12,11 +12,24 @@ import (
 )
 
 type Router struct {
-	env         *config.Env
-	mid         *mid.Middleware
-	authHandler *handler.AuthHandler
-	userHandler *handler.UserHandler
-	taskHandler *handler.TaskHandler
+	env               *config.Env
+	mid               *mid.Middleware
+	authHandler       *handler.AuthHandler
+	userHandler       *handler.UserHandler
+	orgHandler        *handler.OrganizationHandler
+	taskHandler       *handler.TaskHandler
+	bulkTaskHandler   *handler.BulkTaskHandler
+	taskFileHandler   *handler.TaskFileHandler
+	commentHandler    *handler.CommentHandler
+	attachmentHandler *handler.AttachmentHandler
+	checklistHandler  *handler.ChecklistHandler
+	dependencyHandler *handler.TaskDependencyHandler
+	labelHandler      *handler.LabelHandler
+	projectHandler    *handler.ProjectHandler
+	recurringHandler  *handler.RecurringTaskHandler
+	calendarHandler   *handler.CalendarFeedHandler
+	workHandler       *handler.WorkSessionHandler
+	reportHandler     *handler.ReportHandler
 }
 
 func NewRouter(
@@ -24,14 +37,40 @@ func NewRouter(
 	mid *mid.Middleware,
 	authHandler *handler.AuthHandler,
 	userHandler *handler.UserHandler,
+	orgHandler *handler.OrganizationHandler,
 	taskHandler *handler.TaskHandler,
+	bulkTaskHandler *handler.BulkTaskHandler,
+	taskFileHandler *handler.TaskFileHandler,
+	commentHandler *handler.CommentHandler,
+	attachmentHandler *handler.AttachmentHandler,
+	checklistHandler *handler.ChecklistHandler,
+	dependencyHandler *handler.TaskDependencyHandler,
+	labelHandler *handler.LabelHandler,
+	projectHandler *handler.ProjectHandler,
+	recurringHandler *handler.RecurringTaskHandler,
+	calendarHandler *handler.CalendarFeedHandler,
+	workHandler *handler.WorkSessionHandler,
+	reportHandler *handler.ReportHandler,
 ) *Router {
 	return &Router{
-		env:         env,
-		mid:         mid,
-		authHandler: authHandler,
-		userHandler: userHandler,
-		taskHandler: taskHandler,
+		env:               env,
+		mid:               mid,
+		authHandler:       authHandler,
+		userHandler:       userHandler,
+		orgHandler:        orgHandler,
+		taskHandler:       taskHandler,
+		bulkTaskHandler:   bulkTaskHandler,
+		taskFileHandler:   taskFileHandler,
+		commentHandler:    commentHandler,
+		attachmentHandler: attachmentHandler,
+		checklistHandler:  checklistHandler,
+		dependencyHandler: dependencyHandler,
+		labelHandler:      labelHandler,
+		projectHandler:    projectHandler,
+		recurringHandler:  recurringHandler,
+		calendarHandler:   calendarHandler,
+		workHandler:       workHandler,
+		reportHandler:     reportHandler,
 	}
 }
 
@@ -43,15 +82,254 @@ func (r *Router) Register(
 
 	apiV1.GET("/docs/*", echoSwagger.WrapHandler)
 
+	apiV1.POST("/organizations", r.orgHandler.Create, r.mid.BasicAuth)
+	apiV1.GET("/organizations", r.orgHandler.List, r.mid.BasicAuth)
+
 	apiV1.POST("/users", r.userHandler.Create, r.mid.BasicAuth)
 
 	apiV1.POST("/auth/login", r.authHandler.Login)
 
+	apiV1.POST(
+		"/users/me/calendar-feed",
+		r.calendarHandler.CreateToken,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.DELETE(
+		"/users/me/calendar-feed",
+		r.calendarHandler.RevokeToken,
+		r.mid.EnsureAuthenticated,
+	)
+	// Calendar apps can not send bearer tokens, the feed is authenticated
+	// by its own token instead.
+	apiV1.GET(
+		"/calendar-feeds/:token/tasks.ics",
+		r.calendarHandler.Get,
+	).Name = "calendarFeed"
+
 	apiV1.POST("/tasks", r.taskHandler.Create, r.mid.EnsureAuthenticated)
+	apiV1.POST(
+		"/tasks/bulk/create",
+		r.bulkTaskHandler.Create,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.POST(
+		"/tasks/bulk/reassign",
+		r.bulkTaskHandler.Reassign,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.POST(
+		"/tasks/bulk/finish",
+		r.bulkTaskHandler.Finish,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.POST(
+		"/tasks/bulk/delete",
+		r.bulkTaskHandler.Delete,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.POST(
+		"/tasks/import",
+		r.taskFileHandler.Import,
+		r.mid.EnsureAuthenticated,
+	)
 	apiV1.PATCH(
 		"/tasks/:id/finished",
 		r.taskHandler.Finish,
 		r.mid.EnsureAuthenticated,
 	)
+	apiV1.PATCH(
+		"/tasks/:id/status",
+		r.taskHandler.Transition,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.PATCH(
+		"/tasks/:id/reopened",
+		r.taskHandler.Reopen,
+		r.mid.EnsureAuthenticated,
+	)
 	apiV1.GET("/tasks", r.taskHandler.List, r.mid.EnsureAuthenticated)
+	apiV1.GET("/tasks/trash", r.taskHandler.Trash, r.mid.EnsureAuthenticated)
+	apiV1.GET(
+		"/tasks/export",
+		r.taskFileHandler.Export,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.GET("/tasks/:id", r.taskHandler.Get, r.mid.EnsureAuthenticated)
+	apiV1.GET(
+		"/tasks/:id/history",
+		r.taskHandler.History,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.PUT("/tasks/:id", r.taskHandler.Update, r.mid.EnsureAuthenticated)
+	apiV1.PATCH("/tasks/:id", r.taskHandler.Patch, r.mid.EnsureAuthenticated)
+	apiV1.DELETE(
+		"/tasks/:id",
+		r.taskHandler.Delete,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.PATCH(
+		"/tasks/:id/restored",
+		r.taskHandler.Restore,
+		r.mid.EnsureAuthenticated,
+	)
+
+	apiV1.POST(
+		"/tasks/:id/comments",
+		r.commentHandler.Create,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.GET(
+		"/tasks/:id/comments",
+		r.commentHandler.List,
+		r.mid.EnsureAuthenticated,
+	)
+
+	apiV1.POST(
+		"/tasks/:id/attachments",
+		r.attachmentHandler.Upload,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.GET(
+		"/tasks/:id/attachments",
+		r.attachmentHandler.List,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.GET(
+		"/tasks/:id/attachments/:attachment_id",
+		r.attachmentHandler.Download,
+		r.mid.EnsureAuthenticated,
+	)
+
+	apiV1.POST(
+		"/tasks/:id/checklist",
+		r.checklistHandler.Create,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.GET(
+		"/tasks/:id/checklist",
+		r.checklistHandler.List,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.PATCH(
+		"/tasks/:id/checklist/:item_id",
+		r.checklistHandler.Update,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.DELETE(
+		"/tasks/:id/checklist/:item_id",
+		r.checklistHandler.Delete,
+		r.mid.EnsureAuthenticated,
+	)
+
+	apiV1.POST(
+		"/tasks/:id/work-sessions",
+		r.workHandler.Create,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.GET(
+		"/tasks/:id/work-sessions",
+		r.workHandler.List,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.POST(
+		"/tasks/:id/work-sessions/timer/start",
+		r.workHandler.Start,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.POST(
+		"/tasks/:id/work-sessions/timer/stop",
+		r.workHandler.Stop,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.PATCH(
+		"/tasks/:id/work-sessions/locked",
+		r.workHandler.SetLock,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.PATCH(
+		"/tasks/:id/work-sessions/:session_id",
+		r.workHandler.Update,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.DELETE(
+		"/tasks/:id/work-sessions/:session_id",
+		r.workHandler.Delete,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.GET("/work-time", r.workHandler.Totals, r.mid.EnsureAuthenticated)
+	apiV1.GET(
+		"/reports/tasks",
+		r.reportHandler.Tasks,
+		r.mid.EnsureAuthenticated,
+	)
+
+	apiV1.POST(
+		"/tasks/:id/dependencies",
+		r.dependencyHandler.Add,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.GET(
+		"/tasks/:id/dependencies",
+		r.dependencyHandler.List,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.DELETE(
+		"/tasks/:id/dependencies/:blocked_by_task_id",
+		r.dependencyHandler.Remove,
+		r.mid.EnsureAuthenticated,
+	)
+
+	apiV1.POST("/labels", r.labelHandler.Create, r.mid.EnsureAuthenticated)
+	apiV1.GET("/labels", r.labelHandler.List, r.mid.EnsureAuthenticated)
+	apiV1.PUT("/labels/:id", r.labelHandler.Update, r.mid.EnsureAuthenticated)
+	apiV1.DELETE(
+		"/labels/:id",
+		r.labelHandler.Delete,
+		r.mid.EnsureAuthenticated,
+	)
+
+	apiV1.POST("/projects", r.projectHandler.Create, r.mid.EnsureAuthenticated)
+	apiV1.GET("/projects", r.projectHandler.List, r.mid.EnsureAuthenticated)
+	apiV1.GET("/projects/:id", r.projectHandler.Get, r.mid.EnsureAuthenticated)
+	apiV1.PUT(
+		"/projects/:id",
+		r.projectHandler.Update,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.DELETE(
+		"/projects/:id",
+		r.projectHandler.Delete,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.GET(
+		"/projects/:id/members",
+		r.projectHandler.ListMembers,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.POST(
+		"/projects/:id/members",
+		r.projectHandler.AddMember,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.DELETE(
+		"/projects/:id/members/:user_id",
+		r.projectHandler.RemoveMember,
+		r.mid.EnsureAuthenticated,
+	)
+
+	apiV1.POST(
+		"/recurring-tasks",
+		r.recurringHandler.Create,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.GET(
+		"/recurring-tasks",
+		r.recurringHandler.List,
+		r.mid.EnsureAuthenticated,
+	)
+	apiV1.DELETE(
+		"/recurring-tasks/:id",
+		r.recurringHandler.Delete,
+		r.mid.EnsureAuthenticated,
+	)
 }
@@ -0,0 +1,72 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+)
+
+var mapErrTypeToCode = map[entity.ErrType]codes.Code{
+	entity.ErrTypeForbidden:            codes.PermissionDenied,
+	entity.ErrTypeUnauthorized:         codes.Unauthenticated,
+	entity.ErrTypeValidation:           codes.InvalidArgument,
+	entity.ErrTypeUnknown:              codes.Internal,
+	entity.ErrTypeNotFound:             codes.NotFound,
+	entity.ErrTypeConflict:             codes.Aborted,
+	entity.ErrTypePreconditionRequired: codes.FailedPrecondition,
+}
+
+func (i *Interceptor) UnaryMapErrors(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, mapErr(err, info.FullMethod)
+	}
+
+	return resp, nil
+}
+
+func (i *Interceptor) StreamMapErrors(
+	srv any,
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if err := handler(srv, stream); err != nil {
+		return mapErr(err, info.FullMethod)
+	}
+
+	return nil
+}
+
+// mapErr turns the application errors into gRPC status errors with the
+// code of their type. Unexpected errors are logged and hidden, the same
+// way the REST error middleware handles them.
+func mapErr(err error, method string) error {
+	appErr := &entity.Err{}
+	if !errors.As(err, &appErr) {
+		return err
+	}
+
+	code, ok := mapErrTypeToCode[appErr.Type]
+	if !ok || code == codes.Internal {
+		slog.Error(
+			appErr.Error(),
+			slog.String("method", method),
+			slog.String("stacktrace", appErr.StackTrace),
+		)
+		return status.Error(codes.Internal, "internal server error")
+	}
+
+	return status.Error(code, appErr.Error())
+}
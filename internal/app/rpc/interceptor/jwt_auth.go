@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/danielmesquitta/tasks-api/internal/pkg/tenant"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -93,6 +94,7 @@ func (i *Interceptor) ensureJWTAuthentication(
 	}
 
 	*ctx = context.WithValue(*ctx, ClaimsKey, claims)
+	*ctx = tenant.WithOrganizationID(*ctx, claims.OrganizationID)
 
 	return nil
 }
@@ -23,15 +23,25 @@ const (
 	TaskService_ListTasks_FullMethodName          = "/tasksapi.TaskService/ListTasks"
 	TaskService_CreateTask_FullMethodName         = "/tasksapi.TaskService/CreateTask"
 	TaskService_MarkTaskAsFinished_FullMethodName = "/tasksapi.TaskService/MarkTaskAsFinished"
+	TaskService_TransitionTask_FullMethodName     = "/tasksapi.TaskService/TransitionTask"
+	TaskService_ReopenTask_FullMethodName         = "/tasksapi.TaskService/ReopenTask"
+	TaskService_BulkTasks_FullMethodName          = "/tasksapi.TaskService/BulkTasks"
 )
 
 // TaskServiceClient is the client API for TaskService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TaskServiceClient interface {
-	ListTasks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
 	CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Submits the task for review, moving it from in_progress to in_review,
+	// where a manager accepts it into done with TransitionTask. It no longer
+	// marks the task as done, so task.finished is only published once it is
+	// accepted, and tasks that are not in progress are rejected.
 	MarkTaskAsFinished(ctx context.Context, in *MarkTaskAsFinishedRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	TransitionTask(ctx context.Context, in *TransitionTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReopenTask(ctx context.Context, in *ReopenTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BulkTasks(ctx context.Context, in *BulkTasksRequest, opts ...grpc.CallOption) (*BulkTasksResponse, error)
 }
 
 type taskServiceClient struct {
@@ -42,7 +52,7 @@ func NewTaskServiceClient(cc grpc.ClientConnInterface) TaskServiceClient {
 	return &taskServiceClient{cc}
 }
 
-func (c *taskServiceClient) ListTasks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTasksResponse, error) {
+func (c *taskServiceClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListTasksResponse)
 	err := c.cc.Invoke(ctx, TaskService_ListTasks_FullMethodName, in, out, cOpts...)
@@ -72,13 +82,50 @@ func (c *taskServiceClient) MarkTaskAsFinished(ctx context.Context, in *MarkTask
 	return out, nil
 }
 
+func (c *taskServiceClient) TransitionTask(ctx context.Context, in *TransitionTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TaskService_TransitionTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ReopenTask(ctx context.Context, in *ReopenTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TaskService_ReopenTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) BulkTasks(ctx context.Context, in *BulkTasksRequest, opts ...grpc.CallOption) (*BulkTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_BulkTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaskServiceServer is the server API for TaskService service.
 // All implementations must embed UnimplementedTaskServiceServer
 // for forward compatibility.
 type TaskServiceServer interface {
-	ListTasks(context.Context, *emptypb.Empty) (*ListTasksResponse, error)
+	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
 	CreateTask(context.Context, *CreateTaskRequest) (*emptypb.Empty, error)
+	// Submits the task for review, moving it from in_progress to in_review,
+	// where a manager accepts it into done with TransitionTask. It no longer
+	// marks the task as done, so task.finished is only published once it is
+	// accepted, and tasks that are not in progress are rejected.
 	MarkTaskAsFinished(context.Context, *MarkTaskAsFinishedRequest) (*emptypb.Empty, error)
+	TransitionTask(context.Context, *TransitionTaskRequest) (*emptypb.Empty, error)
+	ReopenTask(context.Context, *ReopenTaskRequest) (*emptypb.Empty, error)
+	BulkTasks(context.Context, *BulkTasksRequest) (*BulkTasksResponse, error)
 	mustEmbedUnimplementedTaskServiceServer()
 }
 
@@ -89,7 +136,7 @@ type TaskServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedTaskServiceServer struct{}
 
-func (UnimplementedTaskServiceServer) ListTasks(context.Context, *emptypb.Empty) (*ListTasksResponse, error) {
+func (UnimplementedTaskServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
 }
 func (UnimplementedTaskServiceServer) CreateTask(context.Context, *CreateTaskRequest) (*emptypb.Empty, error) {
@@ -98,6 +145,15 @@ func (UnimplementedTaskServiceServer) CreateTask(context.Context, *CreateTaskReq
 func (UnimplementedTaskServiceServer) MarkTaskAsFinished(context.Context, *MarkTaskAsFinishedRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarkTaskAsFinished not implemented")
 }
+func (UnimplementedTaskServiceServer) TransitionTask(context.Context, *TransitionTaskRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionTask not implemented")
+}
+func (UnimplementedTaskServiceServer) ReopenTask(context.Context, *ReopenTaskRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenTask not implemented")
+}
+func (UnimplementedTaskServiceServer) BulkTasks(context.Context, *BulkTasksRequest) (*BulkTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkTasks not implemented")
+}
 func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
 func (UnimplementedTaskServiceServer) testEmbeddedByValue()                     {}
 
@@ -120,7 +176,7 @@ func RegisterTaskServiceServer(s grpc.ServiceRegistrar, srv TaskServiceServer) {
 }
 
 func _TaskService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(ListTasksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -132,7 +188,7 @@ func _TaskService_ListTasks_Handler(srv interface{}, ctx context.Context, dec fu
 		FullMethod: TaskService_ListTasks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).ListTasks(ctx, req.(*emptypb.Empty))
+		return srv.(TaskServiceServer).ListTasks(ctx, req.(*ListTasksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -173,6 +229,60 @@ func _TaskService_MarkTaskAsFinished_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_TransitionTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).TransitionTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_TransitionTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).TransitionTask(ctx, req.(*TransitionTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ReopenTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReopenTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ReopenTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ReopenTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ReopenTask(ctx, req.(*ReopenTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_BulkTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).BulkTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_BulkTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).BulkTasks(ctx, req.(*BulkTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -192,6 +302,18 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MarkTaskAsFinished",
 			Handler:    _TaskService_MarkTaskAsFinished_Handler,
 		},
+		{
+			MethodName: "TransitionTask",
+			Handler:    _TaskService_TransitionTask_Handler,
+		},
+		{
+			MethodName: "ReopenTask",
+			Handler:    _TaskService_ReopenTask_Handler,
+		},
+		{
+			MethodName: "BulkTasks",
+			Handler:    _TaskService_BulkTasks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "task_service.proto",
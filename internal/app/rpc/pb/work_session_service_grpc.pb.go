@@ -0,0 +1,388 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: work_session_service.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WorkSessionService_StartWorkSession_FullMethodName    = "/tasksapi.WorkSessionService/StartWorkSession"
+	WorkSessionService_StopWorkSession_FullMethodName     = "/tasksapi.WorkSessionService/StopWorkSession"
+	WorkSessionService_CreateWorkSession_FullMethodName   = "/tasksapi.WorkSessionService/CreateWorkSession"
+	WorkSessionService_UpdateWorkSession_FullMethodName   = "/tasksapi.WorkSessionService/UpdateWorkSession"
+	WorkSessionService_DeleteWorkSession_FullMethodName   = "/tasksapi.WorkSessionService/DeleteWorkSession"
+	WorkSessionService_ListWorkSessions_FullMethodName    = "/tasksapi.WorkSessionService/ListWorkSessions"
+	WorkSessionService_SetWorkSessionsLock_FullMethodName = "/tasksapi.WorkSessionService/SetWorkSessionsLock"
+	WorkSessionService_ListWorkTimeTotals_FullMethodName  = "/tasksapi.WorkSessionService/ListWorkTimeTotals"
+)
+
+// WorkSessionServiceClient is the client API for WorkSessionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WorkSessionServiceClient interface {
+	StartWorkSession(ctx context.Context, in *StartWorkSessionRequest, opts ...grpc.CallOption) (*WorkSession, error)
+	StopWorkSession(ctx context.Context, in *StopWorkSessionRequest, opts ...grpc.CallOption) (*WorkSession, error)
+	CreateWorkSession(ctx context.Context, in *CreateWorkSessionRequest, opts ...grpc.CallOption) (*WorkSession, error)
+	UpdateWorkSession(ctx context.Context, in *UpdateWorkSessionRequest, opts ...grpc.CallOption) (*WorkSession, error)
+	DeleteWorkSession(ctx context.Context, in *DeleteWorkSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListWorkSessions(ctx context.Context, in *ListWorkSessionsRequest, opts ...grpc.CallOption) (*ListWorkSessionsResponse, error)
+	SetWorkSessionsLock(ctx context.Context, in *SetWorkSessionsLockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListWorkTimeTotals(ctx context.Context, in *ListWorkTimeTotalsRequest, opts ...grpc.CallOption) (*ListWorkTimeTotalsResponse, error)
+}
+
+type workSessionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorkSessionServiceClient(cc grpc.ClientConnInterface) WorkSessionServiceClient {
+	return &workSessionServiceClient{cc}
+}
+
+func (c *workSessionServiceClient) StartWorkSession(ctx context.Context, in *StartWorkSessionRequest, opts ...grpc.CallOption) (*WorkSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkSession)
+	err := c.cc.Invoke(ctx, WorkSessionService_StartWorkSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workSessionServiceClient) StopWorkSession(ctx context.Context, in *StopWorkSessionRequest, opts ...grpc.CallOption) (*WorkSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkSession)
+	err := c.cc.Invoke(ctx, WorkSessionService_StopWorkSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workSessionServiceClient) CreateWorkSession(ctx context.Context, in *CreateWorkSessionRequest, opts ...grpc.CallOption) (*WorkSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkSession)
+	err := c.cc.Invoke(ctx, WorkSessionService_CreateWorkSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workSessionServiceClient) UpdateWorkSession(ctx context.Context, in *UpdateWorkSessionRequest, opts ...grpc.CallOption) (*WorkSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkSession)
+	err := c.cc.Invoke(ctx, WorkSessionService_UpdateWorkSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workSessionServiceClient) DeleteWorkSession(ctx context.Context, in *DeleteWorkSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkSessionService_DeleteWorkSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workSessionServiceClient) ListWorkSessions(ctx context.Context, in *ListWorkSessionsRequest, opts ...grpc.CallOption) (*ListWorkSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWorkSessionsResponse)
+	err := c.cc.Invoke(ctx, WorkSessionService_ListWorkSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workSessionServiceClient) SetWorkSessionsLock(ctx context.Context, in *SetWorkSessionsLockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkSessionService_SetWorkSessionsLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workSessionServiceClient) ListWorkTimeTotals(ctx context.Context, in *ListWorkTimeTotalsRequest, opts ...grpc.CallOption) (*ListWorkTimeTotalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWorkTimeTotalsResponse)
+	err := c.cc.Invoke(ctx, WorkSessionService_ListWorkTimeTotals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkSessionServiceServer is the server API for WorkSessionService service.
+// All implementations must embed UnimplementedWorkSessionServiceServer
+// for forward compatibility.
+type WorkSessionServiceServer interface {
+	StartWorkSession(context.Context, *StartWorkSessionRequest) (*WorkSession, error)
+	StopWorkSession(context.Context, *StopWorkSessionRequest) (*WorkSession, error)
+	CreateWorkSession(context.Context, *CreateWorkSessionRequest) (*WorkSession, error)
+	UpdateWorkSession(context.Context, *UpdateWorkSessionRequest) (*WorkSession, error)
+	DeleteWorkSession(context.Context, *DeleteWorkSessionRequest) (*emptypb.Empty, error)
+	ListWorkSessions(context.Context, *ListWorkSessionsRequest) (*ListWorkSessionsResponse, error)
+	SetWorkSessionsLock(context.Context, *SetWorkSessionsLockRequest) (*emptypb.Empty, error)
+	ListWorkTimeTotals(context.Context, *ListWorkTimeTotalsRequest) (*ListWorkTimeTotalsResponse, error)
+	mustEmbedUnimplementedWorkSessionServiceServer()
+}
+
+// UnimplementedWorkSessionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWorkSessionServiceServer struct{}
+
+func (UnimplementedWorkSessionServiceServer) StartWorkSession(context.Context, *StartWorkSessionRequest) (*WorkSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartWorkSession not implemented")
+}
+func (UnimplementedWorkSessionServiceServer) StopWorkSession(context.Context, *StopWorkSessionRequest) (*WorkSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopWorkSession not implemented")
+}
+func (UnimplementedWorkSessionServiceServer) CreateWorkSession(context.Context, *CreateWorkSessionRequest) (*WorkSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkSession not implemented")
+}
+func (UnimplementedWorkSessionServiceServer) UpdateWorkSession(context.Context, *UpdateWorkSessionRequest) (*WorkSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkSession not implemented")
+}
+func (UnimplementedWorkSessionServiceServer) DeleteWorkSession(context.Context, *DeleteWorkSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkSession not implemented")
+}
+func (UnimplementedWorkSessionServiceServer) ListWorkSessions(context.Context, *ListWorkSessionsRequest) (*ListWorkSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkSessions not implemented")
+}
+func (UnimplementedWorkSessionServiceServer) SetWorkSessionsLock(context.Context, *SetWorkSessionsLockRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWorkSessionsLock not implemented")
+}
+func (UnimplementedWorkSessionServiceServer) ListWorkTimeTotals(context.Context, *ListWorkTimeTotalsRequest) (*ListWorkTimeTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkTimeTotals not implemented")
+}
+func (UnimplementedWorkSessionServiceServer) mustEmbedUnimplementedWorkSessionServiceServer() {}
+func (UnimplementedWorkSessionServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeWorkSessionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorkSessionServiceServer will
+// result in compilation errors.
+type UnsafeWorkSessionServiceServer interface {
+	mustEmbedUnimplementedWorkSessionServiceServer()
+}
+
+func RegisterWorkSessionServiceServer(s grpc.ServiceRegistrar, srv WorkSessionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWorkSessionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WorkSessionService_ServiceDesc, srv)
+}
+
+func _WorkSessionService_StartWorkSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartWorkSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkSessionServiceServer).StartWorkSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkSessionService_StartWorkSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkSessionServiceServer).StartWorkSession(ctx, req.(*StartWorkSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkSessionService_StopWorkSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopWorkSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkSessionServiceServer).StopWorkSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkSessionService_StopWorkSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkSessionServiceServer).StopWorkSession(ctx, req.(*StopWorkSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkSessionService_CreateWorkSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkSessionServiceServer).CreateWorkSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkSessionService_CreateWorkSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkSessionServiceServer).CreateWorkSession(ctx, req.(*CreateWorkSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkSessionService_UpdateWorkSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkSessionServiceServer).UpdateWorkSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkSessionService_UpdateWorkSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkSessionServiceServer).UpdateWorkSession(ctx, req.(*UpdateWorkSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkSessionService_DeleteWorkSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkSessionServiceServer).DeleteWorkSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkSessionService_DeleteWorkSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkSessionServiceServer).DeleteWorkSession(ctx, req.(*DeleteWorkSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkSessionService_ListWorkSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkSessionServiceServer).ListWorkSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkSessionService_ListWorkSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkSessionServiceServer).ListWorkSessions(ctx, req.(*ListWorkSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkSessionService_SetWorkSessionsLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWorkSessionsLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkSessionServiceServer).SetWorkSessionsLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkSessionService_SetWorkSessionsLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkSessionServiceServer).SetWorkSessionsLock(ctx, req.(*SetWorkSessionsLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkSessionService_ListWorkTimeTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkTimeTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkSessionServiceServer).ListWorkTimeTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkSessionService_ListWorkTimeTotals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkSessionServiceServer).ListWorkTimeTotals(ctx, req.(*ListWorkTimeTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WorkSessionService_ServiceDesc is the grpc.ServiceDesc for WorkSessionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WorkSessionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tasksapi.WorkSessionService",
+	HandlerType: (*WorkSessionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartWorkSession",
+			Handler:    _WorkSessionService_StartWorkSession_Handler,
+		},
+		{
+			MethodName: "StopWorkSession",
+			Handler:    _WorkSessionService_StopWorkSession_Handler,
+		},
+		{
+			MethodName: "CreateWorkSession",
+			Handler:    _WorkSessionService_CreateWorkSession_Handler,
+		},
+		{
+			MethodName: "UpdateWorkSession",
+			Handler:    _WorkSessionService_UpdateWorkSession_Handler,
+		},
+		{
+			MethodName: "DeleteWorkSession",
+			Handler:    _WorkSessionService_DeleteWorkSession_Handler,
+		},
+		{
+			MethodName: "ListWorkSessions",
+			Handler:    _WorkSessionService_ListWorkSessions_Handler,
+		},
+		{
+			MethodName: "SetWorkSessionsLock",
+			Handler:    _WorkSessionService_SetWorkSessionsLock_Handler,
+		},
+		{
+			MethodName: "ListWorkTimeTotals",
+			Handler:    _WorkSessionService_ListWorkTimeTotals_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "work_session_service.proto",
+}
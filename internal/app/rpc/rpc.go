@@ -7,6 +7,8 @@ import (
 	"github.com/danielmesquitta/tasks-api/internal/app/rpc/interceptor"
 	"github.com/danielmesquitta/tasks-api/internal/app/rpc/pb"
 	"github.com/danielmesquitta/tasks-api/internal/app/rpc/service"
+	"github.com/danielmesquitta/tasks-api/internal/app/scheduler"
+	"github.com/danielmesquitta/tasks-api/internal/app/subscriber"
 	"github.com/danielmesquitta/tasks-api/internal/config"
 	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/hasher"
@@ -54,10 +56,46 @@ func Start() {
 			mysqlrepo.NewMySQLTaskRepo,
 			fx.As(new(repo.TaskRepo)),
 		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLTaskEventRepo,
+			fx.As(new(repo.TaskEventRepo)),
+		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLCommentRepo,
+			fx.As(new(repo.CommentRepo)),
+		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLChecklistRepo,
+			fx.As(new(repo.ChecklistRepo)),
+		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLTaskDependencyRepo,
+			fx.As(new(repo.TaskDependencyRepo)),
+		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLLabelRepo,
+			fx.As(new(repo.LabelRepo)),
+		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLWorkSessionRepo,
+			fx.As(new(repo.WorkSessionRepo)),
+		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLProjectRepo,
+			fx.As(new(repo.ProjectRepo)),
+		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLRecurringTaskRepo,
+			fx.As(new(repo.RecurringTaskRepo)),
+		),
 		fx.Annotate(
 			mysqlrepo.NewMySQLUserRepo,
 			fx.As(new(repo.UserRepo)),
 		),
+		fx.Annotate(
+			mysqlrepo.NewMySQLOrganizationRepo,
+			fx.As(new(repo.OrganizationRepo)),
+		),
 
 		fx.Annotate(
 			clibroker.NewCLIMessageBroker,
@@ -67,18 +105,65 @@ func Start() {
 		// Use cases
 		usecase.NewListTasks,
 		usecase.NewAuthenticate,
+		usecase.NewRefreshToken,
 		usecase.NewCreateUser,
 		usecase.NewCreateTask,
 		usecase.NewFinishTask,
+		usecase.NewTransitionTask,
+		usecase.NewReopenTask,
+		usecase.NewReassignTask,
+		usecase.NewDeleteTask,
+		usecase.NewBulkTasks,
+		usecase.NewUnblockDependentTasks,
+		usecase.NewRunRecurringTasks,
+		usecase.NewNotifyOverdueTasks,
+		usecase.NewCreateComment,
+		usecase.NewListComments,
+		usecase.NewCreateProject,
+		usecase.NewListProjects,
+		usecase.NewGetProject,
+		usecase.NewUpdateProject,
+		usecase.NewDeleteProject,
+		usecase.NewListProjectMembers,
+		usecase.NewAddProjectMember,
+		usecase.NewRemoveProjectMember,
+		usecase.NewStartWorkSession,
+		usecase.NewStopWorkSession,
+		usecase.NewCreateWorkSession,
+		usecase.NewUpdateWorkSession,
+		usecase.NewDeleteWorkSession,
+		usecase.NewListWorkSessions,
+		usecase.NewListWorkTimeTotals,
+		usecase.NewSetWorkSessionsLock,
 
 		// Interceptors
 		interceptor.NewInterceptor,
 
 		// Services
+		fx.Annotate(
+			service.NewAuthService,
+			fx.As(new(pb.AuthServiceServer)),
+		),
 		fx.Annotate(
 			service.NewUserService,
 			fx.As(new(pb.UserServiceServer)),
 		),
+		fx.Annotate(
+			service.NewTaskService,
+			fx.As(new(pb.TaskServiceServer)),
+		),
+		fx.Annotate(
+			service.NewCommentService,
+			fx.As(new(pb.CommentServiceServer)),
+		),
+		fx.Annotate(
+			service.NewProjectService,
+			fx.As(new(pb.ProjectServiceServer)),
+		),
+		fx.Annotate(
+			service.NewWorkSessionService,
+			fx.As(new(pb.WorkSessionServiceServer)),
+		),
 		fx.Annotate(
 			service.NewHealthCheckService,
 			fx.As(new(pb.HealthCheckServiceServer)),
@@ -90,6 +175,8 @@ func Start() {
 
 	container := fx.New(
 		depsProvider,
+		fx.Invoke(subscriber.Register),
+		fx.Invoke(scheduler.Register),
 		fx.Invoke(func(*grpc.Server) {}),
 	)
 
@@ -19,17 +19,33 @@ func NewServer(
 	lc fx.Lifecycle,
 	env *config.Env,
 	intercept *interceptor.Interceptor,
+	authService pb.AuthServiceServer,
 	userService pb.UserServiceServer,
+	taskService pb.TaskServiceServer,
+	commentService pb.CommentServiceServer,
+	projectService pb.ProjectServiceServer,
+	workSessionService pb.WorkSessionServiceServer,
 	healthService pb.HealthCheckServiceServer,
 ) *grpc.Server {
-	intercept.AllowedRolesByMethod = map[string][]entity.Role{}
+	intercept.AllowedRolesByMethod = allowedRolesByMethod()
 
 	server := grpc.NewServer(
-		grpc.UnaryInterceptor(intercept.UnaryEnsureJWTAuthentication),
-		grpc.StreamInterceptor(intercept.StreamEnsureJWTAuthentication),
+		grpc.ChainUnaryInterceptor(
+			intercept.UnaryMapErrors,
+			intercept.UnaryEnsureJWTAuthentication,
+		),
+		grpc.ChainStreamInterceptor(
+			intercept.StreamMapErrors,
+			intercept.StreamEnsureJWTAuthentication,
+		),
 	)
 
+	pb.RegisterAuthServiceServer(server, authService)
 	pb.RegisterUserServiceServer(server, userService)
+	pb.RegisterTaskServiceServer(server, taskService)
+	pb.RegisterCommentServiceServer(server, commentService)
+	pb.RegisterProjectServiceServer(server, projectService)
+	pb.RegisterWorkSessionServiceServer(server, workSessionService)
 	pb.RegisterHealthCheckServiceServer(server, healthService)
 
 	reflection.Register(server)
@@ -59,3 +75,91 @@ func NewServer(
 
 	return server
 }
+
+// allowedRolesByMethod returns the roles allowed to call each method.
+// Methods not listed here, such as the AuthService ones, are public and
+// do not require authentication.
+func allowedRolesByMethod() map[string][]entity.Role {
+	return map[string][]entity.Role{
+		pb.TaskService_ListTasks_FullMethodName: {
+			entity.RoleManager,
+			entity.RoleTechnician,
+		},
+		pb.TaskService_CreateTask_FullMethodName: {
+			entity.RoleManager,
+		},
+		pb.TaskService_MarkTaskAsFinished_FullMethodName: {
+			entity.RoleTechnician,
+		},
+		pb.TaskService_TransitionTask_FullMethodName: {
+			entity.RoleManager,
+			entity.RoleTechnician,
+		},
+		pb.TaskService_ReopenTask_FullMethodName: {
+			entity.RoleManager,
+			entity.RoleTechnician,
+		},
+		pb.TaskService_BulkTasks_FullMethodName: {
+			entity.RoleManager,
+			entity.RoleTechnician,
+		},
+		pb.CommentService_CreateComment_FullMethodName: {
+			entity.RoleManager,
+			entity.RoleTechnician,
+		},
+		pb.CommentService_ListComments_FullMethodName: {
+			entity.RoleManager,
+			entity.RoleTechnician,
+		},
+		pb.ProjectService_CreateProject_FullMethodName: {
+			entity.RoleManager,
+		},
+		pb.ProjectService_ListProjects_FullMethodName: {
+			entity.RoleManager,
+		},
+		pb.ProjectService_GetProject_FullMethodName: {
+			entity.RoleManager,
+		},
+		pb.ProjectService_UpdateProject_FullMethodName: {
+			entity.RoleManager,
+		},
+		pb.ProjectService_DeleteProject_FullMethodName: {
+			entity.RoleManager,
+		},
+		pb.ProjectService_ListProjectMembers_FullMethodName: {
+			entity.RoleManager,
+		},
+		pb.ProjectService_AddProjectMember_FullMethodName: {
+			entity.RoleManager,
+		},
+		pb.ProjectService_RemoveProjectMember_FullMethodName: {
+			entity.RoleManager,
+		},
+		pb.WorkSessionService_StartWorkSession_FullMethodName: {
+			entity.RoleTechnician,
+		},
+		pb.WorkSessionService_StopWorkSession_FullMethodName: {
+			entity.RoleTechnician,
+		},
+		pb.WorkSessionService_CreateWorkSession_FullMethodName: {
+			entity.RoleTechnician,
+		},
+		pb.WorkSessionService_UpdateWorkSession_FullMethodName: {
+			entity.RoleTechnician,
+		},
+		pb.WorkSessionService_DeleteWorkSession_FullMethodName: {
+			entity.RoleTechnician,
+		},
+		pb.WorkSessionService_ListWorkSessions_FullMethodName: {
+			entity.RoleManager,
+			entity.RoleTechnician,
+		},
+		pb.WorkSessionService_SetWorkSessionsLock_FullMethodName: {
+			entity.RoleManager,
+		},
+		pb.WorkSessionService_ListWorkTimeTotals_FullMethodName: {
+			entity.RoleManager,
+			entity.RoleTechnician,
+		},
+	}
+}
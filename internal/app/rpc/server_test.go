@@ -0,0 +1,121 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/app/rpc/interceptor"
+	"github.com/danielmesquitta/tasks-api/internal/app/rpc/pb"
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/tenant"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryEnsureJWTAuthentication_BulkTasks(t *testing.T) {
+	j := jwtutil.NewJWT(&config.Env{JWTSecretKey: "mysecretkey"})
+
+	intercept := interceptor.NewInterceptor(j)
+	intercept.AllowedRolesByMethod = allowedRolesByMethod()
+
+	newAccessToken := func(role entity.Role, organizationID string) string {
+		accessToken, err := j.NewAccessToken(jwtutil.UserClaims{
+			Role:           role,
+			OrganizationID: organizationID,
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    uuid.NewString(),
+				IssuedAt:  jwt.NewNumericDate(time.Now()),
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return accessToken
+	}
+
+	organizationID := uuid.NewString()
+
+	tests := []struct {
+		name        string
+		accessToken string
+		wantCode    codes.Code
+	}{
+		{
+			name:        "should authenticate a manager",
+			accessToken: newAccessToken(entity.RoleManager, organizationID),
+			wantCode:    codes.OK,
+		},
+		{
+			name:        "should authenticate a technician",
+			accessToken: newAccessToken(entity.RoleTechnician, organizationID),
+			wantCode:    codes.OK,
+		},
+		{
+			name:        "should not authenticate without a token",
+			accessToken: "",
+			wantCode:    codes.Unauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.accessToken != "" {
+				ctx = metadata.NewIncomingContext(
+					ctx,
+					metadata.Pairs("authorization", tt.accessToken),
+				)
+			}
+
+			handlerCalled := false
+			handler := func(ctx context.Context, _ any) (any, error) {
+				handlerCalled = true
+
+				if _, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims); !ok {
+					t.Errorf("UnaryEnsureJWTAuthentication() claims not set")
+				}
+
+				if got, _ := tenant.OrganizationID(ctx); got != organizationID {
+					t.Errorf(
+						"UnaryEnsureJWTAuthentication() organization id = %v, want %v",
+						got,
+						organizationID,
+					)
+				}
+
+				return nil, nil
+			}
+
+			_, err := intercept.UnaryEnsureJWTAuthentication(
+				ctx,
+				&pb.BulkTasksRequest{},
+				&grpc.UnaryServerInfo{
+					FullMethod: pb.TaskService_BulkTasks_FullMethodName,
+				},
+				handler,
+			)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf(
+					"UnaryEnsureJWTAuthentication() code = %v, want %v",
+					code,
+					tt.wantCode,
+				)
+			}
+
+			if wantCalled := tt.wantCode == codes.OK; handlerCalled != wantCalled {
+				t.Errorf(
+					"UnaryEnsureJWTAuthentication() handler called = %v, want %v",
+					handlerCalled,
+					wantCalled,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/app/rpc/pb"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+)
+
+type AuthService struct {
+	pb.UnimplementedAuthServiceServer
+	authenticateUseCase *usecase.Authenticate
+	refreshTokenUseCase *usecase.RefreshToken
+}
+
+func NewAuthService(
+	authenticateUseCase *usecase.Authenticate,
+	refreshTokenUseCase *usecase.RefreshToken,
+) *AuthService {
+	return &AuthService{
+		authenticateUseCase: authenticateUseCase,
+		refreshTokenUseCase: refreshTokenUseCase,
+	}
+}
+
+func (s *AuthService) Login(
+	ctx context.Context,
+	req *pb.LoginRequest,
+) (*pb.LoginResponse, error) {
+	accessToken, refreshToken, err := s.authenticateUseCase.Execute(
+		ctx,
+		usecase.AuthenticateParams{
+			Email:    req.GetUsername(),
+			Password: req.GetPassword(),
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return &pb.LoginResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+func (s *AuthService) RefreshToken(
+	ctx context.Context,
+	req *pb.RefreshTokenRequest,
+) (*pb.RefreshTokenResponse, error) {
+	accessToken, refreshToken, err := s.refreshTokenUseCase.Execute(
+		ctx,
+		usecase.RefreshTokenParams{
+			RefreshToken: req.GetRefreshToken(),
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return &pb.RefreshTokenResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
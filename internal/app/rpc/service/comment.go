@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/app/rpc/interceptor"
+	"github.com/danielmesquitta/tasks-api/internal/app/rpc/pb"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type CommentService struct {
+	pb.UnimplementedCommentServiceServer
+	createCommentUseCase *usecase.CreateComment
+	listCommentsUseCase  *usecase.ListComments
+}
+
+func NewCommentService(
+	createCommentUseCase *usecase.CreateComment,
+	listCommentsUseCase *usecase.ListComments,
+) *CommentService {
+	return &CommentService{
+		createCommentUseCase: createCommentUseCase,
+		listCommentsUseCase:  listCommentsUseCase,
+	}
+}
+
+func (s *CommentService) CreateComment(
+	ctx context.Context,
+	req *pb.CreateCommentRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	err := s.createCommentUseCase.Execute(ctx, usecase.CreateCommentParams{
+		TaskID:   req.GetTaskId(),
+		UserID:   claims.Issuer,
+		UserRole: claims.Role,
+		Body:     req.GetBody(),
+	})
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CommentService) ListComments(
+	ctx context.Context,
+	req *pb.ListCommentsRequest,
+) (*pb.ListCommentsResponse, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	comments, err := s.listCommentsUseCase.Execute(
+		ctx,
+		usecase.ListCommentsParams{
+			TaskID:   req.GetTaskId(),
+			UserID:   claims.Issuer,
+			UserRole: claims.Role,
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	data := make([]*pb.Comment, len(comments))
+	for i, comment := range comments {
+		data[i] = &pb.Comment{
+			Id:        comment.ID,
+			TaskId:    comment.TaskID,
+			UserId:    comment.UserID,
+			Body:      comment.Body,
+			CreatedAt: comment.CreatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return &pb.ListCommentsResponse{Data: data}, nil
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+)
+
+// itemErrorMessage returns the message reported for an item of a batch
+// request, logging the unexpected errors the same way the error
+// interceptor does for whole requests.
+func itemErrorMessage(method string, err error, attrs ...any) string {
+	message, unexpected := entity.ItemErrorMessage(err)
+	if unexpected {
+		appErr := &entity.Err{}
+		_ = errors.As(err, &appErr)
+
+		attrs = append(
+			attrs,
+			slog.String("method", method),
+			slog.String("stacktrace", appErr.StackTrace),
+		)
+		slog.Error(err.Error(), attrs...)
+	}
+
+	return message
+}
@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/app/rpc/interceptor"
+	"github.com/danielmesquitta/tasks-api/internal/app/rpc/pb"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type ProjectService struct {
+	pb.UnimplementedProjectServiceServer
+	createProjectUseCase       *usecase.CreateProject
+	listProjectsUseCase        *usecase.ListProjects
+	getProjectUseCase          *usecase.GetProject
+	updateProjectUseCase       *usecase.UpdateProject
+	deleteProjectUseCase       *usecase.DeleteProject
+	listProjectMembersUseCase  *usecase.ListProjectMembers
+	addProjectMemberUseCase    *usecase.AddProjectMember
+	removeProjectMemberUseCase *usecase.RemoveProjectMember
+}
+
+func NewProjectService(
+	createProjectUseCase *usecase.CreateProject,
+	listProjectsUseCase *usecase.ListProjects,
+	getProjectUseCase *usecase.GetProject,
+	updateProjectUseCase *usecase.UpdateProject,
+	deleteProjectUseCase *usecase.DeleteProject,
+	listProjectMembersUseCase *usecase.ListProjectMembers,
+	addProjectMemberUseCase *usecase.AddProjectMember,
+	removeProjectMemberUseCase *usecase.RemoveProjectMember,
+) *ProjectService {
+	return &ProjectService{
+		createProjectUseCase:       createProjectUseCase,
+		listProjectsUseCase:        listProjectsUseCase,
+		getProjectUseCase:          getProjectUseCase,
+		updateProjectUseCase:       updateProjectUseCase,
+		deleteProjectUseCase:       deleteProjectUseCase,
+		listProjectMembersUseCase:  listProjectMembersUseCase,
+		addProjectMemberUseCase:    addProjectMemberUseCase,
+		removeProjectMemberUseCase: removeProjectMemberUseCase,
+	}
+}
+
+func (s *ProjectService) CreateProject(
+	ctx context.Context,
+	req *pb.CreateProjectRequest,
+) (*pb.Project, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	project, err := s.createProjectUseCase.Execute(
+		ctx,
+		usecase.CreateProjectParams{
+			UserRole:    claims.Role,
+			UserID:      claims.Issuer,
+			Name:        req.GetName(),
+			Description: req.GetDescription(),
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return projectToPB(project), nil
+}
+
+func (s *ProjectService) ListProjects(
+	ctx context.Context,
+	_ *pb.ListProjectsRequest,
+) (*pb.ListProjectsResponse, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	projects, err := s.listProjectsUseCase.Execute(
+		ctx,
+		usecase.ListProjectsParams{
+			UserRole: claims.Role,
+			UserID:   claims.Issuer,
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	data := make([]*pb.Project, len(projects))
+	for i, project := range projects {
+		data[i] = projectToPB(project)
+	}
+
+	return &pb.ListProjectsResponse{Data: data}, nil
+}
+
+func (s *ProjectService) GetProject(
+	ctx context.Context,
+	req *pb.GetProjectRequest,
+) (*pb.Project, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	project, err := s.getProjectUseCase.Execute(ctx, usecase.GetProjectParams{
+		ID:       req.GetId(),
+		UserRole: claims.Role,
+		UserID:   claims.Issuer,
+	})
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return projectToPB(project), nil
+}
+
+func (s *ProjectService) UpdateProject(
+	ctx context.Context,
+	req *pb.UpdateProjectRequest,
+) (*pb.Project, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	project, err := s.updateProjectUseCase.Execute(
+		ctx,
+		usecase.UpdateProjectParams{
+			ID:            req.GetId(),
+			UserRole:      claims.Role,
+			UserID:        claims.Issuer,
+			Name:          req.GetName(),
+			Description:   req.GetDescription(),
+			ManagerUserID: req.GetManagerUserId(),
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return projectToPB(project), nil
+}
+
+func (s *ProjectService) DeleteProject(
+	ctx context.Context,
+	req *pb.DeleteProjectRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	err := s.deleteProjectUseCase.Execute(ctx, usecase.DeleteProjectParams{
+		ID:       req.GetId(),
+		UserRole: claims.Role,
+		UserID:   claims.Issuer,
+	})
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ProjectService) ListProjectMembers(
+	ctx context.Context,
+	req *pb.ListProjectMembersRequest,
+) (*pb.ListProjectMembersResponse, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	members, err := s.listProjectMembersUseCase.Execute(
+		ctx,
+		usecase.ListProjectMembersParams{
+			ProjectID: req.GetProjectId(),
+			UserRole:  claims.Role,
+			UserID:    claims.Issuer,
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	data := make([]*pb.ProjectMember, len(members))
+	for i, member := range members {
+		data[i] = projectMemberToPB(member)
+	}
+
+	return &pb.ListProjectMembersResponse{Data: data}, nil
+}
+
+func (s *ProjectService) AddProjectMember(
+	ctx context.Context,
+	req *pb.AddProjectMemberRequest,
+) (*pb.ProjectMember, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	member, err := s.addProjectMemberUseCase.Execute(
+		ctx,
+		usecase.AddProjectMemberParams{
+			ProjectID:    req.GetProjectId(),
+			UserRole:     claims.Role,
+			UserID:       claims.Issuer,
+			MemberUserID: req.GetUserId(),
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return projectMemberToPB(member), nil
+}
+
+func (s *ProjectService) RemoveProjectMember(
+	ctx context.Context,
+	req *pb.RemoveProjectMemberRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	err := s.removeProjectMemberUseCase.Execute(
+		ctx,
+		usecase.RemoveProjectMemberParams{
+			ProjectID:    req.GetProjectId(),
+			UserRole:     claims.Role,
+			UserID:       claims.Issuer,
+			MemberUserID: req.GetUserId(),
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func projectToPB(project entity.Project) *pb.Project {
+	return &pb.Project{
+		Id:            project.ID,
+		Name:          project.Name,
+		Description:   project.Description,
+		ManagerUserId: project.ManagerUserID,
+		CreatedAt:     project.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     project.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func projectMemberToPB(member entity.ProjectMember) *pb.ProjectMember {
+	return &pb.ProjectMember{
+		ProjectId: member.ProjectID,
+		UserId:    member.UserID,
+		CreatedAt: member.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/app/rpc/interceptor"
+	"github.com/danielmesquitta/tasks-api/internal/app/rpc/pb"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type TaskService struct {
+	pb.UnimplementedTaskServiceServer
+	listTasksUseCase  *usecase.ListTasks
+	createTaskUseCase *usecase.CreateTask
+	finishTaskUseCase *usecase.FinishTask
+	transitionUseCase *usecase.TransitionTask
+	reopenUseCase     *usecase.ReopenTask
+	bulkTasksUseCase  *usecase.BulkTasks
+}
+
+func NewTaskService(
+	listTasksUseCase *usecase.ListTasks,
+	createTaskUseCase *usecase.CreateTask,
+	finishTaskUseCase *usecase.FinishTask,
+	transitionUseCase *usecase.TransitionTask,
+	reopenUseCase *usecase.ReopenTask,
+	bulkTasksUseCase *usecase.BulkTasks,
+) *TaskService {
+	return &TaskService{
+		listTasksUseCase:  listTasksUseCase,
+		createTaskUseCase: createTaskUseCase,
+		finishTaskUseCase: finishTaskUseCase,
+		transitionUseCase: transitionUseCase,
+		reopenUseCase:     reopenUseCase,
+		bulkTasksUseCase:  bulkTasksUseCase,
+	}
+}
+
+func (s *TaskService) ListTasks(
+	ctx context.Context,
+	req *pb.ListTasksRequest,
+) (*pb.ListTasksResponse, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	result, err := s.listTasksUseCase.Execute(ctx, usecase.ListTasksParams{
+		UserRole:      claims.Role,
+		UserID:        claims.Issuer,
+		Limit:         int(req.GetLimit()),
+		Cursor:        req.GetCursor(),
+		LabelIDs:      req.GetLabelIds(),
+		LabelMatch:    repo.LabelMatch(req.GetLabelMatch()),
+		SortBy:        repo.TaskSortField(req.GetSortBy()),
+		SortDirection: repo.SortDirection(req.GetSortDirection()),
+		Priorities:    taskPrioritiesFromPB(req.GetPriorities()),
+		Overdue:       req.GetOverdue(),
+		ProjectID:     req.GetProjectId(),
+	})
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	data := make([]*pb.Task, len(result.Data))
+	for i, task := range result.Data {
+		data[i] = taskToPB(task)
+	}
+
+	return &pb.ListTasksResponse{
+		Data:       data,
+		NextCursor: result.NextCursor,
+		PrevCursor: result.PrevCursor,
+	}, nil
+}
+
+func (s *TaskService) CreateTask(
+	ctx context.Context,
+	req *pb.CreateTaskRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	dueAt, err := timeFromPB(req.GetDueAt())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.createTaskUseCase.Execute(ctx, usecase.CreateTaskParams{
+		UserRole:         claims.Role,
+		Summary:          req.GetSummary(),
+		CreatedByUserID:  claims.Issuer,
+		AssignedToUserID: req.GetAssignedToUserId(),
+		AssigneeIDs:      req.GetAssigneeIds(),
+		LabelIDs:         req.GetLabelIds(),
+		DueAt:            dueAt,
+		Priority:         entity.TaskPriority(req.GetPriority()),
+		FinishPolicy:     entity.TaskFinishPolicy(req.GetFinishPolicy()),
+		ProjectID:        req.GetProjectId(),
+	})
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TaskService) MarkTaskAsFinished(
+	ctx context.Context,
+	req *pb.MarkTaskAsFinishedRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	err := s.finishTaskUseCase.Execute(ctx, usecase.FinishTaskParams{
+		TaskID:   req.GetId(),
+		UserID:   claims.Issuer,
+		UserRole: claims.Role,
+	})
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TaskService) TransitionTask(
+	ctx context.Context,
+	req *pb.TransitionTaskRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	err := s.transitionUseCase.Execute(ctx, usecase.TransitionTaskParams{
+		TaskID:   req.GetId(),
+		UserID:   claims.Issuer,
+		UserRole: claims.Role,
+		Status:   entity.TaskStatus(req.GetStatus()),
+	})
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TaskService) ReopenTask(
+	ctx context.Context,
+	req *pb.ReopenTaskRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	err := s.reopenUseCase.Execute(ctx, usecase.ReopenTaskParams{
+		TaskID:   req.GetId(),
+		UserID:   claims.Issuer,
+		UserRole: claims.Role,
+		Reason:   req.GetReason(),
+	})
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TaskService) BulkTasks(
+	ctx context.Context,
+	req *pb.BulkTasksRequest,
+) (*pb.BulkTasksResponse, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	items := make([]usecase.BulkTaskItem, len(req.GetItems()))
+	for i, item := range req.GetItems() {
+		items[i] = usecase.BulkTaskItem{
+			TaskID:           item.GetTaskId(),
+			Summary:          item.GetSummary(),
+			AssignedToUserID: item.GetAssignedToUserId(),
+			LabelIDs:         item.GetLabelIds(),
+		}
+	}
+
+	result, err := s.bulkTasksUseCase.Execute(ctx, usecase.BulkTasksParams{
+		UserID:    claims.Issuer,
+		UserRole:  claims.Role,
+		Operation: usecase.BulkTaskOperation(req.GetOperation()),
+		Mode:      usecase.BulkMode(req.GetMode()),
+		Items:     items,
+	})
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	res := &pb.BulkTasksResponse{
+		Succeeded: int32(result.Succeeded),
+		Failed:    int32(result.Failed),
+		Results:   make([]*pb.BulkTaskItemResult, len(result.Results)),
+	}
+	for i, itemResult := range result.Results {
+		res.Results[i] = &pb.BulkTaskItemResult{
+			Index:  int32(itemResult.Index),
+			TaskId: itemResult.TaskID,
+		}
+
+		if itemResult.Err != nil {
+			res.Results[i].Error = itemErrorMessage(
+				"BulkTasks",
+				itemResult.Err,
+				slog.Int("index", itemResult.Index),
+			)
+		}
+	}
+
+	return res, nil
+}
+
+// taskToPB converts a task entity to its protobuf representation,
+// formatting dates as RFC 3339 strings and leaving unset values empty.
+func taskToPB(task entity.Task) *pb.Task {
+	pbTask := &pb.Task{
+		Id:                   task.ID,
+		Summary:              task.Summary,
+		Status:               string(task.Status),
+		Priority:             string(task.Priority),
+		Overdue:              task.Overdue,
+		CreatedByUserId:      task.CreatedByUserID,
+		AssigneeIds:          task.AssigneeIDs,
+		SignedOffUserIds:     task.SignedOffUserIDs,
+		FinishPolicy:         string(task.FinishPolicy),
+		UpdatedAt:            task.UpdatedAt.Format(time.RFC3339),
+		ChecklistProgress:    task.ChecklistProgress,
+		WorkSessionsUnlocked: task.WorkSessionsUnlocked,
+		Version:              task.Version,
+	}
+
+	if task.AssignedToUserID != nil {
+		pbTask.AssignedToUserId = *task.AssignedToUserID
+	}
+
+	if task.FinishedAt != nil {
+		pbTask.FinishedAt = task.FinishedAt.Format(time.RFC3339)
+	}
+
+	if task.ReopenReason != nil {
+		pbTask.ReopenReason = *task.ReopenReason
+	}
+
+	if task.ReopenedAt != nil {
+		pbTask.ReopenedAt = task.ReopenedAt.Format(time.RFC3339)
+	}
+
+	if task.DueAt != nil {
+		pbTask.DueAt = task.DueAt.Format(time.RFC3339)
+	}
+
+	if task.ProjectID != nil {
+		pbTask.ProjectId = *task.ProjectID
+	}
+
+	for _, label := range task.Labels {
+		pbTask.Labels = append(pbTask.Labels, label.Name)
+	}
+
+	return pbTask
+}
+
+// timeFromPB parses an optional RFC 3339 date, returning nil if empty.
+func timeFromPB(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return nil, validationErr
+	}
+
+	return &parsed, nil
+}
+
+func taskPrioritiesFromPB(values []string) []entity.TaskPriority {
+	if len(values) == 0 {
+		return nil
+	}
+
+	priorities := make([]entity.TaskPriority, len(values))
+	for i, value := range values {
+		priorities[i] = entity.TaskPriority(value)
+	}
+
+	return priorities
+}
@@ -0,0 +1,344 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/app/rpc/interceptor"
+	"github.com/danielmesquitta/tasks-api/internal/app/rpc/pb"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type WorkSessionService struct {
+	pb.UnimplementedWorkSessionServiceServer
+	startWorkSessionUseCase    *usecase.StartWorkSession
+	stopWorkSessionUseCase     *usecase.StopWorkSession
+	createWorkSessionUseCase   *usecase.CreateWorkSession
+	updateWorkSessionUseCase   *usecase.UpdateWorkSession
+	deleteWorkSessionUseCase   *usecase.DeleteWorkSession
+	listWorkSessionsUseCase    *usecase.ListWorkSessions
+	listWorkTimeTotalsUseCase  *usecase.ListWorkTimeTotals
+	setWorkSessionsLockUseCase *usecase.SetWorkSessionsLock
+}
+
+func NewWorkSessionService(
+	startWorkSessionUseCase *usecase.StartWorkSession,
+	stopWorkSessionUseCase *usecase.StopWorkSession,
+	createWorkSessionUseCase *usecase.CreateWorkSession,
+	updateWorkSessionUseCase *usecase.UpdateWorkSession,
+	deleteWorkSessionUseCase *usecase.DeleteWorkSession,
+	listWorkSessionsUseCase *usecase.ListWorkSessions,
+	listWorkTimeTotalsUseCase *usecase.ListWorkTimeTotals,
+	setWorkSessionsLockUseCase *usecase.SetWorkSessionsLock,
+) *WorkSessionService {
+	return &WorkSessionService{
+		startWorkSessionUseCase:    startWorkSessionUseCase,
+		stopWorkSessionUseCase:     stopWorkSessionUseCase,
+		createWorkSessionUseCase:   createWorkSessionUseCase,
+		updateWorkSessionUseCase:   updateWorkSessionUseCase,
+		deleteWorkSessionUseCase:   deleteWorkSessionUseCase,
+		listWorkSessionsUseCase:    listWorkSessionsUseCase,
+		listWorkTimeTotalsUseCase:  listWorkTimeTotalsUseCase,
+		setWorkSessionsLockUseCase: setWorkSessionsLockUseCase,
+	}
+}
+
+func (s *WorkSessionService) StartWorkSession(
+	ctx context.Context,
+	req *pb.StartWorkSessionRequest,
+) (*pb.WorkSession, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	session, err := s.startWorkSessionUseCase.Execute(
+		ctx,
+		usecase.StartWorkSessionParams{
+			TaskID: req.GetTaskId(),
+			UserID: claims.Issuer,
+			Note:   stringFromPB(req.GetNote()),
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return workSessionToPB(session), nil
+}
+
+func (s *WorkSessionService) StopWorkSession(
+	ctx context.Context,
+	req *pb.StopWorkSessionRequest,
+) (*pb.WorkSession, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	session, err := s.stopWorkSessionUseCase.Execute(
+		ctx,
+		usecase.StopWorkSessionParams{
+			TaskID: req.GetTaskId(),
+			UserID: claims.Issuer,
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return workSessionToPB(session), nil
+}
+
+func (s *WorkSessionService) CreateWorkSession(
+	ctx context.Context,
+	req *pb.CreateWorkSessionRequest,
+) (*pb.WorkSession, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	startedAt, err := timeFromPB(req.GetStartedAt())
+	if err != nil {
+		return nil, err
+	}
+
+	endedAt, err := timeFromPB(req.GetEndedAt())
+	if err != nil {
+		return nil, err
+	}
+
+	params := usecase.CreateWorkSessionParams{
+		TaskID: req.GetTaskId(),
+		UserID: claims.Issuer,
+		Note:   stringFromPB(req.GetNote()),
+	}
+
+	if startedAt != nil {
+		params.StartedAt = *startedAt
+	}
+
+	if endedAt != nil {
+		params.EndedAt = *endedAt
+	}
+
+	session, err := s.createWorkSessionUseCase.Execute(ctx, params)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return workSessionToPB(session), nil
+}
+
+func (s *WorkSessionService) UpdateWorkSession(
+	ctx context.Context,
+	req *pb.UpdateWorkSessionRequest,
+) (*pb.WorkSession, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	startedAt, err := timeFromPB(req.GetStartedAt())
+	if err != nil {
+		return nil, err
+	}
+
+	endedAt, err := timeFromPB(req.GetEndedAt())
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.updateWorkSessionUseCase.Execute(
+		ctx,
+		usecase.UpdateWorkSessionParams{
+			TaskID:    req.GetTaskId(),
+			SessionID: req.GetSessionId(),
+			UserID:    claims.Issuer,
+			StartedAt: startedAt,
+			EndedAt:   endedAt,
+			Note:      stringFromPB(req.GetNote()),
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return workSessionToPB(session), nil
+}
+
+func (s *WorkSessionService) DeleteWorkSession(
+	ctx context.Context,
+	req *pb.DeleteWorkSessionRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	err := s.deleteWorkSessionUseCase.Execute(
+		ctx,
+		usecase.DeleteWorkSessionParams{
+			TaskID:    req.GetTaskId(),
+			SessionID: req.GetSessionId(),
+			UserID:    claims.Issuer,
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *WorkSessionService) ListWorkSessions(
+	ctx context.Context,
+	req *pb.ListWorkSessionsRequest,
+) (*pb.ListWorkSessionsResponse, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	result, err := s.listWorkSessionsUseCase.Execute(
+		ctx,
+		usecase.ListWorkSessionsParams{
+			TaskID:   req.GetTaskId(),
+			UserID:   claims.Issuer,
+			UserRole: claims.Role,
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	sessions := make([]*pb.WorkSession, len(result.Sessions))
+	for i, session := range result.Sessions {
+		sessions[i] = workSessionToPB(session)
+	}
+
+	return &pb.ListWorkSessionsResponse{
+		TaskId:       result.TaskID,
+		TotalSeconds: result.TotalSeconds,
+		Technicians:  workTimeTotalsToPB(result.Technicians),
+		Sessions:     sessions,
+		Locked:       result.Locked,
+	}, nil
+}
+
+func (s *WorkSessionService) SetWorkSessionsLock(
+	ctx context.Context,
+	req *pb.SetWorkSessionsLockRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	err := s.setWorkSessionsLockUseCase.Execute(
+		ctx,
+		usecase.SetWorkSessionsLockParams{
+			TaskID:   req.GetTaskId(),
+			UserID:   claims.Issuer,
+			UserRole: claims.Role,
+			Locked:   req.GetLocked(),
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *WorkSessionService) ListWorkTimeTotals(
+	ctx context.Context,
+	req *pb.ListWorkTimeTotalsRequest,
+) (*pb.ListWorkTimeTotalsResponse, error) {
+	claims, ok := ctx.Value(interceptor.ClaimsKey).(*jwtutil.UserClaims)
+	if !ok {
+		return nil, entity.NewErr("invalid claims")
+	}
+
+	from, err := timeFromPB(req.GetFrom())
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := timeFromPB(req.GetTo())
+	if err != nil {
+		return nil, err
+	}
+
+	params := usecase.ListWorkTimeTotalsParams{
+		UserID:   claims.Issuer,
+		UserRole: claims.Role,
+	}
+
+	if from != nil {
+		params.From = *from
+	}
+
+	if to != nil {
+		params.To = *to
+	}
+
+	result, err := s.listWorkTimeTotalsUseCase.Execute(ctx, params)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return &pb.ListWorkTimeTotalsResponse{
+		Data:         workTimeTotalsToPB(result.Data),
+		TotalSeconds: result.TotalSeconds,
+	}, nil
+}
+
+func workSessionToPB(session entity.WorkSession) *pb.WorkSession {
+	pbSession := &pb.WorkSession{
+		Id:              session.ID,
+		TaskId:          session.TaskID,
+		UserId:          session.UserID,
+		StartedAt:       session.StartedAt.Format(time.RFC3339),
+		Manual:          session.Manual,
+		DurationSeconds: session.DurationSeconds,
+		CreatedAt:       session.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       session.UpdatedAt.Format(time.RFC3339),
+	}
+
+	if session.EndedAt != nil {
+		pbSession.EndedAt = session.EndedAt.Format(time.RFC3339)
+	}
+
+	if session.Note != nil {
+		pbSession.Note = *session.Note
+	}
+
+	return pbSession
+}
+
+func workTimeTotalsToPB(totals []entity.WorkTimeTotal) []*pb.WorkTimeTotal {
+	pbTotals := make([]*pb.WorkTimeTotal, len(totals))
+	for i, total := range totals {
+		pbTotals[i] = &pb.WorkTimeTotal{
+			UserId:  total.UserID,
+			Seconds: total.Seconds,
+		}
+	}
+
+	return pbTotals
+}
+
+// stringFromPB returns nil for an empty string, which proto3 can not tell
+// apart from an unset one.
+func stringFromPB(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
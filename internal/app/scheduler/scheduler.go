@@ -0,0 +1,113 @@
+// Package scheduler runs the periodic jobs of the API, such as creating
+// the tasks of the recurring tasks that are due and notifying the tasks
+// that became overdue.
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/tenant"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+// Register starts the scheduler along with the application and stops it
+// on shutdown. It runs once on start and then every SCHEDULER_INTERVAL,
+// over each organization in turn.
+func Register(
+	lc fx.Lifecycle,
+	env *config.Env,
+	organizationRepo repo.OrganizationRepo,
+	runRecurringTasksUseCase *usecase.RunRecurringTasks,
+	notifyOverdueTasksUseCase *usecase.NotifyOverdueTasks,
+) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	runOrganization := func(organization entity.Organization) {
+		ctx := tenant.WithOrganizationID(ctx, organization.ID)
+
+		count, err := runRecurringTasksUseCase.Execute(ctx, time.Now())
+		if err != nil {
+			log.Printf(
+				"error running recurring tasks of %s: %v\n",
+				organization.Name,
+				err,
+			)
+		}
+		if count > 0 {
+			log.Printf(
+				"created %d recurring task(s) of %s\n",
+				count,
+				organization.Name,
+			)
+		}
+
+		count, err = notifyOverdueTasksUseCase.Execute(ctx, time.Now())
+		if err != nil {
+			log.Printf(
+				"error notifying overdue tasks of %s: %v\n",
+				organization.Name,
+				err,
+			)
+		}
+		if count > 0 {
+			log.Printf(
+				"notified %d overdue task(s) of %s\n",
+				count,
+				organization.Name,
+			)
+		}
+	}
+
+	run := func() {
+		organizations, err := organizationRepo.ListOrganizations(ctx)
+		if err != nil {
+			log.Println("error listing organizations:", err)
+			return
+		}
+
+		for _, organization := range organizations {
+			runOrganization(organization)
+		}
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+
+				ticker := time.NewTicker(env.SchedulerInterval)
+				defer ticker.Stop()
+
+				for {
+					run()
+
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+					}
+				}
+			}()
+
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+}
@@ -0,0 +1,45 @@
+// Package subscriber handles the messages published by the use cases
+// in the same process, such as unblocking the tasks waiting on a
+// finished task.
+package subscriber
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/domain/usecase"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/tenant"
+	"github.com/danielmesquitta/tasks-api/internal/provider/broker"
+)
+
+// Register subscribes the message handlers to their topics.
+func Register(
+	msgBroker broker.MessageBroker,
+	unblockDependentTasksUseCase *usecase.UnblockDependentTasks,
+) error {
+	return msgBroker.Subscribe(
+		broker.TopicTaskFinished,
+		func(message []byte) {
+			task := entity.Task{}
+			if err := json.Unmarshal(message, &task); err != nil {
+				log.Println("invalid task finished message:", err)
+				return
+			}
+
+			// The message is handled in the organization of the task
+			ctx := tenant.WithOrganizationID(
+				context.Background(),
+				task.OrganizationID,
+			)
+
+			if err := unblockDependentTasksUseCase.Execute(
+				ctx,
+				task,
+			); err != nil {
+				log.Println("error unblocking dependent tasks:", err)
+			}
+		},
+	)
+}
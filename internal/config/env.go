@@ -3,6 +3,7 @@ package config
 import (
 	"cmp"
 	"os"
+	"time"
 
 	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
 	"github.com/spf13/viper"
@@ -19,14 +20,17 @@ const (
 type Env struct {
 	val validator.Validator
 
-	Environment          Environment `mapstructure:"ENVIRONMENT"`
-	Port                 string      `mapstructure:"PORT"`
-	DBConnection         string      `mapstructure:"DB_CONNECTION"         validate:"required"`
-	CipherSecretKey      string      `mapstructure:"CIPHER_SECRET_KEY"     validate:"required,min=32,max=32"`
-	InitializationVector string      `mapstructure:"INITIALIZATION_VECTOR" validate:"required,min=16,max=16"`
-	JWTSecretKey         string      `mapstructure:"JWT_SECRET_KEY"        validate:"required"`
-	BasicAuthUsername    string      `mapstructure:"BASIC_AUTH_USERNAME"   validate:"required"`
-	BasicAuthPassword    string      `mapstructure:"BASIC_AUTH_PASSWORD"   validate:"required"`
+	Environment          Environment   `mapstructure:"ENVIRONMENT"`
+	Port                 string        `mapstructure:"PORT"`
+	DBConnection         string        `mapstructure:"DB_CONNECTION"         validate:"required"`
+	CipherSecretKey      string        `mapstructure:"CIPHER_SECRET_KEY"     validate:"required,min=32,max=32"`
+	InitializationVector string        `mapstructure:"INITIALIZATION_VECTOR" validate:"required,min=16,max=16"`
+	JWTSecretKey         string        `mapstructure:"JWT_SECRET_KEY"        validate:"required"`
+	BasicAuthUsername    string        `mapstructure:"BASIC_AUTH_USERNAME"   validate:"required"`
+	BasicAuthPassword    string        `mapstructure:"BASIC_AUTH_PASSWORD"   validate:"required"`
+	TaskTrashRetention   time.Duration `mapstructure:"TASK_TRASH_RETENTION"`
+	BlobStorageDir       string        `mapstructure:"BLOB_STORAGE_DIR"`
+	SchedulerInterval    time.Duration `mapstructure:"SCHEDULER_INTERVAL"`
 }
 
 func (e *Env) validate() error {
@@ -39,6 +43,15 @@ func (e *Env) validate() error {
 	if e.Port == "" {
 		e.Port = "8080"
 	}
+	if e.TaskTrashRetention == 0 {
+		e.TaskTrashRetention = 30 * 24 * time.Hour
+	}
+	if e.BlobStorageDir == "" {
+		e.BlobStorageDir = "./storage"
+	}
+	if e.SchedulerInterval == 0 {
+		e.SchedulerInterval = time.Minute
+	}
 	return nil
 }
 
@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+// Attachment holds the metadata of a file attached to a task, the
+// file content itself lives in the blob store.
+type Attachment struct {
+	ID          string    `json:"id,omitempty"`
+	TaskID      string    `json:"task_id,omitempty"`
+	UserID      string    `json:"user_id,omitempty"`
+	FileName    string    `json:"file_name,omitempty"`
+	ContentType string    `json:"content_type,omitempty"`
+	Size        int64     `json:"size,omitempty"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+}
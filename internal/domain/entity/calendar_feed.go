@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+// CalendarFeedToken grants access to the calendar feed of a user. Only
+// the SHA-256 hash of the token is stored, and a user has at most one.
+type CalendarFeedToken struct {
+	UserID    string    `json:"user_id,omitempty"`
+	TokenHash string    `json:"-"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
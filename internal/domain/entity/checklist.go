@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// ChecklistItem is a step of a task, ordered by its position.
+// Required items must be done before the task can be finished.
+type ChecklistItem struct {
+	ID        string     `json:"id,omitempty"`
+	TaskID    string     `json:"task_id,omitempty"`
+	Position  int        `json:"position,omitempty"`
+	Title     string     `json:"title,omitempty"`
+	Required  bool       `json:"required"`
+	Done      bool       `json:"done"`
+	DoneAt    *time.Time `json:"done_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at,omitempty"`
+	UpdatedAt time.Time  `json:"updated_at,omitempty"`
+}
+
+// ChecklistProgress counts the done items of a task checklist.
+type ChecklistProgress struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
+// String formats the progress as done/total, such as 3/5.
+func (p ChecklistProgress) String() string {
+	return fmt.Sprintf("%d/%d", p.Done, p.Total)
+}
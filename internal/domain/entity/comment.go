@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+type Comment struct {
+	ID        string    `json:"id,omitempty"`
+	TaskID    string    `json:"task_id,omitempty"`
+	UserID    string    `json:"user_id,omitempty"`
+	Body      string    `json:"body,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
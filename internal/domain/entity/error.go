@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"errors"
 	"fmt"
 	"runtime/debug"
 )
@@ -19,6 +20,11 @@ const (
 	ErrTypeUnauthorized ErrType = "unauthorized"
 	ErrTypeForbidden    ErrType = "forbidden"
 	ErrTypeValidation   ErrType = "validation_error"
+	ErrTypeConflict     ErrType = "conflict"
+	// ErrTypePreconditionRequired is for the requests missing the
+	// precondition they must be made on, such as the version of the task
+	// being updated.
+	ErrTypePreconditionRequired ErrType = "precondition_required"
 )
 
 func newErr(err any, errType ErrType) *Err {
@@ -58,6 +64,19 @@ func (e *Err) ErrorWithStackTrace() string {
 	return fmt.Sprintf("%s\n\n%s", e.Message, e.StackTrace)
 }
 
+// ItemErrorMessage returns the message reported for an item of a batch
+// request, and whether err is unexpected. The message of unexpected
+// errors is hidden from the client, the same way the transports handle
+// them for whole requests, so callers should log them instead.
+func ItemErrorMessage(err error) (message string, unexpected bool) {
+	appErr := &Err{}
+	if !errors.As(err, &appErr) || appErr.Type == ErrTypeUnknown {
+		return "internal server error", true
+	}
+
+	return err.Error(), false
+}
+
 var (
 	ErrUserNotFound = newErr(
 		"user not found",
@@ -79,6 +98,10 @@ var (
 		"validation error",
 		ErrTypeValidation,
 	)
+	ErrInvalidCursor = newErr(
+		"invalid pagination cursor",
+		ErrTypeValidation,
+	)
 	ErrUserNotAllowedToCreateTask = newErr(
 		"only users with the role manager can create tasks",
 		ErrTypeForbidden,
@@ -91,10 +114,18 @@ var (
 		"only users with the role technician can finish tasks",
 		ErrTypeForbidden,
 	)
+	ErrUserNotAssignedToTask = newErr(
+		"only the technicians assigned to this task can finish it",
+		ErrTypeForbidden,
+	)
 	ErrUserEmailOrPasswordIncorrect = newErr(
 		"email or password is incorrect",
 		ErrTypeUnauthorized,
 	)
+	ErrInvalidRefreshToken = newErr(
+		"refresh token is invalid or expired",
+		ErrTypeUnauthorized,
+	)
 	ErrEmailAlreadyExists = newErr(
 		"email is already registered",
 		ErrTypeValidation,
@@ -103,6 +134,14 @@ var (
 		"only users with the role manager can delete tasks",
 		ErrTypeForbidden,
 	)
+	ErrUserNotAllowedToRestoreTask = newErr(
+		"only users with the role manager can restore tasks",
+		ErrTypeForbidden,
+	)
+	ErrUserNotAllowedToViewTrash = newErr(
+		"only users with the role manager can view deleted tasks",
+		ErrTypeForbidden,
+	)
 	ErrUserNotAllowedToUpdateTask = newErr(
 		"only users with the role of manager or those assigned to this task can update it",
 		ErrTypeForbidden,
@@ -111,10 +150,214 @@ var (
 		"only managers can update the assigned user of a task",
 		ErrTypeForbidden,
 	)
+	ErrInvalidTaskStatus = newErr(
+		"task status must be one of open, in_progress, blocked, in_review or done",
+		ErrTypeValidation,
+	)
+	ErrInvalidTaskStatusTransition = newErr(
+		"task can not be moved from its current status to the requested one",
+		ErrTypeValidation,
+	)
+	ErrUserNotAllowedToTransitionTask = newErr(
+		"user is not allowed to move this task to the requested status",
+		ErrTypeForbidden,
+	)
+	ErrUserNotAllowedToReopenTask = newErr(
+		"only users with the role of manager or those assigned to this task can reopen it",
+		ErrTypeForbidden,
+	)
+	ErrTaskNotFinished = newErr(
+		"task is not finished",
+		ErrTypeValidation,
+	)
+	ErrUserNotAllowedToCommentTask = newErr(
+		"only users with the role of manager or those assigned to this task can comment on it",
+		ErrTypeForbidden,
+	)
 	ErrUserNotAllowedToViewTask = newErr(
 		"only users with the role of manager or those assigned to this task can view it",
 		ErrTypeForbidden,
 	)
+	ErrUserNotAllowedToAttachFile = newErr(
+		"only users with the role of manager or those assigned to this task can attach files to it",
+		ErrTypeForbidden,
+	)
+	ErrAttachmentNotFound = newErr(
+		"attachment not found",
+		ErrTypeNotFound,
+	)
+	ErrAttachmentTooLarge = newErr(
+		"attachment exceeds the maximum size of 10 MiB",
+		ErrTypeValidation,
+	)
+	ErrUserNotAllowedToEditChecklist = newErr(
+		"only users with the role of manager or those assigned to this task can edit its checklist",
+		ErrTypeForbidden,
+	)
+	ErrChecklistItemNotFound = newErr(
+		"checklist item not found",
+		ErrTypeNotFound,
+	)
+	ErrTaskHasOpenChecklistItems = newErr(
+		"task has required checklist items that are not done",
+		ErrTypeValidation,
+	)
+	ErrUserNotAllowedToEditTaskDependencies = newErr(
+		"only users with the role of manager can edit task dependencies",
+		ErrTypeForbidden,
+	)
+	ErrBlockingTaskNotFound = newErr(
+		"blocking task not found",
+		ErrTypeNotFound,
+	)
+	ErrTaskDependencyNotFound = newErr(
+		"task dependency not found",
+		ErrTypeNotFound,
+	)
+	ErrTaskDependencyCycle = newErr(
+		"task dependency would create a cycle",
+		ErrTypeValidation,
+	)
+	ErrTaskBlocked = newErr(
+		"task is blocked by unfinished tasks",
+		ErrTypeValidation,
+	)
+	ErrUserNotAllowedToManageLabels = newErr(
+		"only users with the role of manager can manage labels",
+		ErrTypeForbidden,
+	)
+	ErrUserNotAllowedToUpdateTaskLabels = newErr(
+		"only users with the role of manager can update the task labels",
+		ErrTypeForbidden,
+	)
+	ErrUserNotAllowedToUpdateTaskPlanning = newErr(
+		"only users with the role of manager can update the task due date, priority, finish policy and project",
+		ErrTypeForbidden,
+	)
+	ErrLabelNotFound = newErr(
+		"label not found",
+		ErrTypeNotFound,
+	)
+	ErrLabelAlreadyExists = newErr(
+		"label name is already taken",
+		ErrTypeValidation,
+	)
+	ErrUserNotAllowedToManageRecurringTasks = newErr(
+		"only users with the role of manager can manage recurring tasks",
+		ErrTypeForbidden,
+	)
+	ErrRecurringTaskNotFound = newErr(
+		"recurring task not found",
+		ErrTypeNotFound,
+	)
+	ErrInvalidRecurrenceSchedule = newErr(
+		"schedule must be a cron expression with five fields or a descriptor such as @weekly",
+		ErrTypeValidation,
+	)
+	ErrBulkItemRolledBack = newErr(
+		"not applied because another item of the batch failed",
+		ErrTypeValidation,
+	)
+	ErrInvalidImportFile = newErr(
+		"import file is invalid",
+		ErrTypeValidation,
+	)
+	ErrUserNotAllowedToUseCalendarFeed = newErr(
+		"only users with the role technician can have a calendar feed",
+		ErrTypeForbidden,
+	)
+	ErrInvalidCalendarFeedToken = newErr(
+		"calendar feed token is invalid or was revoked",
+		ErrTypeUnauthorized,
+	)
+	ErrAttachmentTypeNotAllowed = newErr(
+		"attachment type not allowed, only JPEG, PNG, GIF, WebP and PDF files are accepted",
+		ErrTypeValidation,
+	)
+	ErrUserNotAllowedToManageProjects = newErr(
+		"only users with the role of manager can manage projects",
+		ErrTypeForbidden,
+	)
+	ErrProjectNotFound = newErr(
+		"project not found",
+		ErrTypeNotFound,
+	)
+	ErrUserNotProjectMember = newErr(
+		"only the members of the project can access it and its tasks",
+		ErrTypeForbidden,
+	)
+	ErrUserNotProjectManager = newErr(
+		"only the manager of the project can change it and its members",
+		ErrTypeForbidden,
+	)
+	ErrProjectMemberNotFound = newErr(
+		"project member not found",
+		ErrTypeNotFound,
+	)
+	ErrInvalidRoleForProjectMember = newErr(
+		"only users with the role manager can be members of a project",
+		ErrTypeForbidden,
+	)
+	ErrProjectHasTasks = newErr(
+		"project has tasks, move or purge them before deleting it",
+		ErrTypeValidation,
+	)
+	ErrProjectManagerCannotBeRemoved = newErr(
+		"the manager of the project can not be removed from its members",
+		ErrTypeValidation,
+	)
+	ErrOrganizationNotFound = newErr(
+		"organization not found",
+		ErrTypeNotFound,
+	)
+	ErrMissingOrganization = newErr(
+		"the request is not bound to an organization",
+		ErrTypeUnknown,
+	)
+	ErrWorkSessionNotFound = newErr(
+		"work session not found",
+		ErrTypeNotFound,
+	)
+	ErrUserNotAllowedToTrackTime = newErr(
+		"only the technicians assigned to the task can track time on it",
+		ErrTypeForbidden,
+	)
+	ErrUserNotWorkSessionOwner = newErr(
+		"only the technician who logged the work session can change it",
+		ErrTypeForbidden,
+	)
+	ErrWorkSessionsLocked = newErr(
+		"work sessions of finished tasks are locked, unless a manager unlocks them",
+		ErrTypeForbidden,
+	)
+	ErrUserNotAllowedToLockWorkSessions = newErr(
+		"only users with the role of manager can lock or unlock work sessions",
+		ErrTypeForbidden,
+	)
+	ErrWorkSessionAlreadyRunning = newErr(
+		"a work session timer is already running, stop it before starting another",
+		ErrTypeValidation,
+	)
+	ErrNoRunningWorkSession = newErr(
+		"no work session timer is running on this task",
+		ErrTypeNotFound,
+	)
+	ErrInvalidWorkSessionPeriod = newErr(
+		"work session must end after it starts and not in the future",
+		ErrTypeValidation,
+	)
+	ErrUserNotAllowedToViewReports = newErr(
+		"only users with the role of manager can view reports",
+		ErrTypeForbidden,
+	)
+	ErrTaskVersionRequired = newErr(
+		"the version of the task being updated is required, send the ETag it was read with in the If-Match header",
+		ErrTypePreconditionRequired,
+	)
+	ErrTaskVersionMismatch = newErr(
+		"task was changed since it was read, get it again and retry",
+		ErrTypeConflict,
+	)
 )
 
 var _ error = (*Err)(nil)
@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// Label categorizes tasks, such as electrical, plumbing or urgent.
+type Label struct {
+	ID             string    `json:"id,omitempty"`
+	OrganizationID string    `json:"organization_id,omitempty"`
+	Name           string    `json:"name,omitempty"`
+	CreatedAt      time.Time `json:"created_at,omitempty"`
+	UpdatedAt      time.Time `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// Organization is a tenant of the API. Its users only see the tasks and
+// the other data of the same organization.
+type Organization struct {
+	ID        string    `json:"id,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// Project groups tasks, which only its members can manage. The project
+// manager is always one of the members, and the only one that can
+// change the project and its members.
+type Project struct {
+	ID             string    `json:"id,omitempty"`
+	OrganizationID string    `json:"organization_id,omitempty"`
+	Name           string    `json:"name,omitempty"`
+	Description    string    `json:"description,omitempty"`
+	ManagerUserID  string    `json:"manager_user_id,omitempty"`
+	CreatedAt      time.Time `json:"created_at,omitempty"`
+	UpdatedAt      time.Time `json:"updated_at,omitempty"`
+}
+
+type ProjectMember struct {
+	ProjectID string    `json:"project_id,omitempty"`
+	UserID    string    `json:"user_id,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+// RecurringTask is a template from which a new task is created every
+// time its cron schedule fires, starting at NextRunAt.
+type RecurringTask struct {
+	ID               string    `json:"id,omitempty"`
+	OrganizationID   string    `json:"organization_id,omitempty"`
+	Summary          string    `json:"summary,omitempty"`
+	Schedule         string    `json:"schedule,omitempty"`
+	AssignedToUserID *string   `json:"assigned_to_user_id,omitempty"`
+	CreatedByUserID  string    `json:"created_by_user_id,omitempty"`
+	NextRunAt        time.Time `json:"next_run_at,omitempty"`
+	CreatedAt        time.Time `json:"created_at,omitempty"`
+	UpdatedAt        time.Time `json:"updated_at,omitempty"`
+}
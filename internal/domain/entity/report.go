@@ -0,0 +1,47 @@
+package entity
+
+import "time"
+
+// ReportGroupBy is whose tasks the report rows are about.
+type ReportGroupBy string
+
+const (
+	// ReportGroupByTechnician counts a task for each of its assignees.
+	ReportGroupByTechnician ReportGroupBy = "technician"
+	ReportGroupByCreator    ReportGroupBy = "creator"
+)
+
+// ReportBucket is the period the report rows span. Weeks start on Monday,
+// and every bucket is in UTC.
+type ReportBucket string
+
+const (
+	ReportBucketDay   ReportBucket = "day"
+	ReportBucketWeek  ReportBucket = "week"
+	ReportBucketMonth ReportBucket = "month"
+)
+
+// TaskReportRow is the throughput of a user in a bucket. Tasks count as
+// created in the bucket of their creation date and as finished in the
+// bucket of their finish date, where their time to finish is measured.
+type TaskReportRow struct {
+	// BucketStart is when the bucket starts.
+	BucketStart   time.Time `json:"bucket_start"`
+	UserID        string    `json:"user_id"`
+	CreatedCount  int       `json:"created_count"`
+	FinishedCount int       `json:"finished_count"`
+	// MedianFinishSeconds and P90FinishSeconds are the nearest-rank
+	// percentiles of the time from creation to finish of the finished
+	// tasks, nil if none was finished in the bucket.
+	MedianFinishSeconds *int64 `json:"median_finish_seconds"`
+	P90FinishSeconds    *int64 `json:"p90_finish_seconds"`
+}
+
+type TaskReport struct {
+	GroupBy ReportGroupBy `json:"group_by"`
+	Bucket  ReportBucket  `json:"bucket"`
+	From    time.Time     `json:"from"`
+	To      time.Time     `json:"to"`
+	// Rows are ordered by bucket and then by user ID.
+	Rows []TaskReportRow `json:"rows"`
+}
@@ -1,13 +1,84 @@
 package entity
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 type Task struct {
-	ID               string     `json:"id,omitempty"`
-	Summary          string     `json:"summary,omitempty"`
-	AssignedToUserID *string    `json:"assigned_to_user_id,omitempty"`
-	CreatedByUserID  string     `json:"created_by_user_id,omitempty"`
-	FinishedAt       *time.Time `json:"finished_at,omitempty"`
-	CreatedAt        time.Time  `json:"created_at,omitempty"`
-	UpdatedAt        time.Time  `json:"updated_at,omitempty"`
+	ID               string           `json:"id,omitempty"`
+	OrganizationID   string           `json:"organization_id,omitempty"`
+	Summary          string           `json:"summary,omitempty"`
+	Status           TaskStatus       `json:"status,omitempty"`
+	AssignedToUserID *string          `json:"assigned_to_user_id,omitempty"`
+	CreatedByUserID  string           `json:"created_by_user_id,omitempty"`
+	FinishedAt       *time.Time       `json:"finished_at,omitempty"`
+	ReopenReason     *string          `json:"reopen_reason,omitempty"`
+	ReopenedAt       *time.Time       `json:"reopened_at,omitempty"`
+	DueAt            *time.Time       `json:"due_at,omitempty"`
+	Priority         TaskPriority     `json:"priority,omitempty"`
+	FinishPolicy     TaskFinishPolicy `json:"finish_policy,omitempty"`
+	ProjectID        *string          `json:"project_id,omitempty"`
+	DeletedAt        *time.Time       `json:"deleted_at,omitempty"`
+	CreatedAt        time.Time        `json:"created_at,omitempty"`
+	UpdatedAt        time.Time        `json:"updated_at,omitempty"`
+	// AssigneeIDs are the technicians assigned to the task in order.
+	// AssignedToUserID holds the first one, for the clients that only
+	// handle a single assignee.
+	AssigneeIDs []string `json:"assignee_ids,omitempty"`
+	// SignedOffUserIDs are the assignees that finished the task, which
+	// under the all finish policy is only submitted for review once all of
+	// them did. They are cleared when the task is sent back from review
+	// or reopened.
+	SignedOffUserIDs []string `json:"signed_off_user_ids,omitempty"`
+	// WorkSessionsUnlocked lets the work sessions of the task be changed
+	// after it is done.
+	WorkSessionsUnlocked bool `json:"work_sessions_unlocked,omitempty"`
+	// Version is incremented on every change to the task, so that updates
+	// made from a stale read can be rejected.
+	Version int64 `json:"version,omitempty"`
+	// Overdue tells whether the task was past its due date and not done
+	// yet when it was read.
+	Overdue bool `json:"overdue,omitempty"`
+	// ChecklistProgress is the done/total count of the task checklist
+	// items, such as 3/5, empty if the task has no checklist.
+	ChecklistProgress string  `json:"checklist_progress,omitempty"`
+	Labels            []Label `json:"labels,omitempty"`
+}
+
+// IsAssignedTo reports whether the user is one of the task assignees.
+func (t Task) IsAssignedTo(userID string) bool {
+	return slices.Contains(t.AssigneeIDs, userID)
+}
+
+// IsSignedOffByAll reports whether every assignee signed the task off,
+// which is when it is finished under the all finish policy.
+func (t Task) IsSignedOffByAll() bool {
+	for _, assigneeID := range t.AssigneeIDs {
+		if !slices.Contains(t.SignedOffUserIDs, assigneeID) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsOverdue reports whether the task is past its due date at now and
+// not done yet.
+func (t Task) IsOverdue(now time.Time) bool {
+	return t.DueAt != nil && t.DueAt.Before(now) && t.Status != TaskStatusDone
+}
+
+// AreWorkSessionsLocked reports whether the work sessions of the task can
+// no longer be changed, which is once it is done, unless a manager
+// unlocked them.
+func (t Task) AreWorkSessionsLocked() bool {
+	return t.Status == TaskStatusDone && !t.WorkSessionsUnlocked
+}
+
+// IsVisibleTo reports whether the role lets the user see the task and
+// what is attached to it: managers see every task, technicians only
+// their own. It does not know the projects, managers must also be
+// members of the task project, which the use cases check.
+func (t Task) IsVisibleTo(userID string, role Role) bool {
+	return role == RoleManager || t.IsAssignedTo(userID)
 }
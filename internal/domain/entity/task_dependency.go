@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+// TaskDependency links a task to another task that must be finished
+// before it, its blocker.
+type TaskDependency struct {
+	TaskID          string    `json:"task_id,omitempty"`
+	BlockedByTaskID string    `json:"blocked_by_task_id,omitempty"`
+	CreatedAt       time.Time `json:"created_at,omitempty"`
+}
@@ -0,0 +1,118 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+type TaskEventType string
+
+const (
+	TaskEventCreated       TaskEventType = "created"
+	TaskEventUpdated       TaskEventType = "updated"
+	TaskEventFinished      TaskEventType = "finished"
+	TaskEventStatusChanged TaskEventType = "status_changed"
+	TaskEventReopened      TaskEventType = "reopened"
+	TaskEventDeleted       TaskEventType = "deleted"
+	TaskEventRestored      TaskEventType = "restored"
+)
+
+const (
+	TaskFieldSummary          = "summary"
+	TaskFieldStatus           = "status"
+	TaskFieldAssignedToUserID = "assigned_to_user_id"
+	TaskFieldAssigneeIDs      = "assignee_ids"
+	TaskFieldSignedOffUserIDs = "signed_off_user_ids"
+	TaskFieldFinishedAt       = "finished_at"
+	TaskFieldReopenReason     = "reopen_reason"
+	TaskFieldReopenedAt       = "reopened_at"
+	TaskFieldDeletedAt        = "deleted_at"
+	TaskFieldProjectID        = "project_id"
+)
+
+// TaskFieldChange is the change of a single task field. Values are
+// formatted as strings, and a nil value means the field was unset.
+type TaskFieldChange struct {
+	Field string  `json:"field"`
+	From  *string `json:"from,omitempty"`
+	To    *string `json:"to,omitempty"`
+}
+
+// TaskEvent is an entry of the task history, recording who changed
+// the task, how and when.
+type TaskEvent struct {
+	ID          string            `json:"id,omitempty"`
+	TaskID      string            `json:"task_id,omitempty"`
+	ActorUserID string            `json:"actor_user_id,omitempty"`
+	Type        TaskEventType     `json:"type,omitempty"`
+	Changes     []TaskFieldChange `json:"changes"`
+	CreatedAt   time.Time         `json:"created_at,omitempty"`
+}
+
+// DiffTasks returns the changes of the tracked fields between two
+// versions of a task. Summary and reopen reason are compared as given,
+// so the changes hold encrypted values when the tasks do.
+func DiffTasks(from, to Task) []TaskFieldChange {
+	changes := []TaskFieldChange{}
+
+	diff := func(field string, fromValue, toValue *string) {
+		if fromValue == nil && toValue == nil {
+			return
+		}
+
+		if fromValue != nil && toValue != nil && *fromValue == *toValue {
+			return
+		}
+
+		changes = append(changes, TaskFieldChange{
+			Field: field,
+			From:  fromValue,
+			To:    toValue,
+		})
+	}
+
+	diff(TaskFieldSummary, stringOrNil(from.Summary), stringOrNil(to.Summary))
+	diff(
+		TaskFieldStatus,
+		stringOrNil(string(from.Status)),
+		stringOrNil(string(to.Status)),
+	)
+	diff(TaskFieldAssignedToUserID, from.AssignedToUserID, to.AssignedToUserID)
+	diff(
+		TaskFieldAssigneeIDs,
+		listOrNil(from.AssigneeIDs),
+		listOrNil(to.AssigneeIDs),
+	)
+	diff(
+		TaskFieldSignedOffUserIDs,
+		listOrNil(from.SignedOffUserIDs),
+		listOrNil(to.SignedOffUserIDs),
+	)
+	diff(TaskFieldFinishedAt, timeOrNil(from.FinishedAt), timeOrNil(to.FinishedAt))
+	diff(TaskFieldReopenReason, from.ReopenReason, to.ReopenReason)
+	diff(TaskFieldReopenedAt, timeOrNil(from.ReopenedAt), timeOrNil(to.ReopenedAt))
+	diff(TaskFieldDeletedAt, timeOrNil(from.DeletedAt), timeOrNil(to.DeletedAt))
+	diff(TaskFieldProjectID, from.ProjectID, to.ProjectID)
+
+	return changes
+}
+
+func stringOrNil(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// listOrNil formats the values separated by commas.
+func listOrNil(values []string) *string {
+	return stringOrNil(strings.Join(values, ","))
+}
+
+func timeOrNil(value *time.Time) *string {
+	if value == nil {
+		return nil
+	}
+	formatted := value.UTC().Format(time.RFC3339)
+	return &formatted
+}
@@ -0,0 +1,14 @@
+package entity
+
+// TaskFinishPolicy tells when a task with several assignees is
+// finished.
+type TaskFinishPolicy string
+
+const (
+	// TaskFinishPolicyAny finishes the task as soon as any of its
+	// assignees finishes it.
+	TaskFinishPolicyAny TaskFinishPolicy = "any"
+	// TaskFinishPolicyAll finishes the task only once every assignee
+	// signed it off.
+	TaskFinishPolicyAll TaskFinishPolicy = "all"
+)
@@ -0,0 +1,25 @@
+package entity
+
+type TaskPriority string
+
+const (
+	TaskPriorityLow      TaskPriority = "low"
+	TaskPriorityNormal   TaskPriority = "normal"
+	TaskPriorityHigh     TaskPriority = "high"
+	TaskPriorityCritical TaskPriority = "critical"
+)
+
+// taskPriorityRanks orders the priorities from the least to the most
+// urgent.
+var taskPriorityRanks = map[TaskPriority]int{
+	TaskPriorityLow:      1,
+	TaskPriorityNormal:   2,
+	TaskPriorityHigh:     3,
+	TaskPriorityCritical: 4,
+}
+
+// Rank returns the position of the priority from the least to the most
+// urgent, starting at 1, or 0 if the priority is unknown.
+func (p TaskPriority) Rank() int {
+	return taskPriorityRanks[p]
+}
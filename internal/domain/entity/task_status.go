@@ -0,0 +1,80 @@
+package entity
+
+type TaskStatus string
+
+const (
+	TaskStatusOpen       TaskStatus = "open"
+	TaskStatusInProgress TaskStatus = "in_progress"
+	TaskStatusBlocked    TaskStatus = "blocked"
+	TaskStatusInReview   TaskStatus = "in_review"
+	TaskStatusDone       TaskStatus = "done"
+)
+
+// taskActor is who is moving a task between statuses.
+type taskActor byte
+
+const (
+	taskActorAssignee taskActor = 1 << iota
+	taskActorManager
+)
+
+// taskStatusTransitions holds, for each status, the statuses a task can
+// be moved to and who is allowed to do it.
+var taskStatusTransitions = map[TaskStatus]map[TaskStatus]taskActor{
+	TaskStatusOpen: {
+		TaskStatusInProgress: taskActorAssignee,
+		TaskStatusBlocked:    taskActorAssignee | taskActorManager,
+	},
+	TaskStatusInProgress: {
+		TaskStatusOpen:     taskActorManager,
+		TaskStatusBlocked:  taskActorAssignee | taskActorManager,
+		TaskStatusInReview: taskActorAssignee,
+	},
+	TaskStatusBlocked: {
+		TaskStatusOpen:       taskActorManager,
+		TaskStatusInProgress: taskActorAssignee,
+	},
+	TaskStatusInReview: {
+		TaskStatusInProgress: taskActorManager,
+		TaskStatusDone:       taskActorManager,
+	},
+	// Done tasks can only be reopened, which requires a reason.
+	TaskStatusDone: {},
+}
+
+// IsValid reports whether the status is a known one.
+func (s TaskStatus) IsValid() bool {
+	_, ok := taskStatusTransitions[s]
+	return ok
+}
+
+// ValidateTransition checks if the user can move the task to the status,
+// following the transitions table.
+func (t Task) ValidateTransition(
+	to TaskStatus,
+	userID string,
+	userRole Role,
+) error {
+	if !to.IsValid() {
+		return ErrInvalidTaskStatus
+	}
+
+	allowedActors, ok := taskStatusTransitions[t.Status][to]
+	if !ok {
+		return ErrInvalidTaskStatusTransition
+	}
+
+	var actor taskActor
+	if userRole == RoleManager {
+		actor |= taskActorManager
+	}
+	if userRole == RoleTechnician && t.IsAssignedTo(userID) {
+		actor |= taskActorAssignee
+	}
+
+	if allowedActors&actor == 0 {
+		return ErrUserNotAllowedToTransitionTask
+	}
+
+	return nil
+}
@@ -10,11 +10,12 @@ const (
 )
 
 type User struct {
-	ID        string    `json:"id"`
-	Role      Role      `json:"role"`
-	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	Password  string    `json:"-"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID             string    `json:"id"`
+	OrganizationID string    `json:"organization_id"`
+	Role           Role      `json:"role"`
+	Name           string    `json:"name"`
+	Email          string    `json:"email"`
+	Password       string    `json:"-"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
 }
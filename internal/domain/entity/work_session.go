@@ -0,0 +1,56 @@
+package entity
+
+import "time"
+
+// WorkSession is a span of time a technician worked on a task, either
+// timed with a timer or entered by hand. A running timer has no end yet.
+type WorkSession struct {
+	ID        string     `json:"id,omitempty"`
+	TaskID    string     `json:"task_id,omitempty"`
+	UserID    string     `json:"user_id,omitempty"`
+	StartedAt time.Time  `json:"started_at,omitempty"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+	Note      *string    `json:"note,omitempty"`
+	// Manual tells whether the session was entered by hand instead of
+	// timed.
+	Manual    bool      `json:"manual"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DurationSeconds is how long the session lasted, up to when it was
+	// read if the timer is still running.
+	DurationSeconds int64 `json:"duration_seconds"`
+}
+
+// IsRunning reports whether the session is a timer that was not stopped.
+func (s WorkSession) IsRunning() bool {
+	return s.EndedAt == nil
+}
+
+// Duration returns how long the session lasted, up to now if the timer
+// is still running.
+func (s WorkSession) Duration(now time.Time) time.Duration {
+	endedAt := now
+	if s.EndedAt != nil {
+		endedAt = *s.EndedAt
+	}
+
+	return max(endedAt.Sub(s.StartedAt), 0)
+}
+
+// WorkTimeTotal is the time a technician worked, in seconds.
+type WorkTimeTotal struct {
+	UserID  string `json:"user_id,omitempty"`
+	Seconds int64  `json:"seconds"`
+}
+
+// TaskWorkTime is the time worked on a task, in total and by technician,
+// along with its work sessions.
+type TaskWorkTime struct {
+	TaskID       string          `json:"task_id,omitempty"`
+	TotalSeconds int64           `json:"total_seconds"`
+	Technicians  []WorkTimeTotal `json:"technicians"`
+	Sessions     []WorkSession   `json:"sessions"`
+	// Locked tells whether the sessions can no longer be changed, since
+	// the task is done.
+	Locked bool `json:"locked"`
+}
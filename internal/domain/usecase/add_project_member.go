@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type AddProjectMember struct {
+	validator   validator.Validator
+	projectRepo repo.ProjectRepo
+	userRepo    repo.UserRepo
+}
+
+func NewAddProjectMember(
+	validator validator.Validator,
+	projectRepo repo.ProjectRepo,
+	userRepo repo.UserRepo,
+) *AddProjectMember {
+	return &AddProjectMember{
+		validator:   validator,
+		projectRepo: projectRepo,
+		userRepo:    userRepo,
+	}
+}
+
+type AddProjectMemberParams struct {
+	ProjectID    string      `json:"project_id,omitempty"     validate:"required,uuid"`
+	UserRole     entity.Role `json:"user_role,omitempty"      validate:"required,min=1,max=2"`
+	UserID       string      `json:"user_id,omitempty"        validate:"required,uuid"`
+	MemberUserID string      `json:"member_user_id,omitempty" validate:"required,uuid"`
+}
+
+// Execute adds the manager to the project members, which only the
+// project manager can do. Adding a member twice does nothing.
+func (a *AddProjectMember) Execute(
+	ctx context.Context,
+	params AddProjectMemberParams,
+) (entity.ProjectMember, error) {
+	if params.UserRole != entity.RoleManager {
+		return entity.ProjectMember{}, entity.ErrUserNotAllowedToManageProjects
+	}
+
+	if err := a.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return entity.ProjectMember{}, validationErr
+	}
+
+	project, err := ensureProjectManager(
+		ctx,
+		a.projectRepo,
+		params.ProjectID,
+		params.UserID,
+	)
+	if err != nil {
+		return entity.ProjectMember{}, err
+	}
+
+	user, err := a.userRepo.GetUserByID(ctx, params.MemberUserID)
+	if err != nil {
+		return entity.ProjectMember{}, entity.NewErr(err)
+	}
+
+	if user.ID == "" {
+		return entity.ProjectMember{}, entity.ErrUserNotFound
+	}
+
+	if user.Role != entity.RoleManager {
+		return entity.ProjectMember{}, entity.ErrInvalidRoleForProjectMember
+	}
+
+	if err := a.projectRepo.AddProjectMember(
+		ctx,
+		project.ID,
+		user.ID,
+	); err != nil {
+		return entity.ProjectMember{}, entity.NewErr(err)
+	}
+
+	member, err := a.projectRepo.GetProjectMember(ctx, project.ID, user.ID)
+	if err != nil {
+		return entity.ProjectMember{}, entity.NewErr(err)
+	}
+
+	return member, nil
+}
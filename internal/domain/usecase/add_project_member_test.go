@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestAddProjectMember_Execute(t *testing.T) {
+	val := validator.NewValidate()
+
+	projectManager := entity.User{
+		ID:   uuid.NewString(),
+		Role: entity.RoleManager,
+	}
+
+	otherManager := entity.User{
+		ID:   uuid.NewString(),
+		Role: entity.RoleManager,
+	}
+
+	technicianUser := entity.User{
+		ID:   uuid.NewString(),
+		Role: entity.RoleTechnician,
+	}
+
+	userRepo := inmemoryrepo.NewInMemoryUserRepo()
+	userRepo.Users = append(
+		userRepo.Users,
+		projectManager,
+		otherManager,
+		technicianUser,
+	)
+
+	project := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Maintenance",
+		ManagerUserID: projectManager.ID,
+	}
+
+	type args struct {
+		params AddProjectMemberParams
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantMembers int
+		wantErr     error
+	}{
+		{
+			name: "should add a manager to the project",
+			args: args{
+				params: AddProjectMemberParams{
+					ProjectID:    project.ID,
+					UserRole:     entity.RoleManager,
+					UserID:       projectManager.ID,
+					MemberUserID: otherManager.ID,
+				},
+			},
+			wantMembers: 2,
+			wantErr:     nil,
+		},
+		{
+			name: "should do nothing if the user already is a member",
+			args: args{
+				params: AddProjectMemberParams{
+					ProjectID:    project.ID,
+					UserRole:     entity.RoleManager,
+					UserID:       projectManager.ID,
+					MemberUserID: projectManager.ID,
+				},
+			},
+			wantMembers: 1,
+			wantErr:     nil,
+		},
+		{
+			name: "should not add a member if user is not the project manager",
+			args: args{
+				params: AddProjectMemberParams{
+					ProjectID:    project.ID,
+					UserRole:     entity.RoleManager,
+					UserID:       otherManager.ID,
+					MemberUserID: otherManager.ID,
+				},
+			},
+			wantErr: entity.ErrUserNotProjectManager,
+		},
+		{
+			name: "should not add a technician to the project",
+			args: args{
+				params: AddProjectMemberParams{
+					ProjectID:    project.ID,
+					UserRole:     entity.RoleManager,
+					UserID:       projectManager.ID,
+					MemberUserID: technicianUser.ID,
+				},
+			},
+			wantErr: entity.ErrInvalidRoleForProjectMember,
+		},
+		{
+			name: "should not add a user that does not exist",
+			args: args{
+				params: AddProjectMemberParams{
+					ProjectID:    project.ID,
+					UserRole:     entity.RoleManager,
+					UserID:       projectManager.ID,
+					MemberUserID: uuid.NewString(),
+				},
+			},
+			wantErr: entity.ErrUserNotFound,
+		},
+		{
+			name: "should not add a member to a project that does not exist",
+			args: args{
+				params: AddProjectMemberParams{
+					ProjectID:    uuid.NewString(),
+					UserRole:     entity.RoleManager,
+					UserID:       projectManager.ID,
+					MemberUserID: otherManager.ID,
+				},
+			},
+			wantErr: entity.ErrProjectNotFound,
+		},
+		{
+			name: "should not add a member if user role is not manager",
+			args: args{
+				params: AddProjectMemberParams{
+					ProjectID:    project.ID,
+					UserRole:     entity.RoleTechnician,
+					UserID:       technicianUser.ID,
+					MemberUserID: otherManager.ID,
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToManageProjects,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+			projectRepo.Projects = append(projectRepo.Projects, project)
+			projectRepo.Members = append(
+				projectRepo.Members,
+				entity.ProjectMember{
+					ProjectID: project.ID,
+					UserID:    projectManager.ID,
+				},
+			)
+
+			a := NewAddProjectMember(val, projectRepo, userRepo)
+
+			got, err := a.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"AddProjectMember.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.UserID != tt.args.params.MemberUserID {
+				t.Errorf(
+					"AddProjectMember.Execute() = %v, want member %v",
+					got,
+					tt.args.params.MemberUserID,
+				)
+			}
+
+			if len(projectRepo.Members) != tt.wantMembers {
+				t.Errorf(
+					"AddProjectMember.Execute() members = %v, want %d of them",
+					projectRepo.Members,
+					tt.wantMembers,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type AddTaskDependency struct {
+	validator          validator.Validator
+	taskRepo           repo.TaskRepo
+	projectRepo        repo.ProjectRepo
+	taskDependencyRepo repo.TaskDependencyRepo
+	tx                 transactioner.Transactioner
+}
+
+func NewAddTaskDependency(
+	validator validator.Validator,
+	taskRepo repo.TaskRepo,
+	projectRepo repo.ProjectRepo,
+	taskDependencyRepo repo.TaskDependencyRepo,
+	tx transactioner.Transactioner,
+) *AddTaskDependency {
+	return &AddTaskDependency{
+		validator:          validator,
+		taskRepo:           taskRepo,
+		projectRepo:        projectRepo,
+		taskDependencyRepo: taskDependencyRepo,
+		tx:                 tx,
+	}
+}
+
+type AddTaskDependencyParams struct {
+	TaskID          string      `json:"task_id,omitempty"            validate:"required,uuid"`
+	BlockedByTaskID string      `json:"blocked_by_task_id,omitempty" validate:"required,uuid"`
+	UserID          string      `json:"user_id,omitempty"            validate:"required,uuid"`
+	UserRole        entity.Role `json:"role,omitempty"               validate:"required,min=1,max=2"`
+}
+
+// Execute makes the task blocked by another task, refusing links that
+// would make a task depend on itself.
+func (a *AddTaskDependency) Execute(
+	ctx context.Context,
+	params AddTaskDependencyParams,
+) error {
+	if params.UserRole != entity.RoleManager {
+		return entity.ErrUserNotAllowedToEditTaskDependencies
+	}
+
+	if err := a.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return validationErr
+	}
+
+	if params.TaskID == params.BlockedByTaskID {
+		return entity.ErrTaskDependencyCycle
+	}
+
+	task, err := a.taskRepo.GetTaskByID(ctx, params.TaskID)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if task.ID == "" {
+		return entity.ErrTaskNotFound
+	}
+
+	if err := ensureTaskProjectMember(
+		ctx,
+		a.projectRepo,
+		task,
+		params.UserID,
+	); err != nil {
+		return err
+	}
+
+	blockingTask, err := a.taskRepo.GetTaskByID(ctx, params.BlockedByTaskID)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if blockingTask.ID == "" {
+		return entity.ErrBlockingTaskNotFound
+	}
+
+	if err := ensureTaskProjectMember(
+		ctx,
+		a.projectRepo,
+		blockingTask,
+		params.UserID,
+	); err != nil {
+		return err
+	}
+
+	err = a.tx.Do(ctx, func(ctx context.Context) error {
+		// The new link closes a cycle if the blocking task already
+		// depends on the task.
+		cycle, err := dependsOn(
+			ctx,
+			a.taskDependencyRepo,
+			blockingTask.ID,
+			task.ID,
+		)
+		if err != nil {
+			return entity.NewErr(err)
+		}
+
+		if cycle {
+			return entity.ErrTaskDependencyCycle
+		}
+
+		if err := a.taskDependencyRepo.CreateTaskDependency(
+			ctx,
+			repo.TaskDependencyParams{
+				TaskID:          task.ID,
+				BlockedByTaskID: blockingTask.ID,
+			},
+		); err != nil {
+			return entity.NewErr(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestAddTaskDependency_Execute(t *testing.T) {
+	val := validator.NewValidate()
+
+	managerID := uuid.NewString()
+
+	newTask := func() entity.Task {
+		return entity.Task{
+			ID:              uuid.NewString(),
+			Summary:         "Loren ipsum dolor sit amet",
+			Status:          entity.TaskStatusOpen,
+			CreatedByUserID: managerID,
+			CreatedAt:       time.Now(),
+			UpdatedAt:       time.Now(),
+		}
+	}
+
+	// task1 is blocked by task2, which is blocked by task3.
+	task1, task2, task3, task4 := newTask(), newTask(), newTask(), newTask()
+
+	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+	taskRepo.Tasks = append(taskRepo.Tasks, task1, task2, task3, task4)
+
+	project := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Maintenance",
+		ManagerUserID: uuid.NewString(),
+	}
+	projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+	projectRepo.Projects = append(projectRepo.Projects, project)
+
+	projectTask := newTask()
+	projectTask.ID = uuid.NewString()
+	projectTask.ProjectID = &project.ID
+	taskRepo.Tasks = append(taskRepo.Tasks, projectTask)
+
+	dependencies := []entity.TaskDependency{
+		{TaskID: task1.ID, BlockedByTaskID: task2.ID},
+		{TaskID: task2.ID, BlockedByTaskID: task3.ID},
+	}
+
+	type args struct {
+		params AddTaskDependencyParams
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "should block task by another task",
+			args: args{
+				params: AddTaskDependencyParams{
+					TaskID:          task3.ID,
+					BlockedByTaskID: task4.ID,
+					UserID:          managerID,
+					UserRole:        entity.RoleManager,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should block task by a transitive blocker",
+			args: args{
+				params: AddTaskDependencyParams{
+					TaskID:          task1.ID,
+					BlockedByTaskID: task3.ID,
+					UserID:          managerID,
+					UserRole:        entity.RoleManager,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should not block task by itself",
+			args: args{
+				params: AddTaskDependencyParams{
+					TaskID:          task1.ID,
+					BlockedByTaskID: task1.ID,
+					UserID:          managerID,
+					UserRole:        entity.RoleManager,
+				},
+			},
+			wantErr: entity.ErrTaskDependencyCycle,
+		},
+		{
+			name: "should not block task by a task it blocks",
+			args: args{
+				params: AddTaskDependencyParams{
+					TaskID:          task2.ID,
+					BlockedByTaskID: task1.ID,
+					UserID:          managerID,
+					UserRole:        entity.RoleManager,
+				},
+			},
+			wantErr: entity.ErrTaskDependencyCycle,
+		},
+		{
+			name: "should not block task by a task it blocks transitively",
+			args: args{
+				params: AddTaskDependencyParams{
+					TaskID:          task3.ID,
+					BlockedByTaskID: task1.ID,
+					UserID:          managerID,
+					UserRole:        entity.RoleManager,
+				},
+			},
+			wantErr: entity.ErrTaskDependencyCycle,
+		},
+		{
+			name: "should not block task if user is not a manager",
+			args: args{
+				params: AddTaskDependencyParams{
+					TaskID:          task3.ID,
+					BlockedByTaskID: task4.ID,
+					UserID:          uuid.NewString(),
+					UserRole:        entity.RoleTechnician,
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToEditTaskDependencies,
+		},
+		{
+			name: "should not block task if blocking task does not exists",
+			args: args{
+				params: AddTaskDependencyParams{
+					TaskID:          task3.ID,
+					BlockedByTaskID: uuid.NewString(),
+					UserID:          managerID,
+					UserRole:        entity.RoleManager,
+				},
+			},
+			wantErr: entity.ErrBlockingTaskNotFound,
+		},
+		{
+			name: "should not block task if user is not a project member",
+			args: args{
+				params: AddTaskDependencyParams{
+					TaskID:          projectTask.ID,
+					BlockedByTaskID: task4.ID,
+					UserID:          managerID,
+					UserRole:        entity.RoleManager,
+				},
+			},
+			wantErr: entity.ErrUserNotProjectMember,
+		},
+		{
+			name: "should not block task by a task of a project the user is not a member of",
+			args: args{
+				params: AddTaskDependencyParams{
+					TaskID:          task4.ID,
+					BlockedByTaskID: projectTask.ID,
+					UserID:          managerID,
+					UserRole:        entity.RoleManager,
+				},
+			},
+			wantErr: entity.ErrUserNotProjectMember,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			taskDependencyRepo := inmemoryrepo.NewInMemoryTaskDependencyRepo()
+			taskDependencyRepo.Dependencies = append(
+				taskDependencyRepo.Dependencies,
+				dependencies...,
+			)
+
+			a := NewAddTaskDependency(
+				val,
+				taskRepo,
+				projectRepo,
+				taskDependencyRepo,
+				transactioner.NewNoopTransactioner(),
+			)
+
+			err := a.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"AddTaskDependency.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			wantDependencies := len(dependencies)
+			if tt.wantErr == nil {
+				wantDependencies++
+			}
+
+			if len(taskDependencyRepo.Dependencies) != wantDependencies {
+				t.Errorf(
+					"AddTaskDependency.Execute() dependencies = %v, want %v",
+					len(taskDependencyRepo.Dependencies),
+					wantDependencies,
+				)
+			}
+		})
+	}
+}
@@ -7,29 +7,33 @@ import (
 	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/hasher"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/tenant"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
 	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
 	"github.com/golang-jwt/jwt/v5"
 )
 
 type Authenticate struct {
-	val      validator.Validator
-	jwt      jwtutil.JWTManager
-	hasher   hasher.Hasher
-	userRepo repo.UserRepo
+	val              validator.Validator
+	jwt              jwtutil.JWTManager
+	hasher           hasher.Hasher
+	organizationRepo repo.OrganizationRepo
+	userRepo         repo.UserRepo
 }
 
 func NewAuthenticate(
 	val validator.Validator,
 	jwt jwtutil.JWTManager,
 	hasher hasher.Hasher,
+	organizationRepo repo.OrganizationRepo,
 	userRepo repo.UserRepo,
 ) *Authenticate {
 	return &Authenticate{
-		val:      val,
-		jwt:      jwt,
-		hasher:   hasher,
-		userRepo: userRepo,
+		val:              val,
+		jwt:              jwt,
+		hasher:           hasher,
+		organizationRepo: organizationRepo,
+		userRepo:         userRepo,
 	}
 }
 
@@ -48,6 +52,20 @@ func (a *Authenticate) Execute(
 		return "", "", validationErr
 	}
 
+	organizationID, err := a.organizationRepo.GetOrganizationIDByUserEmail(
+		ctx,
+		params.Email,
+	)
+	if err != nil {
+		return "", "", entity.NewErr(err)
+	}
+
+	if organizationID == "" {
+		return "", "", entity.ErrUserEmailOrPasswordIncorrect
+	}
+
+	ctx = tenant.WithOrganizationID(ctx, organizationID)
+
 	user, err := a.userRepo.GetUserByEmail(ctx, params.Email)
 	if err != nil {
 		return "", "", entity.NewErr(err)
@@ -61,8 +79,21 @@ func (a *Authenticate) Execute(
 		return "", "", entity.ErrUserEmailOrPasswordIncorrect
 	}
 
-	accessToken, err = a.jwt.NewAccessToken(jwtutil.UserClaims{
-		Role: user.Role,
+	return issueTokens(a.jwt, user)
+}
+
+// issueTokens creates a new pair of access and refresh tokens for the user.
+// The access token carries the user ID as issuer, while the refresh token
+// carries it as subject, so one can not be used in place of the other.
+// Only the access token carries the user organization, since refreshing
+// looks it up again.
+func issueTokens(
+	jwtManager jwtutil.JWTManager,
+	user entity.User,
+) (accessToken, refreshToken string, err error) {
+	accessToken, err = jwtManager.NewAccessToken(jwtutil.UserClaims{
+		Role:           user.Role,
+		OrganizationID: user.OrganizationID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    user.ID,
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -73,7 +104,8 @@ func (a *Authenticate) Execute(
 		return "", "", entity.NewErr(err)
 	}
 
-	refreshToken, err = a.jwt.NewRefreshToken(jwt.RegisteredClaims{
+	refreshToken, err = jwtManager.NewRefreshToken(jwt.RegisteredClaims{
+		Subject:   user.ID,
 		IssuedAt:  jwt.NewNumericDate(time.Now()),
 		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour * 24 * 7)),
 	})
@@ -30,13 +30,14 @@ func TestAuthenticate_Execute(t *testing.T) {
 	}
 
 	user := entity.User{
-		ID:        uuid.NewString(),
-		Role:      entity.RoleManager,
-		Name:      "John Doe",
-		Email:     "johndoe@email.com",
-		Password:  hashedPassword,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		ID:             uuid.NewString(),
+		OrganizationID: uuid.NewString(),
+		Role:           entity.RoleManager,
+		Name:           "John Doe",
+		Email:          "johndoe@email.com",
+		Password:       hashedPassword,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
 	}
 
 	userRepo.Users = append(
@@ -44,11 +45,14 @@ func TestAuthenticate_Execute(t *testing.T) {
 		user,
 	)
 
+	organizationRepo := inmemoryrepo.NewInMemoryOrganizationRepo(userRepo, nil)
+
 	type fields struct {
-		val      validator.Validator
-		jwt      jwtutil.JWTManager
-		bcrypt   hasher.Hasher
-		userRepo *inmemoryrepo.InMemoryUserRepo
+		val              validator.Validator
+		jwt              jwtutil.JWTManager
+		bcrypt           hasher.Hasher
+		organizationRepo *inmemoryrepo.InMemoryOrganizationRepo
+		userRepo         *inmemoryrepo.InMemoryUserRepo
 	}
 	type args struct {
 		params AuthenticateParams
@@ -62,10 +66,11 @@ func TestAuthenticate_Execute(t *testing.T) {
 		{
 			name: "should authenticate",
 			fields: fields{
-				val:      val,
-				jwt:      j,
-				bcrypt:   bcr,
-				userRepo: userRepo,
+				val:              val,
+				jwt:              j,
+				bcrypt:           bcr,
+				organizationRepo: organizationRepo,
+				userRepo:         userRepo,
 			},
 			args: args{
 				params: AuthenticateParams{
@@ -78,10 +83,11 @@ func TestAuthenticate_Execute(t *testing.T) {
 		{
 			name: "should not authenticate without email",
 			fields: fields{
-				val:      val,
-				jwt:      j,
-				bcrypt:   bcr,
-				userRepo: userRepo,
+				val:              val,
+				jwt:              j,
+				bcrypt:           bcr,
+				organizationRepo: organizationRepo,
+				userRepo:         userRepo,
 			},
 			args: args{
 				params: AuthenticateParams{
@@ -93,10 +99,11 @@ func TestAuthenticate_Execute(t *testing.T) {
 		{
 			name: "should not authenticate without password",
 			fields: fields{
-				val:      val,
-				jwt:      j,
-				bcrypt:   bcr,
-				userRepo: userRepo,
+				val:              val,
+				jwt:              j,
+				bcrypt:           bcr,
+				organizationRepo: organizationRepo,
+				userRepo:         userRepo,
 			},
 			args: args{
 				params: AuthenticateParams{
@@ -108,10 +115,11 @@ func TestAuthenticate_Execute(t *testing.T) {
 		{
 			name: "should not authenticate with invalid email",
 			fields: fields{
-				val:      val,
-				jwt:      j,
-				bcrypt:   bcr,
-				userRepo: userRepo,
+				val:              val,
+				jwt:              j,
+				bcrypt:           bcr,
+				organizationRepo: organizationRepo,
+				userRepo:         userRepo,
 			},
 			args: args{
 				params: AuthenticateParams{
@@ -124,10 +132,11 @@ func TestAuthenticate_Execute(t *testing.T) {
 		{
 			name: "should not authenticate with non-existing email",
 			fields: fields{
-				val:      val,
-				jwt:      j,
-				bcrypt:   bcr,
-				userRepo: userRepo,
+				val:              val,
+				jwt:              j,
+				bcrypt:           bcr,
+				organizationRepo: organizationRepo,
+				userRepo:         userRepo,
 			},
 			args: args{
 				params: AuthenticateParams{
@@ -140,10 +149,11 @@ func TestAuthenticate_Execute(t *testing.T) {
 		{
 			name: "should not authenticate with wrong password",
 			fields: fields{
-				val:      val,
-				jwt:      j,
-				bcrypt:   bcr,
-				userRepo: userRepo,
+				val:              val,
+				jwt:              j,
+				bcrypt:           bcr,
+				organizationRepo: organizationRepo,
+				userRepo:         userRepo,
 			},
 			args: args{
 				params: AuthenticateParams{
@@ -162,6 +172,7 @@ func TestAuthenticate_Execute(t *testing.T) {
 				tt.fields.val,
 				tt.fields.jwt,
 				tt.fields.bcrypt,
+				tt.fields.organizationRepo,
 				tt.fields.userRepo,
 			)
 
@@ -0,0 +1,227 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+)
+
+type BulkTaskOperation string
+
+const (
+	BulkTaskOperationCreate   BulkTaskOperation = "create"
+	BulkTaskOperationReassign BulkTaskOperation = "reassign"
+	BulkTaskOperationFinish   BulkTaskOperation = "finish"
+	BulkTaskOperationDelete   BulkTaskOperation = "delete"
+)
+
+// BulkMode tells what happens to the batch when one of its items fails.
+type BulkMode string
+
+const (
+	// BulkModeAllOrNothing applies every item in a single transaction,
+	// which is rolled back if any of them fails.
+	BulkModeAllOrNothing BulkMode = "all_or_nothing"
+	// BulkModeBestEffort applies each item in its own transaction, so
+	// the items that succeed are kept.
+	BulkModeBestEffort BulkMode = "best_effort"
+)
+
+// errBulkRollback rolls back the batch transaction when an item fails.
+var errBulkRollback = errors.New("bulk operation rolled back")
+
+type BulkTasks struct {
+	validator         validator.Validator
+	createTaskUseCase *CreateTask
+	reassignUseCase   *ReassignTask
+	transitionUseCase *TransitionTask
+	deleteTaskUseCase *DeleteTask
+	tx                transactioner.Transactioner
+}
+
+func NewBulkTasks(
+	validator validator.Validator,
+	createTaskUseCase *CreateTask,
+	reassignUseCase *ReassignTask,
+	transitionUseCase *TransitionTask,
+	deleteTaskUseCase *DeleteTask,
+	tx transactioner.Transactioner,
+) *BulkTasks {
+	return &BulkTasks{
+		validator:         validator,
+		createTaskUseCase: createTaskUseCase,
+		reassignUseCase:   reassignUseCase,
+		transitionUseCase: transitionUseCase,
+		deleteTaskUseCase: deleteTaskUseCase,
+		tx:                tx,
+	}
+}
+
+// BulkTaskItem holds the fields used by the operation, the task ID for
+// reassign, finish and delete, and the task fields for create.
+type BulkTaskItem struct {
+	TaskID           string   `json:"task_id,omitempty"`
+	Summary          string   `json:"summary,omitempty"`
+	AssignedToUserID string   `json:"assigned_to_user_id,omitempty"`
+	LabelIDs         []string `json:"label_ids,omitempty"`
+}
+
+type BulkTasksParams struct {
+	UserID    string            `json:"user_id,omitempty"   validate:"required,uuid"`
+	UserRole  entity.Role       `json:"user_role,omitempty" validate:"required,min=1,max=2"`
+	Operation BulkTaskOperation `json:"operation,omitempty" validate:"required,oneof=create reassign finish delete"`
+	Mode      BulkMode          `json:"mode,omitempty"      validate:"required,oneof=all_or_nothing best_effort"`
+	Items     []BulkTaskItem    `json:"items,omitempty"     validate:"required,min=1,max=100"`
+}
+
+// BulkTaskItemResult is the outcome of an item, Err is nil when it
+// was applied and wraps an *entity.Err otherwise.
+type BulkTaskItemResult struct {
+	Index  int    `json:"index"`
+	TaskID string `json:"task_id,omitempty"`
+	Err    error  `json:"-"`
+}
+
+type BulkTasksResult struct {
+	Succeeded int                  `json:"succeeded"`
+	Failed    int                  `json:"failed"`
+	Results   []BulkTaskItemResult `json:"results"`
+}
+
+// Execute applies the operation to every item, returning one result
+// per item in the same order. Messages are published only after the
+// transaction of the item, or of the whole batch, commits.
+func (b *BulkTasks) Execute(
+	ctx context.Context,
+	params BulkTasksParams,
+) (BulkTasksResult, error) {
+	if params.Mode == "" {
+		params.Mode = BulkModeAllOrNothing
+	}
+
+	if err := b.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return BulkTasksResult{}, validationErr
+	}
+
+	results := make([]BulkTaskItemResult, len(params.Items))
+
+	switch params.Mode {
+	case BulkModeBestEffort:
+		for i, item := range params.Items {
+			results[i] = b.applyItem(ctx, params, i, item)
+		}
+
+	case BulkModeAllOrNothing:
+		err := b.tx.Do(ctx, func(ctx context.Context) error {
+			var failed bool
+			for i, item := range params.Items {
+				results[i] = b.applyItem(ctx, params, i, item)
+				failed = failed || results[i].Err != nil
+			}
+
+			if failed {
+				return errBulkRollback
+			}
+
+			return nil
+		})
+
+		if errors.Is(err, errBulkRollback) {
+			for i := range results {
+				if results[i].Err == nil {
+					results[i].Err = entity.ErrBulkItemRolledBack
+				}
+				if params.Operation == BulkTaskOperationCreate {
+					results[i].TaskID = ""
+				}
+			}
+		} else if err != nil {
+			return BulkTasksResult{}, entity.NewErr(err)
+		}
+	}
+
+	result := BulkTasksResult{Results: results}
+	for _, itemResult := range results {
+		if itemResult.Err != nil {
+			result.Failed++
+		} else {
+			result.Succeeded++
+		}
+	}
+
+	return result, nil
+}
+
+// applyItem runs the operation on the item in a transaction, which
+// joins the batch transaction in the all or nothing mode.
+func (b *BulkTasks) applyItem(
+	ctx context.Context,
+	params BulkTasksParams,
+	index int,
+	item BulkTaskItem,
+) BulkTaskItemResult {
+	result := BulkTaskItemResult{
+		Index:  index,
+		TaskID: item.TaskID,
+	}
+
+	err := b.tx.Do(ctx, func(ctx context.Context) error {
+		switch params.Operation {
+		case BulkTaskOperationCreate:
+			taskID, err := b.createTaskUseCase.Execute(ctx, CreateTaskParams{
+				UserRole:         params.UserRole,
+				Summary:          item.Summary,
+				CreatedByUserID:  params.UserID,
+				AssignedToUserID: item.AssignedToUserID,
+				LabelIDs:         item.LabelIDs,
+			})
+			result.TaskID = taskID
+			return err
+
+		case BulkTaskOperationReassign:
+			return b.reassignUseCase.Execute(ctx, ReassignTaskParams{
+				TaskID:           item.TaskID,
+				UserID:           params.UserID,
+				UserRole:         params.UserRole,
+				AssignedToUserID: item.AssignedToUserID,
+			})
+
+		case BulkTaskOperationFinish:
+			return b.transitionUseCase.Execute(ctx, TransitionTaskParams{
+				TaskID:   item.TaskID,
+				UserID:   params.UserID,
+				UserRole: params.UserRole,
+				Status:   entity.TaskStatusDone,
+			})
+
+		case BulkTaskOperationDelete:
+			return b.deleteTaskUseCase.Execute(ctx, DeleteTaskParams{
+				TaskID:   item.TaskID,
+				UserID:   params.UserID,
+				UserRole: params.UserRole,
+			})
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		result.Err = snapshotErr(err)
+	}
+
+	return result
+}
+
+// snapshotErr wraps err as an entity error, keeping its current
+// message. Use cases fill the message of the shared validation errors,
+// so holding on to them to report several failures would otherwise show
+// the message of the last one only.
+func snapshotErr(err error) error {
+	return fmt.Errorf("%w", entity.NewErr(err))
+}
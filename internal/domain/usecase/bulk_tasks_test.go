@@ -0,0 +1,361 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/broker"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+// recordingBroker keeps the topics of the published messages.
+type recordingBroker struct {
+	mutex  sync.Mutex
+	topics []broker.Topic
+}
+
+func (rb *recordingBroker) Publish(topic broker.Topic, _ []byte) error {
+	rb.mutex.Lock()
+	defer rb.mutex.Unlock()
+	rb.topics = append(rb.topics, topic)
+	return nil
+}
+
+func (rb *recordingBroker) Subscribe(broker.Topic, broker.Handler) error {
+	return nil
+}
+
+func TestBulkTasks_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	managerUser := entity.User{
+		ID:   uuid.NewString(),
+		Role: entity.RoleManager,
+	}
+
+	technicianUser := entity.User{
+		ID:   uuid.NewString(),
+		Role: entity.RoleTechnician,
+	}
+
+	newTask := func() entity.Task {
+		return entity.Task{
+			ID:               uuid.NewString(),
+			Summary:          "Loren ipsum dolor sit amet",
+			Status:           entity.TaskStatusInReview,
+			AssignedToUserID: &technicianUser.ID,
+			CreatedByUserID:  managerUser.ID,
+			CreatedAt:        time.Now(),
+			UpdatedAt:        time.Now(),
+		}
+	}
+
+	firstTask := newTask()
+	secondTask := newTask()
+
+	project := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Maintenance",
+		ManagerUserID: uuid.NewString(),
+	}
+	projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+	projectRepo.Projects = append(projectRepo.Projects, project)
+
+	projectTask := newTask()
+	projectTask.ProjectID = &project.ID
+
+	type args struct {
+		params BulkTasksParams
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantItemErrs  []error
+		wantPublished int
+		wantErr       error
+	}{
+		{
+			name: "should finish every task",
+			args: args{
+				params: BulkTasksParams{
+					UserID:    managerUser.ID,
+					UserRole:  entity.RoleManager,
+					Operation: BulkTaskOperationFinish,
+					Items: []BulkTaskItem{
+						{TaskID: firstTask.ID},
+						{TaskID: secondTask.ID},
+					},
+				},
+			},
+			wantItemErrs: []error{nil, nil},
+			// Each finished task publishes a status changed and a
+			// finished message.
+			wantPublished: 4,
+			wantErr:       nil,
+		},
+		{
+			name: "should roll back every item if one fails",
+			args: args{
+				params: BulkTasksParams{
+					UserID:    managerUser.ID,
+					UserRole:  entity.RoleManager,
+					Operation: BulkTaskOperationFinish,
+					Mode:      BulkModeAllOrNothing,
+					Items: []BulkTaskItem{
+						{TaskID: firstTask.ID},
+						{TaskID: uuid.NewString()},
+					},
+				},
+			},
+			wantItemErrs: []error{
+				entity.ErrBulkItemRolledBack,
+				entity.ErrTaskNotFound,
+			},
+			wantPublished: 0,
+			wantErr:       nil,
+		},
+		{
+			name: "should keep the items that succeed in best effort mode",
+			args: args{
+				params: BulkTasksParams{
+					UserID:    managerUser.ID,
+					UserRole:  entity.RoleManager,
+					Operation: BulkTaskOperationFinish,
+					Mode:      BulkModeBestEffort,
+					Items: []BulkTaskItem{
+						{TaskID: firstTask.ID},
+						{TaskID: uuid.NewString()},
+					},
+				},
+			},
+			wantItemErrs:  []error{nil, entity.ErrTaskNotFound},
+			wantPublished: 2,
+			wantErr:       nil,
+		},
+		{
+			name: "should create tasks",
+			args: args{
+				params: BulkTasksParams{
+					UserID:    managerUser.ID,
+					UserRole:  entity.RoleManager,
+					Operation: BulkTaskOperationCreate,
+					Items: []BulkTaskItem{
+						{Summary: "Loren ipsum"},
+						{
+							Summary:          "Dolor sit amet",
+							AssignedToUserID: technicianUser.ID,
+						},
+					},
+				},
+			},
+			wantItemErrs: []error{nil, nil},
+			wantErr:      nil,
+		},
+		{
+			name: "should not reassign tasks if user role is not manager",
+			args: args{
+				params: BulkTasksParams{
+					UserID:    technicianUser.ID,
+					UserRole:  entity.RoleTechnician,
+					Operation: BulkTaskOperationReassign,
+					Mode:      BulkModeBestEffort,
+					Items: []BulkTaskItem{
+						{
+							TaskID:           firstTask.ID,
+							AssignedToUserID: technicianUser.ID,
+						},
+					},
+				},
+			},
+			wantItemErrs: []error{entity.ErrUserNotAllowedToUpdateAssignedUser},
+			wantErr:      nil,
+		},
+		{
+			name: "should not finish tasks of a project the user is not a member of",
+			args: args{
+				params: BulkTasksParams{
+					UserID:    managerUser.ID,
+					UserRole:  entity.RoleManager,
+					Operation: BulkTaskOperationFinish,
+					Mode:      BulkModeBestEffort,
+					Items: []BulkTaskItem{
+						{TaskID: firstTask.ID},
+						{TaskID: projectTask.ID},
+					},
+				},
+			},
+			wantItemErrs:  []error{nil, entity.ErrUserNotProjectMember},
+			wantPublished: 2,
+			wantErr:       nil,
+		},
+		{
+			name: "should not reassign tasks of a project the user is not a member of",
+			args: args{
+				params: BulkTasksParams{
+					UserID:    managerUser.ID,
+					UserRole:  entity.RoleManager,
+					Operation: BulkTaskOperationReassign,
+					Mode:      BulkModeBestEffort,
+					Items: []BulkTaskItem{
+						{
+							TaskID:           projectTask.ID,
+							AssignedToUserID: technicianUser.ID,
+						},
+					},
+				},
+			},
+			wantItemErrs: []error{entity.ErrUserNotProjectMember},
+			wantErr:      nil,
+		},
+		{
+			name: "should not run an unknown operation",
+			args: args{
+				params: BulkTasksParams{
+					UserID:    managerUser.ID,
+					UserRole:  entity.RoleManager,
+					Operation: "archive",
+					Items:     []BulkTaskItem{{TaskID: firstTask.ID}},
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+		{
+			name: "should not run without items",
+			args: args{
+				params: BulkTasksParams{
+					UserID:    managerUser.ID,
+					UserRole:  entity.RoleManager,
+					Operation: BulkTaskOperationDelete,
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userRepo := inmemoryrepo.NewInMemoryUserRepo()
+			userRepo.Users = append(userRepo.Users, managerUser, technicianUser)
+
+			taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+			taskRepo.Tasks = append(
+				taskRepo.Tasks,
+				firstTask,
+				secondTask,
+				projectTask,
+			)
+
+			taskEventRepo := inmemoryrepo.NewInMemoryTaskEventRepo()
+			msgBroker := &recordingBroker{}
+			tx := transactioner.NewNoopTransactioner()
+
+			b := NewBulkTasks(
+				val,
+				NewCreateTask(
+					val,
+					symCrypto,
+					taskRepo,
+					userRepo,
+					inmemoryrepo.NewInMemoryLabelRepo(),
+					projectRepo,
+					taskEventRepo,
+					tx,
+				),
+				NewReassignTask(
+					val,
+					taskRepo,
+					projectRepo,
+					userRepo,
+					taskEventRepo,
+					tx,
+				),
+				NewTransitionTask(
+					val,
+					msgBroker,
+					taskRepo,
+					projectRepo,
+					taskEventRepo,
+					inmemoryrepo.NewInMemoryChecklistRepo(),
+					inmemoryrepo.NewInMemoryTaskDependencyRepo(),
+					tx,
+					NewFinishTask(
+						val,
+						msgBroker,
+						taskRepo,
+						taskEventRepo,
+						inmemoryrepo.NewInMemoryChecklistRepo(),
+						inmemoryrepo.NewInMemoryTaskDependencyRepo(),
+						tx,
+					),
+				),
+				NewDeleteTask(
+					val,
+					taskRepo,
+					projectRepo,
+					taskEventRepo,
+					tx,
+				),
+				tx,
+			)
+
+			got, err := b.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"BulkTasks.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if len(got.Results) != len(tt.wantItemErrs) {
+				t.Fatalf(
+					"BulkTasks.Execute() results = %v, want %v",
+					len(got.Results),
+					len(tt.wantItemErrs),
+				)
+			}
+
+			for i, result := range got.Results {
+				if result.Index != i ||
+					!testutil.IsSameErr(result.Err, tt.wantItemErrs[i]) {
+					t.Errorf(
+						"BulkTasks.Execute() results[%d] = %v, want error %v",
+						i,
+						result,
+						tt.wantItemErrs[i],
+					)
+				}
+				if result.Err == nil && result.TaskID == "" {
+					t.Errorf(
+						"BulkTasks.Execute() results[%d] has no task id",
+						i,
+					)
+				}
+			}
+
+			if len(msgBroker.topics) != tt.wantPublished {
+				t.Errorf(
+					"BulkTasks.Execute() published = %v, want %v",
+					msgBroker.topics,
+					tt.wantPublished,
+				)
+			}
+		})
+	}
+}
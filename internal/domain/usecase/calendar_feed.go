@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+)
+
+// newCalendarFeedToken returns a random token to be handed to the user
+// along with its hash, which is what gets stored.
+func newCalendarFeedToken() (token, tokenHash string, err error) {
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return "", "", err
+	}
+
+	token = base64.RawURLEncoding.EncodeToString(tokenBytes)
+
+	return token, hashCalendarFeedToken(token), nil
+}
+
+// hashCalendarFeedToken hashes tokens with SHA-256 rather than the
+// password hasher, since they are random and looked up by their hash.
+func hashCalendarFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+const (
+	icalTimeLayout = "20060102T150405Z"
+	// icalMaxLineLength is the maximum length in octets of the lines of
+	// iCalendar files, longer ones are folded.
+	icalMaxLineLength = 75
+)
+
+// icalWriter builds iCalendar (RFC 5545) files line by line.
+type icalWriter struct {
+	builder strings.Builder
+}
+
+// writeLine writes the content line, folding it when it is too long
+// without splitting multi-byte characters.
+func (w *icalWriter) writeLine(name, value string) {
+	line := name + ":" + value
+
+	// Continuation lines start with a space, which counts toward their
+	// length.
+	limit := icalMaxLineLength
+	for len(line) > limit {
+		cut := limit
+		for !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		w.builder.WriteString(line[:cut])
+		w.builder.WriteString("\r\n ")
+		line = line[cut:]
+		limit = icalMaxLineLength - 1
+	}
+
+	w.builder.WriteString(line)
+	w.builder.WriteString("\r\n")
+}
+
+func (w *icalWriter) writeTime(name string, value time.Time) {
+	w.writeLine(name, value.UTC().Format(icalTimeLayout))
+}
+
+func (w *icalWriter) bytes() []byte {
+	return []byte(w.builder.String())
+}
+
+var icalTextEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// escapeICalText escapes the characters with a special meaning in
+// iCalendar text values.
+func escapeICalText(text string) string {
+	return icalTextEscaper.Replace(text)
+}
+
+// icalPriority maps the task priority to the iCalendar one, which goes
+// from 1, the highest, to 9, the lowest.
+func icalPriority(priority entity.TaskPriority) int {
+	switch priority {
+	case entity.TaskPriorityCritical:
+		return 1
+	case entity.TaskPriorityHigh:
+		return 3
+	case entity.TaskPriorityLow:
+		return 9
+	default:
+		return 5
+	}
+}
+
+// writeTaskEvent writes the task as an event at its due date.
+func (w *icalWriter) writeTaskEvent(task entity.Task, now time.Time) {
+	w.writeLine("BEGIN", "VEVENT")
+	w.writeLine("UID", task.ID+"@tasks-api")
+	w.writeTime("DTSTAMP", now)
+	w.writeTime("DTSTART", *task.DueAt)
+	w.writeTime("LAST-MODIFIED", task.UpdatedAt)
+	w.writeLine("SUMMARY", escapeICalText(task.Summary))
+	w.writeLine("DESCRIPTION", escapeICalText(fmt.Sprintf(
+		"Status: %s\nPriority: %s",
+		task.Status,
+		task.Priority,
+	)))
+	w.writeLine("PRIORITY", fmt.Sprint(icalPriority(task.Priority)))
+	w.writeLine("END", "VEVENT")
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+// setChecklistProgress fills the checklist progress of the tasks.
+func setChecklistProgress(
+	ctx context.Context,
+	checklistRepo repo.ChecklistRepo,
+	tasks []entity.Task,
+) error {
+	taskIDs := make([]string, len(tasks))
+	for i, task := range tasks {
+		taskIDs[i] = task.ID
+	}
+
+	progressByTaskID, err := checklistRepo.CountChecklistItems(ctx, taskIDs)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	for i, task := range tasks {
+		if progress, ok := progressByTaskID[task.ID]; ok {
+			tasks[i].ChecklistProgress = progress.String()
+		}
+	}
+
+	return nil
+}
+
+// ensureChecklistDone returns ErrTaskHasOpenChecklistItems if any
+// required checklist item of the task is not done.
+func ensureChecklistDone(
+	ctx context.Context,
+	checklistRepo repo.ChecklistRepo,
+	taskID string,
+) error {
+	items, err := checklistRepo.ListChecklistItems(ctx, taskID)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	for _, item := range items {
+		if item.Required && !item.Done {
+			return entity.ErrTaskHasOpenChecklistItems
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type CreateCalendarFeedToken struct {
+	validator        validator.Validator
+	calendarFeedRepo repo.CalendarFeedRepo
+}
+
+func NewCreateCalendarFeedToken(
+	validator validator.Validator,
+	calendarFeedRepo repo.CalendarFeedRepo,
+) *CreateCalendarFeedToken {
+	return &CreateCalendarFeedToken{
+		validator:        validator,
+		calendarFeedRepo: calendarFeedRepo,
+	}
+}
+
+type CreateCalendarFeedTokenParams struct {
+	UserID   string      `json:"user_id,omitempty"   validate:"required,uuid"`
+	UserRole entity.Role `json:"user_role,omitempty" validate:"required,min=1,max=2"`
+}
+
+// Execute returns a new token for the calendar feed of the user,
+// revoking the previous one. The token is not stored, so it can not be
+// retrieved again.
+func (c *CreateCalendarFeedToken) Execute(
+	ctx context.Context,
+	params CreateCalendarFeedTokenParams,
+) (string, error) {
+	if err := c.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return "", validationErr
+	}
+
+	if params.UserRole != entity.RoleTechnician {
+		return "", entity.ErrUserNotAllowedToUseCalendarFeed
+	}
+
+	token, tokenHash, err := newCalendarFeedToken()
+	if err != nil {
+		return "", entity.NewErr(err)
+	}
+
+	if err := c.calendarFeedRepo.SetCalendarFeedToken(
+		ctx,
+		params.UserID,
+		tokenHash,
+	); err != nil {
+		return "", entity.NewErr(err)
+	}
+
+	return token, nil
+}
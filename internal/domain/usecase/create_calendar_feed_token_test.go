@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/tenant"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestCreateCalendarFeedToken_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	tests := []struct {
+		name     string
+		userRole entity.Role
+		wantErr  error
+	}{
+		{
+			name:     "should create a token that replaces the previous one",
+			userRole: entity.RoleTechnician,
+			wantErr:  nil,
+		},
+		{
+			name:     "should not create a token if user role is not technician",
+			userRole: entity.RoleManager,
+			wantErr:  entity.ErrUserNotAllowedToUseCalendarFeed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			user := entity.User{
+				ID:             uuid.NewString(),
+				OrganizationID: uuid.NewString(),
+				Role:           tt.userRole,
+			}
+
+			userRepo := inmemoryrepo.NewInMemoryUserRepo()
+			userRepo.Users = append(userRepo.Users, user)
+
+			calendarFeedRepo := inmemoryrepo.NewInMemoryCalendarFeedRepo()
+			c := NewCreateCalendarFeedToken(val, calendarFeedRepo)
+			g := NewGetCalendarFeed(
+				val,
+				symCrypto,
+				inmemoryrepo.NewInMemoryOrganizationRepo(
+					userRepo,
+					calendarFeedRepo,
+				),
+				calendarFeedRepo,
+				inmemoryrepo.NewInMemoryTaskRepo(),
+			)
+			r := NewRevokeCalendarFeedToken(val, calendarFeedRepo)
+
+			ctx := tenant.WithOrganizationID(
+				context.Background(),
+				user.OrganizationID,
+			)
+
+			params := CreateCalendarFeedTokenParams{
+				UserID:   user.ID,
+				UserRole: user.Role,
+			}
+
+			firstToken, err := c.Execute(ctx, params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Fatalf(
+					"CreateCalendarFeedToken.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if err != nil {
+				return
+			}
+
+			secondToken, err := c.Execute(ctx, params)
+			if err != nil {
+				t.Fatalf("CreateCalendarFeedToken.Execute() error = %v", err)
+			}
+
+			if _, err := g.Execute(
+				context.Background(),
+				GetCalendarFeedParams{Token: firstToken},
+			); !testutil.IsSameErr(err, entity.ErrInvalidCalendarFeedToken) {
+				t.Errorf(
+					"GetCalendarFeed.Execute() with the previous token error = %v, want %v",
+					err,
+					entity.ErrInvalidCalendarFeedToken,
+				)
+			}
+
+			if _, err := g.Execute(
+				context.Background(),
+				GetCalendarFeedParams{Token: secondToken},
+			); err != nil {
+				t.Errorf("GetCalendarFeed.Execute() error = %v", err)
+			}
+
+			if err := r.Execute(
+				ctx,
+				RevokeCalendarFeedTokenParams{UserID: params.UserID},
+			); err != nil {
+				t.Fatalf("RevokeCalendarFeedToken.Execute() error = %v", err)
+			}
+
+			if _, err := g.Execute(
+				context.Background(),
+				GetCalendarFeedParams{Token: secondToken},
+			); !testutil.IsSameErr(err, entity.ErrInvalidCalendarFeedToken) {
+				t.Errorf(
+					"GetCalendarFeed.Execute() with the revoked token error = %v, want %v",
+					err,
+					entity.ErrInvalidCalendarFeedToken,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type CreateChecklistItem struct {
+	validator     validator.Validator
+	symCrypto     symcrypt.SymmetricalEncrypter
+	taskRepo      repo.TaskRepo
+	projectRepo   repo.ProjectRepo
+	checklistRepo repo.ChecklistRepo
+	tx            transactioner.Transactioner
+}
+
+func NewCreateChecklistItem(
+	validator validator.Validator,
+	symCrypto symcrypt.SymmetricalEncrypter,
+	taskRepo repo.TaskRepo,
+	projectRepo repo.ProjectRepo,
+	checklistRepo repo.ChecklistRepo,
+	tx transactioner.Transactioner,
+) *CreateChecklistItem {
+	return &CreateChecklistItem{
+		validator:     validator,
+		symCrypto:     symCrypto,
+		taskRepo:      taskRepo,
+		projectRepo:   projectRepo,
+		checklistRepo: checklistRepo,
+		tx:            tx,
+	}
+}
+
+type CreateChecklistItemParams struct {
+	TaskID   string      `json:"task_id,omitempty" validate:"required,uuid"`
+	UserID   string      `json:"user_id,omitempty" validate:"required,uuid"`
+	UserRole entity.Role `json:"role,omitempty"    validate:"required,min=1,max=2"`
+	Title    string      `json:"title,omitempty"   validate:"required,max=500"`
+	Required bool        `json:"required,omitempty"`
+}
+
+// Execute appends an item to the end of the task checklist.
+func (c *CreateChecklistItem) Execute(
+	ctx context.Context,
+	params CreateChecklistItemParams,
+) (entity.ChecklistItem, error) {
+	if err := c.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return entity.ChecklistItem{}, validationErr
+	}
+
+	task, err := c.taskRepo.GetTaskByID(ctx, params.TaskID)
+	if err != nil {
+		return entity.ChecklistItem{}, entity.NewErr(err)
+	}
+
+	if task.ID == "" {
+		return entity.ChecklistItem{}, entity.ErrTaskNotFound
+	}
+
+	if err := ensureTaskVisible(
+		ctx,
+		c.projectRepo,
+		task,
+		params.UserID,
+		params.UserRole,
+		entity.ErrUserNotAllowedToEditChecklist,
+	); err != nil {
+		return entity.ChecklistItem{}, err
+	}
+
+	encryptedTitle, err := c.symCrypto.Encrypt(params.Title)
+	if err != nil {
+		return entity.ChecklistItem{}, entity.NewErr(err)
+	}
+
+	repoParams := repo.CreateChecklistItemParams{
+		ID:       uuid.NewString(),
+		TaskID:   task.ID,
+		Title:    encryptedTitle,
+		Required: params.Required,
+	}
+
+	var item entity.ChecklistItem
+	err = c.tx.Do(ctx, func(ctx context.Context) error {
+		items, err := c.checklistRepo.ListChecklistItems(ctx, task.ID)
+		if err != nil {
+			return entity.NewErr(err)
+		}
+
+		repoParams.Position = 1
+		if len(items) > 0 {
+			repoParams.Position = items[len(items)-1].Position + 1
+		}
+
+		if err := c.checklistRepo.CreateChecklistItem(
+			ctx,
+			repoParams,
+		); err != nil {
+			return entity.NewErr(err)
+		}
+
+		item, err = c.checklistRepo.GetChecklistItemByID(ctx, repoParams.ID)
+		if err != nil {
+			return entity.NewErr(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return entity.ChecklistItem{}, entity.NewErr(err)
+	}
+
+	item.Title = params.Title
+
+	return item, nil
+}
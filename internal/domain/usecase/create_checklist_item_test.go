@@ -0,0 +1,194 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestCreateChecklistItem_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	managerID := uuid.NewString()
+	technicianID := uuid.NewString()
+
+	task := entity.Task{
+		ID:               uuid.NewString(),
+		Summary:          "Loren ipsum dolor sit amet",
+		Status:           entity.TaskStatusOpen,
+		AssignedToUserID: &technicianID,
+		CreatedByUserID:  managerID,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+
+	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+	taskRepo.Tasks = append(taskRepo.Tasks, task)
+
+	project := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Maintenance",
+		ManagerUserID: uuid.NewString(),
+	}
+	projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+	projectRepo.Projects = append(projectRepo.Projects, project)
+
+	projectTask := task
+	projectTask.ID = uuid.NewString()
+	projectTask.ProjectID = &project.ID
+	taskRepo.Tasks = append(taskRepo.Tasks, projectTask)
+
+	type args struct {
+		params CreateChecklistItemParams
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantPosition int
+		wantErr      error
+	}{
+		{
+			name: "should append item to the checklist if user is a manager",
+			args: args{
+				params: CreateChecklistItemParams{
+					TaskID:   task.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					Title:    "Replace the filter",
+					Required: true,
+				},
+			},
+			wantPosition: 3,
+			wantErr:      nil,
+		},
+		{
+			name: "should append item to the checklist if user is the assignee",
+			args: args{
+				params: CreateChecklistItemParams{
+					TaskID:   task.ID,
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+					Title:    "Replace the filter",
+				},
+			},
+			wantPosition: 3,
+			wantErr:      nil,
+		},
+		{
+			name: "should not append item if user is not the assignee",
+			args: args{
+				params: CreateChecklistItemParams{
+					TaskID:   task.ID,
+					UserID:   uuid.NewString(),
+					UserRole: entity.RoleTechnician,
+					Title:    "Replace the filter",
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToEditChecklist,
+		},
+		{
+			name: "should not append item if title is too long",
+			args: args{
+				params: CreateChecklistItemParams{
+					TaskID:   task.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					Title:    strings.Repeat("a", 501),
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+		{
+			name: "should not append item if task does not exists",
+			args: args{
+				params: CreateChecklistItemParams{
+					TaskID:   uuid.NewString(),
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					Title:    "Replace the filter",
+				},
+			},
+			wantErr: entity.ErrTaskNotFound,
+		},
+		{
+			name: "should not append item if user is not a project member",
+			args: args{
+				params: CreateChecklistItemParams{
+					TaskID:   projectTask.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					Title:    "Replace the filter",
+					Required: true,
+				},
+			},
+			wantErr: entity.ErrUserNotProjectMember,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			checklistRepo := inmemoryrepo.NewInMemoryChecklistRepo()
+			checklistRepo.Items = append(
+				checklistRepo.Items,
+				entity.ChecklistItem{ID: uuid.NewString(), TaskID: task.ID, Position: 1},
+				entity.ChecklistItem{ID: uuid.NewString(), TaskID: task.ID, Position: 2},
+			)
+
+			c := NewCreateChecklistItem(
+				val,
+				symCrypto,
+				taskRepo,
+				projectRepo,
+				checklistRepo,
+				transactioner.NewNoopTransactioner(),
+			)
+
+			got, err := c.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"CreateChecklistItem.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.Position != tt.wantPosition {
+				t.Errorf(
+					"CreateChecklistItem.Execute() position = %v, want %v",
+					got.Position,
+					tt.wantPosition,
+				)
+			}
+
+			if got.Title != tt.args.params.Title {
+				t.Errorf(
+					"CreateChecklistItem.Execute() title = %v, want %v",
+					got.Title,
+					tt.args.params.Title,
+				)
+			}
+
+			stored := checklistRepo.Items[len(checklistRepo.Items)-1]
+			if stored.Title == tt.args.params.Title {
+				t.Errorf("CreateChecklistItem.Execute() title was not encrypted")
+			}
+		})
+	}
+}
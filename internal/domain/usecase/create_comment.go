@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/broker"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type CreateComment struct {
+	validator   validator.Validator
+	symCrypto   symcrypt.SymmetricalEncrypter
+	msgBroker   broker.MessageBroker
+	taskRepo    repo.TaskRepo
+	projectRepo repo.ProjectRepo
+	commentRepo repo.CommentRepo
+	tx          transactioner.Transactioner
+}
+
+func NewCreateComment(
+	validator validator.Validator,
+	symCrypto symcrypt.SymmetricalEncrypter,
+	msgBroker broker.MessageBroker,
+	taskRepo repo.TaskRepo,
+	projectRepo repo.ProjectRepo,
+	commentRepo repo.CommentRepo,
+	tx transactioner.Transactioner,
+) *CreateComment {
+	return &CreateComment{
+		validator:   validator,
+		symCrypto:   symCrypto,
+		msgBroker:   msgBroker,
+		taskRepo:    taskRepo,
+		projectRepo: projectRepo,
+		commentRepo: commentRepo,
+		tx:          tx,
+	}
+}
+
+type CreateCommentParams struct {
+	TaskID   string      `json:"task_id,omitempty" validate:"required,uuid"`
+	UserID   string      `json:"user_id,omitempty" validate:"required,uuid"`
+	UserRole entity.Role `json:"role,omitempty"    validate:"required,min=1,max=2"`
+	Body     string      `json:"body,omitempty"    validate:"required,max=2500"`
+}
+
+func (c *CreateComment) Execute(
+	ctx context.Context,
+	params CreateCommentParams,
+) error {
+	if err := c.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return validationErr
+	}
+
+	task, err := c.taskRepo.GetTaskByID(ctx, params.TaskID)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if task.ID == "" {
+		return entity.ErrTaskNotFound
+	}
+
+	if err := ensureTaskVisible(
+		ctx,
+		c.projectRepo,
+		task,
+		params.UserID,
+		params.UserRole,
+		entity.ErrUserNotAllowedToCommentTask,
+	); err != nil {
+		return err
+	}
+
+	encryptedBody, err := c.symCrypto.Encrypt(params.Body)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	repoParams := repo.CreateCommentParams{
+		ID:     uuid.NewString(),
+		TaskID: task.ID,
+		UserID: params.UserID,
+		Body:   encryptedBody,
+	}
+
+	err = c.tx.Do(ctx, func(ctx context.Context) error {
+		if err := c.commentRepo.CreateComment(ctx, repoParams); err != nil {
+			return entity.NewErr(err)
+		}
+
+		commentBytes, err := json.Marshal(entity.Comment{
+			ID:        repoParams.ID,
+			TaskID:    repoParams.TaskID,
+			UserID:    repoParams.UserID,
+			Body:      repoParams.Body,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		})
+		if err != nil {
+			return entity.NewErr(err)
+		}
+
+		if err := publishAfterCommit(
+			ctx,
+			c.msgBroker,
+			broker.TopicTaskCommented,
+			commentBytes,
+		); err != nil {
+			return entity.NewErr(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
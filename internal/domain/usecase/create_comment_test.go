@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/broker/clibroker"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestCreateComment_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	managerID := uuid.NewString()
+	technicianID := uuid.NewString()
+
+	task := entity.Task{
+		ID:               uuid.NewString(),
+		Summary:          "Loren ipsum dolor sit amet",
+		Status:           entity.TaskStatusOpen,
+		AssignedToUserID: &technicianID,
+		CreatedByUserID:  managerID,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+
+	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+	taskRepo.Tasks = append(taskRepo.Tasks, task)
+
+	project := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Maintenance",
+		ManagerUserID: uuid.NewString(),
+	}
+	projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+	projectRepo.Projects = append(projectRepo.Projects, project)
+
+	projectTask := task
+	projectTask.ID = uuid.NewString()
+	projectTask.ProjectID = &project.ID
+	taskRepo.Tasks = append(taskRepo.Tasks, projectTask)
+
+	type args struct {
+		params CreateCommentParams
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "should comment on task if user is the assignee",
+			args: args{
+				params: CreateCommentParams{
+					TaskID:   task.ID,
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+					Body:     "Waiting on parts",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should comment on task if user is a manager",
+			args: args{
+				params: CreateCommentParams{
+					TaskID:   task.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					Body:     "Parts were ordered",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should not comment on task if user is not the assignee",
+			args: args{
+				params: CreateCommentParams{
+					TaskID:   task.ID,
+					UserID:   uuid.NewString(),
+					UserRole: entity.RoleTechnician,
+					Body:     "Waiting on parts",
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToCommentTask,
+		},
+		{
+			name: "should not comment on task without a body",
+			args: args{
+				params: CreateCommentParams{
+					TaskID:   task.ID,
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+		{
+			name: "should not comment on task if body is too long",
+			args: args{
+				params: CreateCommentParams{
+					TaskID:   task.ID,
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+					Body:     strings.Repeat("a", 2501),
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+		{
+			name: "should not comment on task if task does not exists",
+			args: args{
+				params: CreateCommentParams{
+					TaskID:   uuid.NewString(),
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					Body:     "Waiting on parts",
+				},
+			},
+			wantErr: entity.ErrTaskNotFound,
+		},
+		{
+			name: "should not comment on task if user is not a project member",
+			args: args{
+				params: CreateCommentParams{
+					TaskID:   projectTask.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					Body:     "Parts were ordered",
+				},
+			},
+			wantErr: entity.ErrUserNotProjectMember,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			commentRepo := inmemoryrepo.NewInMemoryCommentRepo()
+			c := NewCreateComment(
+				val,
+				symCrypto,
+				clibroker.NewCLIMessageBroker(),
+				taskRepo,
+				projectRepo,
+				commentRepo,
+				transactioner.NewNoopTransactioner(),
+			)
+
+			err := c.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"CreateComment.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if len(commentRepo.Comments) != 1 {
+				t.Fatalf(
+					"CreateComment.Execute() comments = %v, want 1",
+					len(commentRepo.Comments),
+				)
+			}
+
+			comment := commentRepo.Comments[0]
+			if comment.Body == tt.args.params.Body {
+				t.Errorf("CreateComment.Execute() comment body was not encrypted")
+			}
+
+			if comment.UserID != tt.args.params.UserID {
+				t.Errorf(
+					"CreateComment.Execute() comment.UserID = %v, want %v",
+					comment.UserID,
+					tt.args.params.UserID,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type CreateLabel struct {
+	validator validator.Validator
+	labelRepo repo.LabelRepo
+}
+
+func NewCreateLabel(
+	validator validator.Validator,
+	labelRepo repo.LabelRepo,
+) *CreateLabel {
+	return &CreateLabel{
+		validator: validator,
+		labelRepo: labelRepo,
+	}
+}
+
+type CreateLabelParams struct {
+	UserRole entity.Role `json:"user_role,omitempty" validate:"required,min=1,max=2"`
+	Name     string      `json:"name,omitempty"      validate:"required,max=50"`
+}
+
+func (c *CreateLabel) Execute(
+	ctx context.Context,
+	params CreateLabelParams,
+) (entity.Label, error) {
+	if params.UserRole != entity.RoleManager {
+		return entity.Label{}, entity.ErrUserNotAllowedToManageLabels
+	}
+
+	params.Name = strings.TrimSpace(params.Name)
+
+	if err := c.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return entity.Label{}, validationErr
+	}
+
+	existingLabel, err := c.labelRepo.GetLabelByName(ctx, params.Name)
+	if err != nil {
+		return entity.Label{}, entity.NewErr(err)
+	}
+
+	if existingLabel.ID != "" {
+		return entity.Label{}, entity.ErrLabelAlreadyExists
+	}
+
+	repoParams := repo.CreateLabelParams{
+		ID:   uuid.NewString(),
+		Name: params.Name,
+	}
+
+	if err := c.labelRepo.CreateLabel(ctx, repoParams); err != nil {
+		return entity.Label{}, entity.NewErr(err)
+	}
+
+	label, err := c.labelRepo.GetLabelByID(ctx, repoParams.ID)
+	if err != nil {
+		return entity.Label{}, entity.NewErr(err)
+	}
+
+	return label, nil
+}
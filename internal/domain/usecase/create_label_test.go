@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestCreateLabel_Execute(t *testing.T) {
+	val := validator.NewValidate()
+
+	existingLabel := entity.Label{
+		ID:   uuid.NewString(),
+		Name: "urgent",
+	}
+
+	type args struct {
+		params CreateLabelParams
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantName string
+		wantErr  error
+	}{
+		{
+			name: "should create a label",
+			args: args{
+				params: CreateLabelParams{
+					UserRole: entity.RoleManager,
+					Name:     "  hardware ",
+				},
+			},
+			wantName: "hardware",
+			wantErr:  nil,
+		},
+		{
+			name: "should not create a label if user role is not manager",
+			args: args{
+				params: CreateLabelParams{
+					UserRole: entity.RoleTechnician,
+					Name:     "hardware",
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToManageLabels,
+		},
+		{
+			name: "should not create a label with a taken name",
+			args: args{
+				params: CreateLabelParams{
+					UserRole: entity.RoleManager,
+					Name:     existingLabel.Name,
+				},
+			},
+			wantErr: entity.ErrLabelAlreadyExists,
+		},
+		{
+			name: "should not create a label with a blank name",
+			args: args{
+				params: CreateLabelParams{
+					UserRole: entity.RoleManager,
+					Name:     "   ",
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+		{
+			name: "should not create a label if name is too long",
+			args: args{
+				params: CreateLabelParams{
+					UserRole: entity.RoleManager,
+					Name:     strings.Repeat("a", 51),
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			labelRepo := inmemoryrepo.NewInMemoryLabelRepo()
+			labelRepo.Labels = append(labelRepo.Labels, existingLabel)
+
+			c := NewCreateLabel(val, labelRepo)
+
+			got, err := c.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"CreateLabel.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.ID == "" || got.Name != tt.wantName {
+				t.Errorf(
+					"CreateLabel.Execute() = %v, want name %v",
+					got,
+					tt.wantName,
+				)
+			}
+		})
+	}
+}
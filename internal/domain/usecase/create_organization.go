@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type CreateOrganization struct {
+	validator        validator.Validator
+	organizationRepo repo.OrganizationRepo
+}
+
+func NewCreateOrganization(
+	validator validator.Validator,
+	organizationRepo repo.OrganizationRepo,
+) *CreateOrganization {
+	return &CreateOrganization{
+		validator:        validator,
+		organizationRepo: organizationRepo,
+	}
+}
+
+type CreateOrganizationParams struct {
+	Name string `json:"name,omitempty" validate:"required,max=255"`
+}
+
+func (c *CreateOrganization) Execute(
+	ctx context.Context,
+	params CreateOrganizationParams,
+) (entity.Organization, error) {
+	params.Name = strings.TrimSpace(params.Name)
+
+	if err := c.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return entity.Organization{}, validationErr
+	}
+
+	repoParams := repo.CreateOrganizationParams{
+		ID:   uuid.NewString(),
+		Name: params.Name,
+	}
+
+	err := c.organizationRepo.CreateOrganization(ctx, repoParams)
+	if err != nil {
+		return entity.Organization{}, entity.NewErr(err)
+	}
+
+	organization, err := c.organizationRepo.GetOrganizationByID(
+		ctx,
+		repoParams.ID,
+	)
+	if err != nil {
+		return entity.Organization{}, entity.NewErr(err)
+	}
+
+	return organization, nil
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+)
+
+func TestCreateOrganization_Execute(t *testing.T) {
+	val := validator.NewValidate()
+
+	type args struct {
+		params CreateOrganizationParams
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantName string
+		wantErr  error
+	}{
+		{
+			name: "should create an organization",
+			args: args{
+				params: CreateOrganizationParams{
+					Name: "  Acme ",
+				},
+			},
+			wantName: "Acme",
+			wantErr:  nil,
+		},
+		{
+			name: "should not create an organization with a blank name",
+			args: args{
+				params: CreateOrganizationParams{
+					Name: "   ",
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+		{
+			name: "should not create an organization if name is too long",
+			args: args{
+				params: CreateOrganizationParams{
+					Name: strings.Repeat("a", 256),
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			organizationRepo := inmemoryrepo.NewInMemoryOrganizationRepo(
+				nil,
+				nil,
+			)
+
+			c := NewCreateOrganization(val, organizationRepo)
+
+			got, err := c.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"CreateOrganization.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.ID == "" || got.Name != tt.wantName {
+				t.Errorf(
+					"CreateOrganization.Execute() = %v, want name %v",
+					got,
+					tt.wantName,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type CreateProject struct {
+	validator   validator.Validator
+	projectRepo repo.ProjectRepo
+	tx          transactioner.Transactioner
+}
+
+func NewCreateProject(
+	validator validator.Validator,
+	projectRepo repo.ProjectRepo,
+	tx transactioner.Transactioner,
+) *CreateProject {
+	return &CreateProject{
+		validator:   validator,
+		projectRepo: projectRepo,
+		tx:          tx,
+	}
+}
+
+type CreateProjectParams struct {
+	UserRole    entity.Role `json:"user_role,omitempty"   validate:"required,min=1,max=2"`
+	UserID      string      `json:"user_id,omitempty"     validate:"required,uuid"`
+	Name        string      `json:"name,omitempty"        validate:"required,max=100"`
+	Description string      `json:"description,omitempty" validate:"max=2500"`
+}
+
+// Execute creates the project with the user as its project manager and
+// only member.
+func (c *CreateProject) Execute(
+	ctx context.Context,
+	params CreateProjectParams,
+) (entity.Project, error) {
+	if params.UserRole != entity.RoleManager {
+		return entity.Project{}, entity.ErrUserNotAllowedToManageProjects
+	}
+
+	params.Name = strings.TrimSpace(params.Name)
+
+	if err := c.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return entity.Project{}, validationErr
+	}
+
+	repoParams := repo.CreateProjectParams{
+		ID:            uuid.NewString(),
+		Name:          params.Name,
+		Description:   params.Description,
+		ManagerUserID: params.UserID,
+	}
+
+	err := c.tx.Do(ctx, func(ctx context.Context) error {
+		if err := c.projectRepo.CreateProject(ctx, repoParams); err != nil {
+			return entity.NewErr(err)
+		}
+
+		if err := c.projectRepo.AddProjectMember(
+			ctx,
+			repoParams.ID,
+			params.UserID,
+		); err != nil {
+			return entity.NewErr(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return entity.Project{}, entity.NewErr(err)
+	}
+
+	project, err := c.projectRepo.GetProjectByID(ctx, repoParams.ID)
+	if err != nil {
+		return entity.Project{}, entity.NewErr(err)
+	}
+
+	return project, nil
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestCreateProject_Execute(t *testing.T) {
+	val := validator.NewValidate()
+
+	managerID := uuid.NewString()
+
+	type args struct {
+		params CreateProjectParams
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantName string
+		wantErr  error
+	}{
+		{
+			name: "should create a project managed by the user",
+			args: args{
+				params: CreateProjectParams{
+					UserRole:    entity.RoleManager,
+					UserID:      managerID,
+					Name:        "  Maintenance ",
+					Description: "Preventive maintenance of the clients",
+				},
+			},
+			wantName: "Maintenance",
+			wantErr:  nil,
+		},
+		{
+			name: "should not create a project if user role is not manager",
+			args: args{
+				params: CreateProjectParams{
+					UserRole: entity.RoleTechnician,
+					UserID:   uuid.NewString(),
+					Name:     "Maintenance",
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToManageProjects,
+		},
+		{
+			name: "should not create a project with a blank name",
+			args: args{
+				params: CreateProjectParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerID,
+					Name:     "   ",
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+		{
+			name: "should not create a project if name is too long",
+			args: args{
+				params: CreateProjectParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerID,
+					Name:     strings.Repeat("a", 101),
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+
+			c := NewCreateProject(
+				val,
+				projectRepo,
+				transactioner.NewNoopTransactioner(),
+			)
+
+			got, err := c.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"CreateProject.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.ID == "" || got.Name != tt.wantName ||
+				got.ManagerUserID != tt.args.params.UserID {
+				t.Errorf(
+					"CreateProject.Execute() = %v, want name %v managed by %v",
+					got,
+					tt.wantName,
+					tt.args.params.UserID,
+				)
+			}
+
+			if len(projectRepo.Members) != 1 ||
+				projectRepo.Members[0].ProjectID != got.ID ||
+				projectRepo.Members[0].UserID != tt.args.params.UserID {
+				t.Errorf(
+					"CreateProject.Execute() members = %v, want only %v",
+					projectRepo.Members,
+					tt.args.params.UserID,
+				)
+			}
+		})
+	}
+}
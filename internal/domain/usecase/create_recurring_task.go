@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type CreateRecurringTask struct {
+	validator         validator.Validator
+	symCrypto         symcrypt.SymmetricalEncrypter
+	userRepo          repo.UserRepo
+	recurringTaskRepo repo.RecurringTaskRepo
+}
+
+func NewCreateRecurringTask(
+	validator validator.Validator,
+	symCrypto symcrypt.SymmetricalEncrypter,
+	userRepo repo.UserRepo,
+	recurringTaskRepo repo.RecurringTaskRepo,
+) *CreateRecurringTask {
+	return &CreateRecurringTask{
+		validator:         validator,
+		symCrypto:         symCrypto,
+		userRepo:          userRepo,
+		recurringTaskRepo: recurringTaskRepo,
+	}
+}
+
+type CreateRecurringTaskParams struct {
+	UserRole         entity.Role `json:"user_role,omitempty"           validate:"required,min=1,max=2"`
+	CreatedByUserID  string      `json:"created_by_user_id,omitempty"  validate:"required,uuid"`
+	Summary          string      `json:"summary,omitempty"             validate:"required,max=2500"`
+	Schedule         string      `json:"schedule,omitempty"            validate:"required,max=100"`
+	AssignedToUserID string      `json:"assigned_to_user_id,omitempty" validate:"omitempty,uuid"`
+}
+
+// Execute saves the task template and schedules its first occurrence.
+func (c *CreateRecurringTask) Execute(
+	ctx context.Context,
+	params CreateRecurringTaskParams,
+) (entity.RecurringTask, error) {
+	if params.UserRole != entity.RoleManager {
+		return entity.RecurringTask{},
+			entity.ErrUserNotAllowedToManageRecurringTasks
+	}
+
+	params.Schedule = strings.TrimSpace(params.Schedule)
+
+	if err := c.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return entity.RecurringTask{}, validationErr
+	}
+
+	schedule, err := parseRecurrenceSchedule(params.Schedule)
+	if err != nil {
+		return entity.RecurringTask{}, err
+	}
+
+	var assignedToUserID *string
+	if params.AssignedToUserID != "" {
+		assignedToUser, err := c.userRepo.GetUserByID(
+			ctx,
+			params.AssignedToUserID,
+		)
+		if err != nil {
+			return entity.RecurringTask{}, entity.NewErr(err)
+		}
+
+		if assignedToUser.ID == "" {
+			return entity.RecurringTask{}, entity.ErrAssignToUserNotFound
+		}
+
+		if assignedToUser.Role != entity.RoleTechnician {
+			return entity.RecurringTask{}, entity.ErrInvalidRoleForAssignedUser
+		}
+
+		assignedToUserID = &assignedToUser.ID
+	}
+
+	encryptedSummary, err := c.symCrypto.Encrypt(params.Summary)
+	if err != nil {
+		return entity.RecurringTask{}, entity.NewErr(err)
+	}
+
+	repoParams := repo.CreateRecurringTaskParams{
+		ID:               uuid.NewString(),
+		Summary:          encryptedSummary,
+		Schedule:         params.Schedule,
+		AssignedToUserID: assignedToUserID,
+		CreatedByUserID:  params.CreatedByUserID,
+		NextRunAt:        nextRecurrence(schedule, time.Now()),
+	}
+
+	if err := c.recurringTaskRepo.CreateRecurringTask(
+		ctx,
+		repoParams,
+	); err != nil {
+		return entity.RecurringTask{}, entity.NewErr(err)
+	}
+
+	recurringTask, err := c.recurringTaskRepo.GetRecurringTaskByID(
+		ctx,
+		repoParams.ID,
+	)
+	if err != nil {
+		return entity.RecurringTask{}, entity.NewErr(err)
+	}
+
+	recurringTask.Summary = params.Summary
+
+	return recurringTask, nil
+}
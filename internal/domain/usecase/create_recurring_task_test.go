@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestCreateRecurringTask_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	managerUser := entity.User{
+		ID:   uuid.NewString(),
+		Role: entity.RoleManager,
+	}
+
+	technicianUser := entity.User{
+		ID:   uuid.NewString(),
+		Role: entity.RoleTechnician,
+	}
+
+	userRepo := inmemoryrepo.NewInMemoryUserRepo()
+	userRepo.Users = append(userRepo.Users, managerUser, technicianUser)
+
+	type args struct {
+		params CreateRecurringTaskParams
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "should create a recurring task with a cron expression",
+			args: args{
+				params: CreateRecurringTaskParams{
+					UserRole:         entity.RoleManager,
+					CreatedByUserID:  managerUser.ID,
+					Summary:          "Check the generators",
+					Schedule:         "0 8 * * MON",
+					AssignedToUserID: technicianUser.ID,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should create a recurring task with a descriptor",
+			args: args{
+				params: CreateRecurringTaskParams{
+					UserRole:        entity.RoleManager,
+					CreatedByUserID: managerUser.ID,
+					Summary:         "Check the generators",
+					Schedule:        "@weekly",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should not create a recurring task if user role is not manager",
+			args: args{
+				params: CreateRecurringTaskParams{
+					UserRole:        entity.RoleTechnician,
+					CreatedByUserID: technicianUser.ID,
+					Summary:         "Check the generators",
+					Schedule:        "@weekly",
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToManageRecurringTasks,
+		},
+		{
+			name: "should not create a recurring task with an invalid schedule",
+			args: args{
+				params: CreateRecurringTaskParams{
+					UserRole:        entity.RoleManager,
+					CreatedByUserID: managerUser.ID,
+					Summary:         "Check the generators",
+					Schedule:        "every monday",
+				},
+			},
+			wantErr: entity.ErrInvalidRecurrenceSchedule,
+		},
+		{
+			name: "should not create a recurring task without a schedule",
+			args: args{
+				params: CreateRecurringTaskParams{
+					UserRole:        entity.RoleManager,
+					CreatedByUserID: managerUser.ID,
+					Summary:         "Check the generators",
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+		{
+			name: "should not create a recurring task assigned to a manager",
+			args: args{
+				params: CreateRecurringTaskParams{
+					UserRole:         entity.RoleManager,
+					CreatedByUserID:  managerUser.ID,
+					Summary:          "Check the generators",
+					Schedule:         "@weekly",
+					AssignedToUserID: managerUser.ID,
+				},
+			},
+			wantErr: entity.ErrInvalidRoleForAssignedUser,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			recurringTaskRepo := inmemoryrepo.NewInMemoryRecurringTaskRepo()
+			c := NewCreateRecurringTask(
+				val,
+				symCrypto,
+				userRepo,
+				recurringTaskRepo,
+			)
+
+			got, err := c.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"CreateRecurringTask.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if !got.NextRunAt.After(time.Now()) {
+				t.Errorf(
+					"CreateRecurringTask.Execute() next run = %v, want a future time",
+					got.NextRunAt,
+				)
+			}
+
+			if got.Summary != tt.args.params.Summary ||
+				recurringTaskRepo.RecurringTasks[0].Summary == tt.args.params.Summary {
+				t.Errorf(
+					"CreateRecurringTask.Execute() summary was not encrypted at rest",
+				)
+			}
+		})
+	}
+}
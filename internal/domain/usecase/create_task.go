@@ -1,21 +1,28 @@
 package usecase
 
 import (
+	"cmp"
 	"context"
-	"errors"
+	"time"
 
 	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
 	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/google/uuid"
 	"github.com/jinzhu/copier"
 )
 
 type CreateTask struct {
-	validator validator.Validator
-	symCrypto symcrypt.SymmetricalEncrypter
-	taskRepo  repo.TaskRepo
-	userRepo  repo.UserRepo
+	validator     validator.Validator
+	symCrypto     symcrypt.SymmetricalEncrypter
+	taskRepo      repo.TaskRepo
+	userRepo      repo.UserRepo
+	labelRepo     repo.LabelRepo
+	projectRepo   repo.ProjectRepo
+	taskEventRepo repo.TaskEventRepo
+	tx            transactioner.Transactioner
 }
 
 func NewCreateTask(
@@ -23,12 +30,20 @@ func NewCreateTask(
 	symCrypto symcrypt.SymmetricalEncrypter,
 	taskRepo repo.TaskRepo,
 	userRepo repo.UserRepo,
+	labelRepo repo.LabelRepo,
+	projectRepo repo.ProjectRepo,
+	taskEventRepo repo.TaskEventRepo,
+	tx transactioner.Transactioner,
 ) *CreateTask {
 	return &CreateTask{
-		validator: validator,
-		symCrypto: symCrypto,
-		taskRepo:  taskRepo,
-		userRepo:  userRepo,
+		validator:     validator,
+		symCrypto:     symCrypto,
+		taskRepo:      taskRepo,
+		userRepo:      userRepo,
+		labelRepo:     labelRepo,
+		projectRepo:   projectRepo,
+		taskEventRepo: taskEventRepo,
+		tx:            tx,
 	}
 }
 
@@ -37,101 +52,135 @@ type CreateTaskParams struct {
 	Summary          string      `json:"summary,omitempty"             validate:"required,max=2500"`
 	CreatedByUserID  string      `json:"created_by_user_id,omitempty"  validate:"required,uuid"`
 	AssignedToUserID string      `json:"assigned_to_user_id,omitempty" validate:"omitempty,uuid"`
+	// AssigneeIDs are assigned after AssignedToUserID, if both are set.
+	AssigneeIDs []string   `json:"assignee_ids,omitempty" validate:"omitempty,dive,uuid"`
+	LabelIDs    []string   `json:"label_ids,omitempty"    validate:"omitempty,dive,uuid"`
+	DueAt       *time.Time `json:"due_at,omitempty"`
+	// Priority defaults to normal.
+	Priority entity.TaskPriority `json:"priority,omitempty" validate:"omitempty,oneof=low normal high critical"`
+	// FinishPolicy defaults to any.
+	FinishPolicy entity.TaskFinishPolicy `json:"finish_policy,omitempty" validate:"omitempty,oneof=any all"`
+	// ProjectID adds the task to the project, which the creator must be
+	// a member of.
+	ProjectID string `json:"project_id,omitempty" validate:"omitempty,uuid"`
 }
 
+// Execute creates the task and returns its ID.
 func (c *CreateTask) Execute(
 	ctx context.Context,
 	params CreateTaskParams,
-) error {
+) (string, error) {
 	if params.UserRole != entity.RoleManager {
-		return entity.ErrUserNotAllowedToCreateTask
+		return "", entity.ErrUserNotAllowedToCreateTask
 	}
 
 	if err := c.validator.Validate(params); err != nil {
 		validationErr := entity.ErrValidation
 		validationErr.Message = err.Error()
-		return validationErr
+		return "", validationErr
 	}
 
-	var createdByUser, assignedToUser entity.User
-	errCh := make(chan error)
-	jobsCount := 2
-
-	go func() {
-		var err error
-		defer func() {
-			errCh <- err
-		}()
-		createdByUser, err = c.userRepo.GetUserByID(
-			ctx,
-			params.CreatedByUserID,
-		)
-	}()
-
-	assignedUserIsDefined := params.AssignedToUserID != ""
-	go func() {
-		var err error
-		defer func() {
-			errCh <- err
-		}()
-		if !assignedUserIsDefined {
-			return
-		}
-		assignedToUser, err = c.userRepo.GetUserByID(
-			ctx,
-			params.AssignedToUserID,
-		)
-	}()
-
-	var errs error
-	for i := 0; i < jobsCount; i++ {
-		err := <-errCh
-		if err != nil {
-			errs = errors.Join(errs, err)
-		}
-	}
-
-	close(errCh)
-
-	if errs != nil {
-		return entity.NewErr(errs)
+	createdByUser, err := c.userRepo.GetUserByID(ctx, params.CreatedByUserID)
+	if err != nil {
+		return "", entity.NewErr(err)
 	}
 
 	if createdByUser.ID == "" {
-		return entity.ErrCreatedByUserNotFound
+		return "", entity.ErrCreatedByUserNotFound
 	}
 
 	if createdByUser.Role != entity.RoleManager {
-		return entity.ErrUserNotAllowedToCreateTask
+		return "", entity.ErrUserNotAllowedToCreateTask
 	}
 
-	if assignedUserIsDefined {
-		if assignedToUser.ID == "" {
-			return entity.ErrAssignToUserNotFound
+	if params.ProjectID != "" {
+		if _, err := ensureProjectMember(
+			ctx,
+			c.projectRepo,
+			params.ProjectID,
+			createdByUser.ID,
+		); err != nil {
+			return "", err
 		}
+	}
 
-		if assignedToUser.Role != entity.RoleTechnician {
-			return entity.ErrInvalidRoleForAssignedUser
-		}
+	assigneeIDs := params.AssigneeIDs
+	if params.AssignedToUserID != "" {
+		assigneeIDs = append([]string{params.AssignedToUserID}, assigneeIDs...)
+	}
+
+	assigneeIDs, err = ensureAssignees(ctx, c.userRepo, assigneeIDs)
+	if err != nil {
+		return "", err
+	}
+
+	labelIDs, err := ensureLabelsExist(ctx, c.labelRepo, params.LabelIDs)
+	if err != nil {
+		return "", err
 	}
 
 	encryptedSummary, err := c.symCrypto.Encrypt(params.Summary)
 	if err != nil {
-		return entity.NewErr(err)
+		return "", entity.NewErr(err)
 	}
 
 	params.Summary = encryptedSummary
+	params.Priority = cmp.Or(params.Priority, entity.TaskPriorityNormal)
+	params.FinishPolicy = cmp.Or(params.FinishPolicy, entity.TaskFinishPolicyAny)
 
 	repoParams := repo.CreateTaskParams{}
 	if err = copier.CopyWithOption(&repoParams, params, copier.Option{
 		IgnoreEmpty: true,
 	}); err != nil {
-		return entity.NewErr(err)
+		return "", entity.NewErr(err)
 	}
 
-	if err := c.taskRepo.CreateTask(ctx, repoParams); err != nil {
-		return entity.NewErr(err)
+	repoParams.ID = uuid.NewString()
+	repoParams.AssigneeIDs = assigneeIDs
+	if params.ProjectID != "" {
+		repoParams.ProjectID = &params.ProjectID
+	}
+
+	createdTask := entity.Task{
+		ID:              repoParams.ID,
+		Summary:         repoParams.Summary,
+		Status:          entity.TaskStatusOpen,
+		CreatedByUserID: repoParams.CreatedByUserID,
+		DueAt:           repoParams.DueAt,
+		Priority:        repoParams.Priority,
+		FinishPolicy:    repoParams.FinishPolicy,
+		AssigneeIDs:     repoParams.AssigneeIDs,
+		ProjectID:       repoParams.ProjectID,
+	}
+
+	err = c.tx.Do(ctx, func(ctx context.Context) error {
+		if err := c.taskRepo.CreateTask(ctx, repoParams); err != nil {
+			return entity.NewErr(err)
+		}
+
+		if len(labelIDs) > 0 {
+			if err := c.taskRepo.SetTaskLabels(
+				ctx,
+				repoParams.ID,
+				labelIDs,
+			); err != nil {
+				return entity.NewErr(err)
+			}
+		}
+
+		return recordTaskEvent(
+			ctx,
+			c.taskEventRepo,
+			entity.TaskEventCreated,
+			params.CreatedByUserID,
+			entity.Task{},
+			createdTask,
+		)
+	})
+
+	if err != nil {
+		return "", entity.NewErr(err)
 	}
 
-	return nil
+	return repoParams.ID, nil
 }
@@ -2,12 +2,14 @@ package usecase
 
 import (
 	"context"
+	"slices"
 	"strings"
 	"testing"
 
 	"github.com/danielmesquitta/tasks-api/internal/config"
 	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
 	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
 	"github.com/danielmesquitta/tasks-api/test/testutil"
@@ -29,16 +31,50 @@ func TestCreateTask_Execute(t *testing.T) {
 		Role: entity.RoleTechnician,
 	}
 
+	secondTechnicianUser := entity.User{
+		ID:   uuid.NewString(),
+		Role: entity.RoleTechnician,
+	}
+
 	newUserRepo := func() *inmemoryrepo.InMemoryUserRepo {
 		userRepo := inmemoryrepo.NewInMemoryUserRepo()
 		userRepo.Users = append(
 			userRepo.Users,
 			managerUser,
 			technicianUser,
+			secondTechnicianUser,
 		)
 		return userRepo
 	}
 
+	label := entity.Label{
+		ID:   uuid.NewString(),
+		Name: "urgent",
+	}
+	labelRepo := inmemoryrepo.NewInMemoryLabelRepo()
+	labelRepo.Labels = append(labelRepo.Labels, label)
+
+	project := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Maintenance",
+		ManagerUserID: managerUser.ID,
+	}
+	otherProject := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Installation",
+		ManagerUserID: uuid.NewString(),
+	}
+	projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+	projectRepo.Projects = append(projectRepo.Projects, project, otherProject)
+	projectRepo.Members = append(
+		projectRepo.Members,
+		entity.ProjectMember{ProjectID: project.ID, UserID: managerUser.ID},
+		entity.ProjectMember{
+			ProjectID: otherProject.ID,
+			UserID:    otherProject.ManagerUserID,
+		},
+	)
+
 	type fields struct {
 		validator validator.Validator
 		symCrypto symcrypt.SymmetricalEncrypter
@@ -49,10 +85,11 @@ func TestCreateTask_Execute(t *testing.T) {
 		params CreateTaskParams
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		wantErr error
+		name            string
+		fields          fields
+		args            args
+		wantAssigneeIDs []string
+		wantErr         error
 	}{
 		{
 			name: "should create a task",
@@ -247,18 +284,173 @@ func TestCreateTask_Execute(t *testing.T) {
 			},
 			wantErr: entity.ErrValidation,
 		},
+		{
+			name: "should create a task with labels",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  inmemoryrepo.NewInMemoryTaskRepo(),
+				userRepo:  newUserRepo(),
+			},
+			args: args{
+				params: CreateTaskParams{
+					UserRole:        entity.RoleManager,
+					Summary:         "Loren ipsum dolor sit amet",
+					CreatedByUserID: managerUser.ID,
+					LabelIDs:        []string{label.ID, label.ID},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should not create a task with non-existent label",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  inmemoryrepo.NewInMemoryTaskRepo(),
+				userRepo:  newUserRepo(),
+			},
+			args: args{
+				params: CreateTaskParams{
+					UserRole:        entity.RoleManager,
+					Summary:         "Loren ipsum dolor sit amet",
+					CreatedByUserID: managerUser.ID,
+					LabelIDs:        []string{uuid.NewString()},
+				},
+			},
+			wantErr: entity.ErrLabelNotFound,
+		},
+		{
+			name: "should create a task with several assignees",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  inmemoryrepo.NewInMemoryTaskRepo(),
+				userRepo:  newUserRepo(),
+			},
+			args: args{
+				params: CreateTaskParams{
+					UserRole:         entity.RoleManager,
+					Summary:          "Loren ipsum dolor sit amet",
+					CreatedByUserID:  managerUser.ID,
+					AssignedToUserID: technicianUser.ID,
+					AssigneeIDs: []string{
+						secondTechnicianUser.ID,
+						technicianUser.ID,
+					},
+					FinishPolicy: entity.TaskFinishPolicyAll,
+				},
+			},
+			wantAssigneeIDs: []string{technicianUser.ID, secondTechnicianUser.ID},
+			wantErr:         nil,
+		},
+		{
+			name: "should not create a task if one of the assignees is not a technician",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  inmemoryrepo.NewInMemoryTaskRepo(),
+				userRepo:  newUserRepo(),
+			},
+			args: args{
+				params: CreateTaskParams{
+					UserRole:        entity.RoleManager,
+					Summary:         "Loren ipsum dolor sit amet",
+					CreatedByUserID: managerUser.ID,
+					AssigneeIDs:     []string{technicianUser.ID, managerUser.ID},
+				},
+			},
+			wantErr: entity.ErrInvalidRoleForAssignedUser,
+		},
+		{
+			name: "should not create a task with an invalid finish policy",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  inmemoryrepo.NewInMemoryTaskRepo(),
+				userRepo:  newUserRepo(),
+			},
+			args: args{
+				params: CreateTaskParams{
+					UserRole:        entity.RoleManager,
+					Summary:         "Loren ipsum dolor sit amet",
+					CreatedByUserID: managerUser.ID,
+					FinishPolicy:    "most",
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+		{
+			name: "should create a task in a project the user is a member of",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  inmemoryrepo.NewInMemoryTaskRepo(),
+				userRepo:  newUserRepo(),
+			},
+			args: args{
+				params: CreateTaskParams{
+					UserRole:        entity.RoleManager,
+					Summary:         "Loren ipsum dolor sit amet",
+					CreatedByUserID: managerUser.ID,
+					ProjectID:       project.ID,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should not create a task in a project the user is not a member of",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  inmemoryrepo.NewInMemoryTaskRepo(),
+				userRepo:  newUserRepo(),
+			},
+			args: args{
+				params: CreateTaskParams{
+					UserRole:        entity.RoleManager,
+					Summary:         "Loren ipsum dolor sit amet",
+					CreatedByUserID: managerUser.ID,
+					ProjectID:       otherProject.ID,
+				},
+			},
+			wantErr: entity.ErrUserNotProjectMember,
+		},
+		{
+			name: "should not create a task in a project that does not exist",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  inmemoryrepo.NewInMemoryTaskRepo(),
+				userRepo:  newUserRepo(),
+			},
+			args: args{
+				params: CreateTaskParams{
+					UserRole:        entity.RoleManager,
+					Summary:         "Loren ipsum dolor sit amet",
+					CreatedByUserID: managerUser.ID,
+					ProjectID:       uuid.NewString(),
+				},
+			},
+			wantErr: entity.ErrProjectNotFound,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
+			taskEventRepo := inmemoryrepo.NewInMemoryTaskEventRepo()
 			c := NewCreateTask(
 				tt.fields.validator,
 				tt.fields.symCrypto,
 				tt.fields.taskRepo,
 				tt.fields.userRepo,
+				labelRepo,
+				projectRepo,
+				taskEventRepo,
+				transactioner.NewNoopTransactioner(),
 			)
-			err := c.Execute(context.Background(), tt.args.params)
+			_, err := c.Execute(context.Background(), tt.args.params)
 			if !testutil.IsSameErr(err, tt.wantErr) {
 				t.Errorf(
 					"CreateTask.Execute() error = %v, wantErr %v",
@@ -273,6 +465,16 @@ func TestCreateTask_Execute(t *testing.T) {
 			}
 
 			lastCreatedTask := tt.fields.taskRepo.Tasks[len(tt.fields.taskRepo.Tasks)-1]
+
+			if len(taskEventRepo.Events) != 1 ||
+				taskEventRepo.Events[0].TaskID != lastCreatedTask.ID ||
+				taskEventRepo.Events[0].Type != entity.TaskEventCreated {
+				t.Errorf(
+					"CreateTask.Execute() events = %v, want one created event",
+					taskEventRepo.Events,
+				)
+			}
+
 			if !testutil.CompareAsPtr(
 				lastCreatedTask.AssignedToUserID,
 				tt.args.params.AssignedToUserID,
@@ -291,6 +493,35 @@ func TestCreateTask_Execute(t *testing.T) {
 					tt.args.params.CreatedByUserID,
 				)
 			}
+
+			if tt.wantAssigneeIDs != nil &&
+				!slices.Equal(lastCreatedTask.AssigneeIDs, tt.wantAssigneeIDs) {
+				t.Errorf(
+					"CreateTask.Execute() assignee ids = %v, want %v",
+					lastCreatedTask.AssigneeIDs,
+					tt.wantAssigneeIDs,
+				)
+			}
+
+			if !testutil.CompareAsPtr(
+				lastCreatedTask.ProjectID,
+				tt.args.params.ProjectID,
+			) {
+				t.Errorf(
+					"CreateTask.Execute() project id = %v, want %s",
+					lastCreatedTask.ProjectID,
+					tt.args.params.ProjectID,
+				)
+			}
+
+			labelIDs := tt.fields.taskRepo.LabelIDs[lastCreatedTask.ID]
+			if len(tt.args.params.LabelIDs) > 0 && len(labelIDs) != 1 {
+				t.Errorf(
+					"CreateTask.Execute() label ids = %v, want [%s]",
+					labelIDs,
+					label.ID,
+				)
+			}
 		})
 	}
 }
@@ -6,26 +6,30 @@ import (
 
 	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/hasher"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/tenant"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
 	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
 	"github.com/jinzhu/copier"
 )
 
 type CreateUser struct {
-	val      validator.Validator
-	hasher   hasher.Hasher
-	userRepo repo.UserRepo
+	val              validator.Validator
+	hasher           hasher.Hasher
+	organizationRepo repo.OrganizationRepo
+	userRepo         repo.UserRepo
 }
 
 func NewCreateUser(
 	val validator.Validator,
 	hasher hasher.Hasher,
+	organizationRepo repo.OrganizationRepo,
 	userRepo repo.UserRepo,
 ) *CreateUser {
 	return &CreateUser{
-		val:      val,
-		hasher:   hasher,
-		userRepo: userRepo,
+		val:              val,
+		hasher:           hasher,
+		organizationRepo: organizationRepo,
+		userRepo:         userRepo,
 	}
 }
 
@@ -34,6 +38,9 @@ type CreateUserParams struct {
 	Name     string      `json:"name,omitempty"     validate:"required,min=1,max=255"`
 	Password string      `json:"password,omitempty" validate:"required,min=8,max=64"`
 	Role     entity.Role `json:"role,omitempty"     validate:"required,min=1,max=2"`
+	// OrganizationID is the organization the user belongs to, and can
+	// act on.
+	OrganizationID string `json:"organization_id,omitempty" validate:"required"`
 }
 
 func (c *CreateUser) Execute(
@@ -48,14 +55,25 @@ func (c *CreateUser) Execute(
 
 	params.Email = strings.Trim(strings.ToLower(params.Email), " ")
 
-	userWithSameEmail, err := c.userRepo.GetUserByEmail(
+	organization, err := c.organizationRepo.GetOrganizationByID(
 		ctx,
-		params.Email,
+		params.OrganizationID,
 	)
 	if err != nil {
 		return entity.NewErr(err)
 	}
-	if userWithSameEmail.ID != "" {
+	if organization.ID == "" {
+		return entity.ErrOrganizationNotFound
+	}
+
+	// Emails are unique across organizations, since signing in finds the
+	// organization by the email.
+	organizationIDWithSameEmail, err := c.organizationRepo.
+		GetOrganizationIDByUserEmail(ctx, params.Email)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+	if organizationIDWithSameEmail != "" {
 		return entity.ErrEmailAlreadyExists
 	}
 
@@ -71,6 +89,8 @@ func (c *CreateUser) Execute(
 
 	repoParams.Password = hashedPassword
 
+	ctx = tenant.WithOrganizationID(ctx, params.OrganizationID)
+
 	if err = c.userRepo.CreateUser(ctx, repoParams); err != nil {
 		return entity.NewErr(err)
 	}
@@ -16,12 +16,18 @@ func TestCreateUser_Execute(t *testing.T) {
 	val := validator.NewValidate()
 	bcr := hasher.NewBcrypt()
 
+	organization := entity.Organization{
+		ID:   uuid.NewString(),
+		Name: "Acme",
+	}
+
 	newUserRepo := func() *inmemoryrepo.InMemoryUserRepo {
 		userRepo := inmemoryrepo.NewInMemoryUserRepo()
 
 		existingUser := entity.User{
-			ID:    uuid.NewString(),
-			Email: "existing-user@email.com",
+			ID:             uuid.NewString(),
+			OrganizationID: uuid.NewString(),
+			Email:          "existing-user@email.com",
 		}
 
 		userRepo.Users = append(userRepo.Users, existingUser)
@@ -52,10 +58,11 @@ func TestCreateUser_Execute(t *testing.T) {
 			},
 			args: args{
 				params: CreateUserParams{
-					Name:     "John Doe",
-					Email:    "johndoe@email.com",
-					Password: "P@ssw0rd",
-					Role:     entity.RoleTechnician,
+					Name:           "John Doe",
+					Email:          "johndoe@email.com",
+					Password:       "P@ssw0rd",
+					Role:           entity.RoleTechnician,
+					OrganizationID: organization.ID,
 				},
 			},
 			wantErr: nil,
@@ -69,10 +76,11 @@ func TestCreateUser_Execute(t *testing.T) {
 			},
 			args: args{
 				params: CreateUserParams{
-					Name:     "John Doe",
-					Email:    "johndoe@email.com",
-					Password: "P@ssw0rd",
-					Role:     entity.RoleManager,
+					Name:           "John Doe",
+					Email:          "johndoe@email.com",
+					Password:       "P@ssw0rd",
+					Role:           entity.RoleManager,
+					OrganizationID: organization.ID,
 				},
 			},
 			wantErr: nil,
@@ -86,10 +94,11 @@ func TestCreateUser_Execute(t *testing.T) {
 			},
 			args: args{
 				params: CreateUserParams{
-					Name:     "John Doe",
-					Email:    "invalid-email",
-					Password: "P@ssw0rd",
-					Role:     entity.RoleManager,
+					Name:           "John Doe",
+					Email:          "invalid-email",
+					Password:       "P@ssw0rd",
+					Role:           entity.RoleManager,
+					OrganizationID: organization.ID,
 				},
 			},
 			wantErr: entity.ErrValidation,
@@ -103,9 +112,10 @@ func TestCreateUser_Execute(t *testing.T) {
 			},
 			args: args{
 				params: CreateUserParams{
-					Email:    "johndoe@email.com",
-					Password: "P@ssw0rd",
-					Role:     entity.RoleManager,
+					Email:          "johndoe@email.com",
+					Password:       "P@ssw0rd",
+					Role:           entity.RoleManager,
+					OrganizationID: organization.ID,
 				},
 			},
 			wantErr: entity.ErrValidation,
@@ -119,10 +129,11 @@ func TestCreateUser_Execute(t *testing.T) {
 			},
 			args: args{
 				params: CreateUserParams{
-					Name:     "John Doe",
-					Email:    "johndoe@email.com",
-					Password: "123",
-					Role:     entity.RoleManager,
+					Name:           "John Doe",
+					Email:          "johndoe@email.com",
+					Password:       "123",
+					Role:           entity.RoleManager,
+					OrganizationID: organization.ID,
 				},
 			},
 			wantErr: entity.ErrValidation,
@@ -136,15 +147,16 @@ func TestCreateUser_Execute(t *testing.T) {
 			},
 			args: args{
 				params: CreateUserParams{
-					Name:     "John Doe",
-					Email:    "johndoe@email.com",
-					Password: "P@ssw0rd",
+					Name:           "John Doe",
+					Email:          "johndoe@email.com",
+					Password:       "P@ssw0rd",
+					OrganizationID: organization.ID,
 				},
 			},
 			wantErr: entity.ErrValidation,
 		},
 		{
-			name: "should not create a user with repeated email",
+			name: "should not create a user without an organization",
 			fields: fields{
 				val:      val,
 				bcr:      bcr,
@@ -153,11 +165,47 @@ func TestCreateUser_Execute(t *testing.T) {
 			args: args{
 				params: CreateUserParams{
 					Name:     "John Doe",
-					Email:    "existing-user@email.com",
+					Email:    "johndoe@email.com",
 					Password: "P@ssw0rd",
 					Role:     entity.RoleManager,
 				},
 			},
+			wantErr: entity.ErrValidation,
+		},
+		{
+			name: "should not create a user in a non-existing organization",
+			fields: fields{
+				val:      val,
+				bcr:      bcr,
+				userRepo: newUserRepo(),
+			},
+			args: args{
+				params: CreateUserParams{
+					Name:           "John Doe",
+					Email:          "johndoe@email.com",
+					Password:       "P@ssw0rd",
+					Role:           entity.RoleManager,
+					OrganizationID: uuid.NewString(),
+				},
+			},
+			wantErr: entity.ErrOrganizationNotFound,
+		},
+		{
+			name: "should not create a user with an email repeated in another organization",
+			fields: fields{
+				val:      val,
+				bcr:      bcr,
+				userRepo: newUserRepo(),
+			},
+			args: args{
+				params: CreateUserParams{
+					Name:           "John Doe",
+					Email:          "existing-user@email.com",
+					Password:       "P@ssw0rd",
+					Role:           entity.RoleManager,
+					OrganizationID: organization.ID,
+				},
+			},
 			wantErr: entity.ErrEmailAlreadyExists,
 		},
 	}
@@ -165,9 +213,19 @@ func TestCreateUser_Execute(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
+			organizationRepo := inmemoryrepo.NewInMemoryOrganizationRepo(
+				tt.fields.userRepo,
+				nil,
+			)
+			organizationRepo.Organizations = append(
+				organizationRepo.Organizations,
+				organization,
+			)
+
 			c := NewCreateUser(
 				tt.fields.val,
 				tt.fields.bcr,
+				organizationRepo,
 				tt.fields.userRepo,
 			)
 			err := c.Execute(context.Background(), tt.args.params)
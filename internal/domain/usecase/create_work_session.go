@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type CreateWorkSession struct {
+	validator       validator.Validator
+	symCrypto       symcrypt.SymmetricalEncrypter
+	taskRepo        repo.TaskRepo
+	workSessionRepo repo.WorkSessionRepo
+}
+
+func NewCreateWorkSession(
+	validator validator.Validator,
+	symCrypto symcrypt.SymmetricalEncrypter,
+	taskRepo repo.TaskRepo,
+	workSessionRepo repo.WorkSessionRepo,
+) *CreateWorkSession {
+	return &CreateWorkSession{
+		validator:       validator,
+		symCrypto:       symCrypto,
+		taskRepo:        taskRepo,
+		workSessionRepo: workSessionRepo,
+	}
+}
+
+type CreateWorkSessionParams struct {
+	TaskID    string    `json:"task_id,omitempty"    validate:"required,uuid"`
+	UserID    string    `json:"user_id,omitempty"    validate:"required,uuid"`
+	StartedAt time.Time `json:"started_at,omitempty" validate:"required"`
+	EndedAt   time.Time `json:"ended_at,omitempty"   validate:"required"`
+	Note      *string   `json:"note,omitempty"       validate:"omitempty,max=2000"`
+}
+
+// Execute logs time the assignee worked on the task without a timer.
+func (c *CreateWorkSession) Execute(
+	ctx context.Context,
+	params CreateWorkSessionParams,
+) (entity.WorkSession, error) {
+	if err := c.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return entity.WorkSession{}, validationErr
+	}
+
+	startedAt := params.StartedAt.UTC().Truncate(time.Second)
+	endedAt := params.EndedAt.UTC().Truncate(time.Second)
+	if err := validateWorkSessionPeriod(
+		startedAt,
+		&endedAt,
+		time.Now(),
+	); err != nil {
+		return entity.WorkSession{}, err
+	}
+
+	task, err := getTrackableTask(ctx, c.taskRepo, params.TaskID, params.UserID)
+	if err != nil {
+		return entity.WorkSession{}, err
+	}
+
+	encryptedNote, err := encryptWorkSessionNote(c.symCrypto, params.Note)
+	if err != nil {
+		return entity.WorkSession{}, err
+	}
+
+	repoParams := repo.CreateWorkSessionParams{
+		ID:        uuid.NewString(),
+		TaskID:    task.ID,
+		UserID:    params.UserID,
+		StartedAt: startedAt,
+		EndedAt:   &endedAt,
+		Note:      encryptedNote,
+		Manual:    true,
+	}
+
+	if err := c.workSessionRepo.CreateWorkSession(
+		ctx,
+		repoParams,
+	); err != nil {
+		return entity.WorkSession{}, entity.NewErr(err)
+	}
+
+	session, err := c.workSessionRepo.GetWorkSessionByID(ctx, repoParams.ID)
+	if err != nil {
+		return entity.WorkSession{}, entity.NewErr(err)
+	}
+
+	return decryptWorkSession(c.symCrypto, session, time.Now())
+}
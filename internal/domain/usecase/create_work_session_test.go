@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestCreateWorkSession_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	managerID := uuid.NewString()
+	technicianID := uuid.NewString()
+
+	task := entity.Task{
+		ID:               uuid.NewString(),
+		Summary:          "Loren ipsum dolor sit amet",
+		Status:           entity.TaskStatusOpen,
+		AssignedToUserID: &technicianID,
+		CreatedByUserID:  managerID,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+	doneTask := entity.Task{
+		ID:               uuid.NewString(),
+		Summary:          "Loren ipsum dolor sit amet",
+		Status:           entity.TaskStatusDone,
+		AssignedToUserID: &technicianID,
+		CreatedByUserID:  managerID,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+
+	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+	taskRepo.Tasks = append(taskRepo.Tasks, task, doneTask)
+
+	startedAt := time.Now().Add(-3 * time.Hour)
+	endedAt := startedAt.Add(90 * time.Minute)
+	note := "Replaced the compressor"
+
+	type args struct {
+		params CreateWorkSessionParams
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantDuration int64
+		wantErr      error
+	}{
+		{
+			name: "should log time if user is the assignee",
+			args: args{
+				params: CreateWorkSessionParams{
+					TaskID:    task.ID,
+					UserID:    technicianID,
+					StartedAt: startedAt,
+					EndedAt:   endedAt,
+					Note:      &note,
+				},
+			},
+			wantDuration: 90 * 60,
+			wantErr:      nil,
+		},
+		{
+			name: "should not log time if user is not the assignee",
+			args: args{
+				params: CreateWorkSessionParams{
+					TaskID:    task.ID,
+					UserID:    managerID,
+					StartedAt: startedAt,
+					EndedAt:   endedAt,
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToTrackTime,
+		},
+		{
+			name: "should not log time if task is finished",
+			args: args{
+				params: CreateWorkSessionParams{
+					TaskID:    doneTask.ID,
+					UserID:    technicianID,
+					StartedAt: startedAt,
+					EndedAt:   endedAt,
+				},
+			},
+			wantErr: entity.ErrWorkSessionsLocked,
+		},
+		{
+			name: "should not log time that ends before it starts",
+			args: args{
+				params: CreateWorkSessionParams{
+					TaskID:    task.ID,
+					UserID:    technicianID,
+					StartedAt: endedAt,
+					EndedAt:   startedAt,
+				},
+			},
+			wantErr: entity.ErrInvalidWorkSessionPeriod,
+		},
+		{
+			name: "should not log time in the future",
+			args: args{
+				params: CreateWorkSessionParams{
+					TaskID:    task.ID,
+					UserID:    technicianID,
+					StartedAt: startedAt,
+					EndedAt:   time.Now().Add(time.Hour),
+				},
+			},
+			wantErr: entity.ErrInvalidWorkSessionPeriod,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			workSessionRepo := inmemoryrepo.NewInMemoryWorkSessionRepo(taskRepo)
+
+			c := NewCreateWorkSession(val, symCrypto, taskRepo, workSessionRepo)
+
+			got, err := c.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"CreateWorkSession.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				if len(workSessionRepo.Sessions) != 0 {
+					t.Errorf("CreateWorkSession.Execute() created a work session")
+				}
+				return
+			}
+
+			if !got.Manual || got.IsRunning() {
+				t.Errorf(
+					"CreateWorkSession.Execute() = %+v, want a manual entry",
+					got,
+				)
+			}
+
+			if got.DurationSeconds != tt.wantDuration {
+				t.Errorf(
+					"CreateWorkSession.Execute() duration = %v, want %v",
+					got.DurationSeconds,
+					tt.wantDuration,
+				)
+			}
+
+			if !testutil.CompareAsPtr(got.Note, tt.args.params.Note) {
+				t.Errorf(
+					"CreateWorkSession.Execute() note = %v, want %v",
+					got.Note,
+					tt.args.params.Note,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type DeleteChecklistItem struct {
+	validator     validator.Validator
+	taskRepo      repo.TaskRepo
+	projectRepo   repo.ProjectRepo
+	checklistRepo repo.ChecklistRepo
+}
+
+func NewDeleteChecklistItem(
+	validator validator.Validator,
+	taskRepo repo.TaskRepo,
+	projectRepo repo.ProjectRepo,
+	checklistRepo repo.ChecklistRepo,
+) *DeleteChecklistItem {
+	return &DeleteChecklistItem{
+		validator:     validator,
+		taskRepo:      taskRepo,
+		projectRepo:   projectRepo,
+		checklistRepo: checklistRepo,
+	}
+}
+
+type DeleteChecklistItemParams struct {
+	TaskID   string      `json:"task_id,omitempty" validate:"required,uuid"`
+	ItemID   string      `json:"item_id,omitempty" validate:"required,uuid"`
+	UserID   string      `json:"user_id,omitempty" validate:"required,uuid"`
+	UserRole entity.Role `json:"role,omitempty"    validate:"required,min=1,max=2"`
+}
+
+func (d *DeleteChecklistItem) Execute(
+	ctx context.Context,
+	params DeleteChecklistItemParams,
+) error {
+	if err := d.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return validationErr
+	}
+
+	task, err := d.taskRepo.GetTaskByID(ctx, params.TaskID)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if task.ID == "" {
+		return entity.ErrTaskNotFound
+	}
+
+	if err := ensureTaskVisible(
+		ctx,
+		d.projectRepo,
+		task,
+		params.UserID,
+		params.UserRole,
+		entity.ErrUserNotAllowedToEditChecklist,
+	); err != nil {
+		return err
+	}
+
+	item, err := d.checklistRepo.GetChecklistItemByID(ctx, params.ItemID)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if item.ID == "" || item.TaskID != task.ID {
+		return entity.ErrChecklistItemNotFound
+	}
+
+	if err := d.checklistRepo.DeleteChecklistItem(ctx, item.ID); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type DeleteLabel struct {
+	validator validator.Validator
+	labelRepo repo.LabelRepo
+}
+
+func NewDeleteLabel(
+	validator validator.Validator,
+	labelRepo repo.LabelRepo,
+) *DeleteLabel {
+	return &DeleteLabel{
+		validator: validator,
+		labelRepo: labelRepo,
+	}
+}
+
+type DeleteLabelParams struct {
+	ID       string      `json:"id,omitempty"        validate:"required,uuid"`
+	UserRole entity.Role `json:"user_role,omitempty" validate:"required,min=1,max=2"`
+}
+
+// Execute deletes the label, removing it from every task.
+func (d *DeleteLabel) Execute(
+	ctx context.Context,
+	params DeleteLabelParams,
+) error {
+	if params.UserRole != entity.RoleManager {
+		return entity.ErrUserNotAllowedToManageLabels
+	}
+
+	if err := d.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return validationErr
+	}
+
+	label, err := d.labelRepo.GetLabelByID(ctx, params.ID)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if label.ID == "" {
+		return entity.ErrLabelNotFound
+	}
+
+	if err := d.labelRepo.DeleteLabel(ctx, label.ID); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
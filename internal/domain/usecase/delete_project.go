@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type DeleteProject struct {
+	validator   validator.Validator
+	projectRepo repo.ProjectRepo
+}
+
+func NewDeleteProject(
+	validator validator.Validator,
+	projectRepo repo.ProjectRepo,
+) *DeleteProject {
+	return &DeleteProject{
+		validator:   validator,
+		projectRepo: projectRepo,
+	}
+}
+
+type DeleteProjectParams struct {
+	ID       string      `json:"id,omitempty"        validate:"required,uuid"`
+	UserRole entity.Role `json:"user_role,omitempty" validate:"required,min=1,max=2"`
+	UserID   string      `json:"user_id,omitempty"   validate:"required,uuid"`
+}
+
+// Execute deletes the project and its members. Projects with tasks,
+// deleted ones included, can not be deleted.
+func (d *DeleteProject) Execute(
+	ctx context.Context,
+	params DeleteProjectParams,
+) error {
+	if params.UserRole != entity.RoleManager {
+		return entity.ErrUserNotAllowedToManageProjects
+	}
+
+	if err := d.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return validationErr
+	}
+
+	project, err := ensureProjectManager(
+		ctx,
+		d.projectRepo,
+		params.ID,
+		params.UserID,
+	)
+	if err != nil {
+		return err
+	}
+
+	hasTasks, err := d.projectRepo.ProjectHasTasks(ctx, project.ID)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if hasTasks {
+		return entity.ErrProjectHasTasks
+	}
+
+	if err := d.projectRepo.DeleteProject(ctx, project.ID); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type DeleteRecurringTask struct {
+	validator         validator.Validator
+	recurringTaskRepo repo.RecurringTaskRepo
+}
+
+func NewDeleteRecurringTask(
+	validator validator.Validator,
+	recurringTaskRepo repo.RecurringTaskRepo,
+) *DeleteRecurringTask {
+	return &DeleteRecurringTask{
+		validator:         validator,
+		recurringTaskRepo: recurringTaskRepo,
+	}
+}
+
+type DeleteRecurringTaskParams struct {
+	ID       string      `json:"id,omitempty"        validate:"required,uuid"`
+	UserRole entity.Role `json:"user_role,omitempty" validate:"required,min=1,max=2"`
+}
+
+// Execute stops the recurrence, the tasks it already created are kept.
+func (d *DeleteRecurringTask) Execute(
+	ctx context.Context,
+	params DeleteRecurringTaskParams,
+) error {
+	if params.UserRole != entity.RoleManager {
+		return entity.ErrUserNotAllowedToManageRecurringTasks
+	}
+
+	if err := d.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return validationErr
+	}
+
+	recurringTask, err := d.recurringTaskRepo.GetRecurringTaskByID(
+		ctx,
+		params.ID,
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if recurringTask.ID == "" {
+		return entity.ErrRecurringTaskNotFound
+	}
+
+	if err := d.recurringTaskRepo.DeleteRecurringTask(
+		ctx,
+		recurringTask.ID,
+	); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
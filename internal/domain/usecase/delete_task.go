@@ -2,29 +2,41 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
 	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
 )
 
 type DeleteTask struct {
-	validator validator.Validator
-	taskRepo  repo.TaskRepo
+	validator     validator.Validator
+	taskRepo      repo.TaskRepo
+	projectRepo   repo.ProjectRepo
+	taskEventRepo repo.TaskEventRepo
+	tx            transactioner.Transactioner
 }
 
 func NewDeleteTask(
 	validator validator.Validator,
 	taskRepo repo.TaskRepo,
+	projectRepo repo.ProjectRepo,
+	taskEventRepo repo.TaskEventRepo,
+	tx transactioner.Transactioner,
 ) *DeleteTask {
 	return &DeleteTask{
-		validator: validator,
-		taskRepo:  taskRepo,
+		validator:     validator,
+		taskRepo:      taskRepo,
+		projectRepo:   projectRepo,
+		taskEventRepo: taskEventRepo,
+		tx:            tx,
 	}
 }
 
 type DeleteTaskParams struct {
 	TaskID   string      `json:"task_id,omitempty" validate:"required,uuid"`
+	UserID   string      `json:"user_id,omitempty" validate:"required,uuid"`
 	UserRole entity.Role `json:"role,omitempty"    validate:"required,min=1,max=2"`
 }
 
@@ -51,7 +63,35 @@ func (d *DeleteTask) Execute(
 		return entity.ErrTaskNotFound
 	}
 
-	if err := d.taskRepo.DeleteTask(ctx, task.ID); err != nil {
+	if err := ensureTaskProjectMember(
+		ctx,
+		d.projectRepo,
+		task,
+		params.UserID,
+	); err != nil {
+		return err
+	}
+
+	deletedAt := time.Now()
+	deletedTask := task
+	deletedTask.DeletedAt = &deletedAt
+
+	err = d.tx.Do(ctx, func(ctx context.Context) error {
+		if err := d.taskRepo.DeleteTask(ctx, task.ID); err != nil {
+			return entity.NewErr(err)
+		}
+
+		return recordTaskEvent(
+			ctx,
+			d.taskEventRepo,
+			entity.TaskEventDeleted,
+			params.UserID,
+			task,
+			deletedTask,
+		)
+	})
+
+	if err != nil {
 		return entity.NewErr(err)
 	}
 
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
 	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
 	"github.com/danielmesquitta/tasks-api/test/testutil"
@@ -34,6 +35,25 @@ func TestDeleteTask_Execute(t *testing.T) {
 		return taskRepo
 	}
 
+	memberUserID := uuid.NewString()
+	project := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Maintenance",
+		ManagerUserID: memberUserID,
+	}
+	projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+	projectRepo.Projects = append(projectRepo.Projects, project)
+	projectRepo.Members = append(
+		projectRepo.Members,
+		entity.ProjectMember{ProjectID: project.ID, UserID: memberUserID},
+	)
+
+	newProjectTaskRepo := func() *inmemoryrepo.InMemoryTaskRepo {
+		taskRepo := newTaskRepo()
+		taskRepo.Tasks[0].ProjectID = &project.ID
+		return taskRepo
+	}
+
 	type fields struct {
 		validator validator.Validator
 		taskRepo  *inmemoryrepo.InMemoryTaskRepo
@@ -61,6 +81,7 @@ func TestDeleteTask_Execute(t *testing.T) {
 				args: args{
 					params: DeleteTaskParams{
 						TaskID:   task.ID,
+						UserID:   uuid.NewString(),
 						UserRole: entity.RoleManager,
 					},
 				},
@@ -80,6 +101,7 @@ func TestDeleteTask_Execute(t *testing.T) {
 				args: args{
 					params: DeleteTaskParams{
 						TaskID:   task.ID,
+						UserID:   uuid.NewString(),
 						UserRole: entity.RoleTechnician,
 					},
 				},
@@ -99,6 +121,7 @@ func TestDeleteTask_Execute(t *testing.T) {
 				args: args{
 					params: DeleteTaskParams{
 						TaskID:   task.ID,
+						UserID:   uuid.NewString(),
 						UserRole: 0,
 					},
 				},
@@ -114,6 +137,7 @@ func TestDeleteTask_Execute(t *testing.T) {
 			args: args{
 				params: DeleteTaskParams{
 					TaskID:   "invalid-task-id",
+					UserID:   uuid.NewString(),
 					UserRole: entity.RoleManager,
 				},
 			},
@@ -128,11 +152,52 @@ func TestDeleteTask_Execute(t *testing.T) {
 			args: args{
 				params: DeleteTaskParams{
 					TaskID:   uuid.NewString(),
+					UserID:   uuid.NewString(),
 					UserRole: entity.RoleManager,
 				},
 			},
 			wantErr: entity.ErrTaskNotFound,
 		},
+		func() test {
+			taskRepo := newProjectTaskRepo()
+			task := taskRepo.Tasks[0]
+
+			return test{
+				name: "should delete a project task if user is a project member",
+				fields: fields{
+					validator: validator.NewValidate(),
+					taskRepo:  taskRepo,
+				},
+				args: args{
+					params: DeleteTaskParams{
+						TaskID:   task.ID,
+						UserID:   memberUserID,
+						UserRole: entity.RoleManager,
+					},
+				},
+				wantErr: nil,
+			}
+		}(),
+		func() test {
+			taskRepo := newProjectTaskRepo()
+			task := taskRepo.Tasks[0]
+
+			return test{
+				name: "should not delete a project task if user is not a project member",
+				fields: fields{
+					validator: validator.NewValidate(),
+					taskRepo:  taskRepo,
+				},
+				args: args{
+					params: DeleteTaskParams{
+						TaskID:   task.ID,
+						UserID:   uuid.NewString(),
+						UserRole: entity.RoleManager,
+					},
+				},
+				wantErr: entity.ErrUserNotProjectMember,
+			}
+		}(),
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -141,6 +206,9 @@ func TestDeleteTask_Execute(t *testing.T) {
 			d := NewDeleteTask(
 				tt.fields.validator,
 				tt.fields.taskRepo,
+				projectRepo,
+				inmemoryrepo.NewInMemoryTaskEventRepo(),
+				transactioner.NewNoopTransactioner(),
 			)
 			if err := d.Execute(context.Background(), tt.args.params); !testutil.IsSameErr(
 				err,
@@ -152,6 +220,18 @@ func TestDeleteTask_Execute(t *testing.T) {
 					tt.wantErr,
 				)
 			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			deletedTask, _ := tt.fields.taskRepo.GetDeletedTaskByID(
+				context.Background(),
+				tt.args.params.TaskID,
+			)
+			if deletedTask.ID == "" {
+				t.Errorf("DeleteTask.Execute() task was not moved to the trash")
+			}
 		})
 	}
 }
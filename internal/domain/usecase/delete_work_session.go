@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type DeleteWorkSession struct {
+	validator       validator.Validator
+	taskRepo        repo.TaskRepo
+	workSessionRepo repo.WorkSessionRepo
+}
+
+func NewDeleteWorkSession(
+	validator validator.Validator,
+	taskRepo repo.TaskRepo,
+	workSessionRepo repo.WorkSessionRepo,
+) *DeleteWorkSession {
+	return &DeleteWorkSession{
+		validator:       validator,
+		taskRepo:        taskRepo,
+		workSessionRepo: workSessionRepo,
+	}
+}
+
+type DeleteWorkSessionParams struct {
+	TaskID    string `json:"task_id,omitempty"    validate:"required,uuid"`
+	SessionID string `json:"session_id,omitempty" validate:"required,uuid"`
+	UserID    string `json:"user_id,omitempty"    validate:"required,uuid"`
+}
+
+func (d *DeleteWorkSession) Execute(
+	ctx context.Context,
+	params DeleteWorkSessionParams,
+) error {
+	if err := d.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return validationErr
+	}
+
+	task, err := getTrackableTask(ctx, d.taskRepo, params.TaskID, params.UserID)
+	if err != nil {
+		return err
+	}
+
+	session, err := getOwnWorkSession(
+		ctx,
+		d.workSessionRepo,
+		task,
+		params.SessionID,
+		params.UserID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := d.workSessionRepo.DeleteWorkSession(ctx, session.ID); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
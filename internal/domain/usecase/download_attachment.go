@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/blobstore"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type DownloadAttachment struct {
+	validator      validator.Validator
+	symCrypto      symcrypt.SymmetricalEncrypter
+	blobStore      blobstore.BlobStore
+	taskRepo       repo.TaskRepo
+	projectRepo    repo.ProjectRepo
+	attachmentRepo repo.AttachmentRepo
+}
+
+func NewDownloadAttachment(
+	validator validator.Validator,
+	symCrypto symcrypt.SymmetricalEncrypter,
+	blobStore blobstore.BlobStore,
+	taskRepo repo.TaskRepo,
+	projectRepo repo.ProjectRepo,
+	attachmentRepo repo.AttachmentRepo,
+) *DownloadAttachment {
+	return &DownloadAttachment{
+		validator:      validator,
+		symCrypto:      symCrypto,
+		blobStore:      blobStore,
+		taskRepo:       taskRepo,
+		projectRepo:    projectRepo,
+		attachmentRepo: attachmentRepo,
+	}
+}
+
+type DownloadAttachmentParams struct {
+	TaskID       string      `json:"task_id,omitempty"       validate:"required,uuid"`
+	AttachmentID string      `json:"attachment_id,omitempty" validate:"required,uuid"`
+	UserID       string      `json:"user_id,omitempty"       validate:"required,uuid"`
+	UserRole     entity.Role `json:"user_role,omitempty"     validate:"required,min=1,max=2"`
+}
+
+// Execute returns the attachment metadata and its decrypted content.
+func (d *DownloadAttachment) Execute(
+	ctx context.Context,
+	params DownloadAttachmentParams,
+) (entity.Attachment, []byte, error) {
+	if err := d.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return entity.Attachment{}, nil, validationErr
+	}
+
+	task, err := d.taskRepo.GetTaskByID(ctx, params.TaskID)
+	if err != nil {
+		return entity.Attachment{}, nil, entity.NewErr(err)
+	}
+
+	if task.ID == "" {
+		return entity.Attachment{}, nil, entity.ErrTaskNotFound
+	}
+
+	if err := ensureTaskVisible(
+		ctx,
+		d.projectRepo,
+		task,
+		params.UserID,
+		params.UserRole,
+		entity.ErrUserNotAllowedToViewTask,
+	); err != nil {
+		return entity.Attachment{}, nil, err
+	}
+
+	attachment, err := d.attachmentRepo.GetAttachmentByID(
+		ctx,
+		params.AttachmentID,
+	)
+	if err != nil {
+		return entity.Attachment{}, nil, entity.NewErr(err)
+	}
+
+	if attachment.ID == "" || attachment.TaskID != task.ID {
+		return entity.Attachment{}, nil, entity.ErrAttachmentNotFound
+	}
+
+	blob, err := d.blobStore.Get(
+		ctx,
+		attachmentBlobKey(attachment.TaskID, attachment.ID),
+	)
+	if errors.Is(err, blobstore.ErrBlobNotFound) {
+		return entity.Attachment{}, nil, entity.ErrAttachmentNotFound
+	}
+
+	if err != nil {
+		return entity.Attachment{}, nil, entity.NewErr(err)
+	}
+	defer blob.Close()
+
+	encryptedContent, err := io.ReadAll(blob)
+	if err != nil {
+		return entity.Attachment{}, nil, entity.NewErr(err)
+	}
+
+	content, err := d.symCrypto.DecryptBytes(encryptedContent)
+	if err != nil {
+		return entity.Attachment{}, nil, entity.NewErr(err)
+	}
+
+	return attachment, content, nil
+}
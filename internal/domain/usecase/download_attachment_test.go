@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/blobstore/localblobstore"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestDownloadAttachment_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	managerID := uuid.NewString()
+	technicianID := uuid.NewString()
+
+	task := entity.Task{
+		ID:               uuid.NewString(),
+		Summary:          "Loren ipsum dolor sit amet",
+		Status:           entity.TaskStatusOpen,
+		AssignedToUserID: &technicianID,
+		CreatedByUserID:  managerID,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+	otherTask := entity.Task{
+		ID:              uuid.NewString(),
+		Summary:         "Loren ipsum dolor sit amet",
+		Status:          entity.TaskStatusOpen,
+		CreatedByUserID: managerID,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+
+	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+	taskRepo.Tasks = append(taskRepo.Tasks, task, otherTask)
+
+	project := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Maintenance",
+		ManagerUserID: uuid.NewString(),
+	}
+	projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+	projectRepo.Projects = append(projectRepo.Projects, project)
+
+	projectTask := task
+	projectTask.ID = uuid.NewString()
+	projectTask.ProjectID = &project.ID
+	taskRepo.Tasks = append(taskRepo.Tasks, projectTask)
+
+	blobStore := localblobstore.NewLocalBlobStore(
+		&config.Env{BlobStorageDir: t.TempDir()},
+	)
+	attachmentRepo := inmemoryrepo.NewInMemoryAttachmentRepo()
+
+	attachment, err := NewUploadAttachment(
+		val,
+		symCrypto,
+		blobStore,
+		taskRepo,
+		projectRepo,
+		attachmentRepo,
+	).Execute(context.Background(), UploadAttachmentParams{
+		TaskID:   task.ID,
+		UserID:   technicianID,
+		UserRole: entity.RoleTechnician,
+		FileName: "photo.png",
+		Content:  bytes.NewReader(pngContent),
+	})
+	if err != nil {
+		t.Fatalf("UploadAttachment.Execute() error = %v", err)
+	}
+
+	type args struct {
+		params DownloadAttachmentParams
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "should download attachment if user is the assignee",
+			args: args{
+				params: DownloadAttachmentParams{
+					TaskID:       task.ID,
+					AttachmentID: attachment.ID,
+					UserID:       technicianID,
+					UserRole:     entity.RoleTechnician,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should download attachment if user is a manager",
+			args: args{
+				params: DownloadAttachmentParams{
+					TaskID:       task.ID,
+					AttachmentID: attachment.ID,
+					UserID:       managerID,
+					UserRole:     entity.RoleManager,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should not download attachment if user is not the assignee",
+			args: args{
+				params: DownloadAttachmentParams{
+					TaskID:       task.ID,
+					AttachmentID: attachment.ID,
+					UserID:       uuid.NewString(),
+					UserRole:     entity.RoleTechnician,
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToViewTask,
+		},
+		{
+			name: "should not download attachment from another task",
+			args: args{
+				params: DownloadAttachmentParams{
+					TaskID:       otherTask.ID,
+					AttachmentID: attachment.ID,
+					UserID:       managerID,
+					UserRole:     entity.RoleManager,
+				},
+			},
+			wantErr: entity.ErrAttachmentNotFound,
+		},
+		{
+			name: "should not download attachment if it does not exists",
+			args: args{
+				params: DownloadAttachmentParams{
+					TaskID:       task.ID,
+					AttachmentID: uuid.NewString(),
+					UserID:       managerID,
+					UserRole:     entity.RoleManager,
+				},
+			},
+			wantErr: entity.ErrAttachmentNotFound,
+		},
+		{
+			name: "should not download attachment if user is not a project member",
+			args: args{
+				params: DownloadAttachmentParams{
+					TaskID:       projectTask.ID,
+					AttachmentID: attachment.ID,
+					UserID:       managerID,
+					UserRole:     entity.RoleManager,
+				},
+			},
+			wantErr: entity.ErrUserNotProjectMember,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := NewDownloadAttachment(
+				val,
+				symCrypto,
+				blobStore,
+				taskRepo,
+				projectRepo,
+				attachmentRepo,
+			)
+
+			_, content, err := d.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"DownloadAttachment.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if !bytes.Equal(content, pngContent) {
+				t.Errorf("DownloadAttachment.Execute() content was not decrypted")
+			}
+		})
+	}
+}
@@ -0,0 +1,289 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+// TaskFileFormat is the format tasks are exported to and imported from.
+type TaskFileFormat string
+
+const (
+	TaskFileFormatCSV    TaskFileFormat = "csv"
+	TaskFileFormatNDJSON TaskFileFormat = "ndjson"
+)
+
+// csvIDsSeparator separates the IDs in a single CSV field, such as the
+// label IDs of a task.
+const csvIDsSeparator = ";"
+
+// taskExportColumns are the header of the exported CSV files, in the
+// same order as the fields of each row.
+var taskExportColumns = []string{
+	"id",
+	"summary",
+	"status",
+	"assigned_to_user_id",
+	"created_by_user_id",
+	"label_ids",
+	"created_at",
+	"updated_at",
+	"finished_at",
+	"due_at",
+	"priority",
+	"assignee_ids",
+	"finish_policy",
+}
+
+// exportTasksPageSize is how many tasks are held in memory at once
+// while exporting.
+const exportTasksPageSize = 500
+
+type ExportTasks struct {
+	validator   validator.Validator
+	symCrypto   symcrypt.SymmetricalEncrypter
+	taskRepo    repo.TaskRepo
+	projectRepo repo.ProjectRepo
+}
+
+func NewExportTasks(
+	validator validator.Validator,
+	symCrypto symcrypt.SymmetricalEncrypter,
+	taskRepo repo.TaskRepo,
+	projectRepo repo.ProjectRepo,
+) *ExportTasks {
+	return &ExportTasks{
+		validator:   validator,
+		symCrypto:   symCrypto,
+		taskRepo:    taskRepo,
+		projectRepo: projectRepo,
+	}
+}
+
+type ExportTasksParams struct {
+	UserRole     entity.Role    `json:"user_role,omitempty"     validate:"required,min=1,max=2"`
+	UserID       string         `json:"user_id,omitempty"       validate:"required,uuid"`
+	Format       TaskFileFormat `json:"format,omitempty"        validate:"required,oneof=csv ndjson"`
+	Status       string         `json:"status,omitempty"        validate:"omitempty,oneof=open finished"`
+	CreatedFrom  *time.Time     `json:"created_from,omitempty"`
+	CreatedTo    *time.Time     `json:"created_to,omitempty"`
+	FinishedFrom *time.Time     `json:"finished_from,omitempty"`
+	FinishedTo   *time.Time     `json:"finished_to,omitempty"`
+}
+
+// ExportedTask is a task as written to NDJSON exports.
+type ExportedTask struct {
+	ID               string                  `json:"id"`
+	Summary          string                  `json:"summary"`
+	Status           entity.TaskStatus       `json:"status"`
+	AssignedToUserID *string                 `json:"assigned_to_user_id"`
+	CreatedByUserID  string                  `json:"created_by_user_id"`
+	LabelIDs         []string                `json:"label_ids"`
+	CreatedAt        time.Time               `json:"created_at"`
+	UpdatedAt        time.Time               `json:"updated_at"`
+	FinishedAt       *time.Time              `json:"finished_at"`
+	DueAt            *time.Time              `json:"due_at"`
+	Priority         entity.TaskPriority     `json:"priority"`
+	AssigneeIDs      []string                `json:"assignee_ids"`
+	FinishPolicy     entity.TaskFinishPolicy `json:"finish_policy"`
+}
+
+// taskWriter writes exported tasks in one of the file formats.
+type taskWriter interface {
+	Write(task ExportedTask) error
+	// Flush writes any buffered data to the underlying writer.
+	Flush() error
+}
+
+// Execute writes the tasks the user can see to w, ordered by creation
+// date. Tasks are read a page at a time and their summaries decrypted
+// as they are written, so the whole table is never held in memory.
+// Nothing is written to w if the params are invalid.
+func (e *ExportTasks) Execute(
+	ctx context.Context,
+	params ExportTasksParams,
+	w io.Writer,
+) error {
+	if err := e.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return validationErr
+	}
+
+	opts := listTasksFilterOptions(ListTasksParams{
+		Status:       params.Status,
+		CreatedFrom:  params.CreatedFrom,
+		CreatedTo:    params.CreatedTo,
+		FinishedFrom: params.FinishedFrom,
+		FinishedTo:   params.FinishedTo,
+	}, time.Now())
+	opts = append(opts, repo.WithLimit(exportTasksPageSize))
+
+	switch params.UserRole {
+	case entity.RoleManager:
+		// Managers can export the tasks they can list.
+		scopeOpt, err := projectScopeOption(ctx, e.projectRepo, params.UserID)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, scopeOpt)
+
+	case entity.RoleTechnician:
+		opts = append(opts, repo.WithAssignedToUserID(params.UserID))
+
+	default:
+		return entity.ErrValidation
+	}
+
+	var tw taskWriter
+	switch params.Format {
+	case TaskFileFormatCSV:
+		cw, err := newCSVTaskWriter(w)
+		if err != nil {
+			return entity.NewErr(err)
+		}
+		tw = cw
+
+	case TaskFileFormatNDJSON:
+		tw = ndjsonTaskWriter{encoder: json.NewEncoder(w)}
+	}
+
+	var after *repo.TaskCursor
+	for {
+		pageOpts := opts
+		if after != nil {
+			pageOpts = append(slices.Clip(opts), repo.WithAfter(*after))
+		}
+
+		tasks, err := e.taskRepo.ListTasks(ctx, pageOpts...)
+		if err != nil {
+			return entity.NewErr(err)
+		}
+
+		if len(tasks) == 0 {
+			break
+		}
+
+		taskIDs := make([]string, len(tasks))
+		for i, task := range tasks {
+			taskIDs[i] = task.ID
+		}
+
+		labelIDsByTaskID, err := e.taskRepo.ListTaskLabelIDs(ctx, taskIDs)
+		if err != nil {
+			return entity.NewErr(err)
+		}
+
+		for _, task := range tasks {
+			task, err = decryptTask(e.symCrypto, task)
+			if err != nil {
+				return entity.NewErr(err)
+			}
+
+			if err := tw.Write(ExportedTask{
+				ID:               task.ID,
+				Summary:          task.Summary,
+				Status:           task.Status,
+				AssignedToUserID: task.AssignedToUserID,
+				CreatedByUserID:  task.CreatedByUserID,
+				LabelIDs:         labelIDsByTaskID[task.ID],
+				CreatedAt:        task.CreatedAt,
+				UpdatedAt:        task.UpdatedAt,
+				FinishedAt:       task.FinishedAt,
+				DueAt:            task.DueAt,
+				Priority:         task.Priority,
+				AssigneeIDs:      task.AssigneeIDs,
+				FinishPolicy:     task.FinishPolicy,
+			}); err != nil {
+				return entity.NewErr(err)
+			}
+		}
+
+		if err := tw.Flush(); err != nil {
+			return entity.NewErr(err)
+		}
+
+		if len(tasks) < exportTasksPageSize {
+			break
+		}
+
+		cursor := repo.NewTaskCursor(
+			repo.TaskSortByCreatedAt,
+			tasks[len(tasks)-1],
+		)
+		after = &cursor
+	}
+
+	return tw.Flush()
+}
+
+type csvTaskWriter struct {
+	writer *csv.Writer
+}
+
+// newCSVTaskWriter returns a writer that has already written the header.
+func newCSVTaskWriter(w io.Writer) (*csvTaskWriter, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(taskExportColumns); err != nil {
+		return nil, err
+	}
+
+	return &csvTaskWriter{writer: writer}, nil
+}
+
+func (c *csvTaskWriter) Write(task ExportedTask) error {
+	var assignedToUserID, finishedAt, dueAt string
+	if task.AssignedToUserID != nil {
+		assignedToUserID = *task.AssignedToUserID
+	}
+	if task.FinishedAt != nil {
+		finishedAt = task.FinishedAt.Format(time.RFC3339)
+	}
+	if task.DueAt != nil {
+		dueAt = task.DueAt.Format(time.RFC3339)
+	}
+
+	return c.writer.Write([]string{
+		task.ID,
+		task.Summary,
+		string(task.Status),
+		assignedToUserID,
+		task.CreatedByUserID,
+		strings.Join(task.LabelIDs, csvIDsSeparator),
+		task.CreatedAt.Format(time.RFC3339),
+		task.UpdatedAt.Format(time.RFC3339),
+		finishedAt,
+		dueAt,
+		string(task.Priority),
+		strings.Join(task.AssigneeIDs, csvIDsSeparator),
+		string(task.FinishPolicy),
+	})
+}
+
+func (c *csvTaskWriter) Flush() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+type ndjsonTaskWriter struct {
+	encoder *json.Encoder
+}
+
+func (n ndjsonTaskWriter) Write(task ExportedTask) error {
+	// The encoder ends every value with a newline.
+	return n.encoder.Encode(task)
+}
+
+func (n ndjsonTaskWriter) Flush() error {
+	return nil
+}
@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestExportTasks_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	managerUser := entity.User{
+		ID:   uuid.NewString(),
+		Role: entity.RoleManager,
+	}
+
+	technicianUser := entity.User{
+		ID:   uuid.NewString(),
+		Role: entity.RoleTechnician,
+	}
+
+	summary := "Loren ipsum, dolor sit amet"
+	encryptedSummary, err := symCrypto.Encrypt(summary)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// More tasks than fit in a page, to export several pages.
+	tasksCount := exportTasksPageSize + 3
+	assignedTasksCount := 0
+	createdAt := time.Now().Add(-time.Hour)
+
+	tasks := make([]entity.Task, tasksCount)
+	for i := range tasks {
+		tasks[i] = entity.Task{
+			ID:              uuid.NewString(),
+			Summary:         encryptedSummary,
+			Status:          entity.TaskStatusOpen,
+			CreatedByUserID: managerUser.ID,
+			CreatedAt:       createdAt.Add(time.Duration(i) * time.Second),
+			UpdatedAt:       createdAt,
+		}
+
+		if i%2 == 0 {
+			tasks[i].AssignedToUserID = &technicianUser.ID
+			assignedTasksCount++
+		}
+	}
+
+	type args struct {
+		params ExportTasksParams
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantCount int
+		wantErr   error
+	}{
+		{
+			name: "should export every task as CSV",
+			args: args{
+				params: ExportTasksParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerUser.ID,
+					Format:   TaskFileFormatCSV,
+				},
+			},
+			wantCount: tasksCount,
+			wantErr:   nil,
+		},
+		{
+			name: "should export only assigned tasks to technicians as NDJSON",
+			args: args{
+				params: ExportTasksParams{
+					UserRole: entity.RoleTechnician,
+					UserID:   technicianUser.ID,
+					Format:   TaskFileFormatNDJSON,
+				},
+			},
+			wantCount: assignedTasksCount,
+			wantErr:   nil,
+		},
+		{
+			name: "should not export tasks in an unknown format",
+			args: args{
+				params: ExportTasksParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerUser.ID,
+					Format:   "xlsx",
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+			taskRepo.Tasks = append(taskRepo.Tasks, tasks...)
+
+			e := NewExportTasks(
+				val,
+				symCrypto,
+				taskRepo,
+				inmemoryrepo.NewInMemoryProjectRepo(),
+			)
+
+			buf := &bytes.Buffer{}
+			err := e.Execute(context.Background(), tt.args.params, buf)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"ExportTasks.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				if buf.Len() != 0 {
+					t.Errorf("ExportTasks.Execute() wrote %q", buf.String())
+				}
+				return
+			}
+
+			var summaries []string
+			switch tt.args.params.Format {
+			case TaskFileFormatCSV:
+				records, err := csv.NewReader(buf).ReadAll()
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, record := range records[1:] {
+					summaries = append(summaries, record[1])
+				}
+
+			case TaskFileFormatNDJSON:
+				scanner := bufio.NewScanner(buf)
+				for scanner.Scan() {
+					task := ExportedTask{}
+					if err := json.Unmarshal(scanner.Bytes(), &task); err != nil {
+						t.Fatal(err)
+					}
+					summaries = append(summaries, task.Summary)
+				}
+			}
+
+			if len(summaries) != tt.wantCount {
+				t.Errorf(
+					"ExportTasks.Execute() exported %v tasks, want %v",
+					len(summaries),
+					tt.wantCount,
+				)
+			}
+
+			for _, got := range summaries {
+				if got != summary {
+					t.Errorf(
+						"ExportTasks.Execute() summary = %v, want %v",
+						got,
+						summary,
+					)
+					break
+				}
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"encoding/json"
+	"slices"
 	"time"
 
 	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
@@ -14,23 +15,32 @@ import (
 )
 
 type FinishTask struct {
-	validator validator.Validator
-	msgBroker broker.MessageBroker
-	taskRepo  repo.TaskRepo
-	tx        transactioner.Transactioner
+	validator          validator.Validator
+	msgBroker          broker.MessageBroker
+	taskRepo           repo.TaskRepo
+	taskEventRepo      repo.TaskEventRepo
+	checklistRepo      repo.ChecklistRepo
+	taskDependencyRepo repo.TaskDependencyRepo
+	tx                 transactioner.Transactioner
 }
 
 func NewFinishTask(
 	validator validator.Validator,
 	msgBroker broker.MessageBroker,
 	taskRepo repo.TaskRepo,
+	taskEventRepo repo.TaskEventRepo,
+	checklistRepo repo.ChecklistRepo,
+	taskDependencyRepo repo.TaskDependencyRepo,
 	tx transactioner.Transactioner,
 ) *FinishTask {
 	return &FinishTask{
-		validator: validator,
-		msgBroker: msgBroker,
-		taskRepo:  taskRepo,
-		tx:        tx,
+		validator:          validator,
+		msgBroker:          msgBroker,
+		taskRepo:           taskRepo,
+		taskEventRepo:      taskEventRepo,
+		checklistRepo:      checklistRepo,
+		taskDependencyRepo: taskDependencyRepo,
+		tx:                 tx,
 	}
 }
 
@@ -40,6 +50,10 @@ type FinishTaskParams struct {
 	UserRole entity.Role `json:"role,omitempty"    validate:"required,min=1,max=2"`
 }
 
+// Execute submits the work of the assignee for review, moving the task
+// to in review, from where a manager accepts it into done. Under the all
+// finish policy only the sign-off of the assignee is recorded until every
+// assignee signed the task off.
 func (f *FinishTask) Execute(
 	ctx context.Context,
 	params FinishTaskParams,
@@ -63,8 +77,49 @@ func (f *FinishTask) Execute(
 		return entity.ErrTaskNotFound
 	}
 
-	finishedAt := time.Now()
-	task.FinishedAt = &finishedAt
+	if !task.IsAssignedTo(params.UserID) {
+		return entity.ErrUserNotAssignedToTask
+	}
+
+	if err := task.ValidateTransition(
+		entity.TaskStatusInReview,
+		params.UserID,
+		params.UserRole,
+	); err != nil {
+		return err
+	}
+
+	previousTask := task
+
+	now := time.Now()
+	if !slices.Contains(task.SignedOffUserIDs, params.UserID) {
+		task.SignedOffUserIDs = append(
+			slices.Clone(task.SignedOffUserIDs),
+			params.UserID,
+		)
+	}
+
+	// Under the all finish policy the sign-off of the assignee is only
+	// recorded until the last one signs the task off.
+	if task.FinishPolicy == entity.TaskFinishPolicyAll &&
+		!task.IsSignedOffByAll() {
+		return f.signOff(ctx, params, previousTask, task, now)
+	}
+
+	if err := ensureChecklistDone(ctx, f.checklistRepo, task.ID); err != nil {
+		return err
+	}
+
+	if err := ensureBlockersFinished(
+		ctx,
+		f.taskRepo,
+		f.taskDependencyRepo,
+		task.ID,
+	); err != nil {
+		return err
+	}
+
+	task.Status = entity.TaskStatusInReview
 
 	var repoParams repo.UpdateTaskParams
 	if err = copier.Copy(&repoParams, task); err != nil {
@@ -72,10 +127,30 @@ func (f *FinishTask) Execute(
 	}
 
 	err = f.tx.Do(ctx, func(ctx context.Context) error {
+		if _, err := f.taskRepo.SignOffTask(
+			ctx,
+			task.ID,
+			params.UserID,
+			now,
+		); err != nil {
+			return entity.NewErr(err)
+		}
+
 		if err = f.taskRepo.UpdateTask(ctx, repoParams); err != nil {
 			return entity.NewErr(err)
 		}
 
+		if err := recordTaskEvent(
+			ctx,
+			f.taskEventRepo,
+			entity.TaskEventStatusChanged,
+			params.UserID,
+			previousTask,
+			task,
+		); err != nil {
+			return err
+		}
+
 		task.UpdatedAt = time.Now()
 
 		taskBytes, err := json.Marshal(task)
@@ -83,7 +158,12 @@ func (f *FinishTask) Execute(
 			return entity.NewErr(err)
 		}
 
-		if err := f.msgBroker.Publish(broker.TopicTaskFinished, taskBytes); err != nil {
+		if err := publishAfterCommit(
+			ctx,
+			f.msgBroker,
+			broker.TopicTaskStatusChanged,
+			taskBytes,
+		); err != nil {
 			return entity.NewErr(err)
 		}
 
@@ -96,3 +176,44 @@ func (f *FinishTask) Execute(
 
 	return nil
 }
+
+// signOff records the sign-off of the assignee without submitting the
+// task for review, which is a no-op if they had already signed it off.
+func (f *FinishTask) signOff(
+	ctx context.Context,
+	params FinishTaskParams,
+	previousTask entity.Task,
+	task entity.Task,
+	signedOffAt time.Time,
+) error {
+	err := f.tx.Do(ctx, func(ctx context.Context) error {
+		signedOff, err := f.taskRepo.SignOffTask(
+			ctx,
+			task.ID,
+			params.UserID,
+			signedOffAt,
+		)
+		if err != nil {
+			return entity.NewErr(err)
+		}
+
+		if !signedOff {
+			return nil
+		}
+
+		return recordTaskEvent(
+			ctx,
+			f.taskEventRepo,
+			entity.TaskEventUpdated,
+			params.UserID,
+			previousTask,
+			task,
+		)
+	})
+
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
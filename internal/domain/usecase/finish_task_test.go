@@ -2,11 +2,13 @@ package usecase
 
 import (
 	"context"
+	"slices"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
 	"github.com/danielmesquitta/tasks-api/internal/provider/broker"
 	"github.com/danielmesquitta/tasks-api/internal/provider/broker/clibroker"
@@ -29,15 +31,83 @@ func TestFinishTask_Execute(t *testing.T) {
 	task := entity.Task{
 		ID:               uuid.NewString(),
 		Summary:          "Loren ipsum dolor sit amet",
+		Status:           entity.TaskStatusInProgress,
 		AssignedToUserID: &technicianUser.ID,
 		CreatedByUserID:  managerUser.ID,
 		CreatedAt:        time.Now(),
 		UpdatedAt:        time.Now(),
 	}
 
+	taskWithOpenChecklist := entity.Task{
+		ID:               uuid.NewString(),
+		Summary:          "Loren ipsum dolor sit amet",
+		Status:           entity.TaskStatusInProgress,
+		AssignedToUserID: &technicianUser.ID,
+		CreatedByUserID:  managerUser.ID,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+
+	blockingTask := entity.Task{
+		ID:              uuid.NewString(),
+		Summary:         "Loren ipsum dolor sit amet",
+		CreatedByUserID: managerUser.ID,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+
+	blockedTask := entity.Task{
+		ID:               uuid.NewString(),
+		Summary:          "Loren ipsum dolor sit amet",
+		Status:           entity.TaskStatusInProgress,
+		AssignedToUserID: &technicianUser.ID,
+		CreatedByUserID:  managerUser.ID,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+
+	openTask := task
+	openTask.ID = uuid.NewString()
+	openTask.Status = entity.TaskStatusOpen
+
+	blockedStatusTask := task
+	blockedStatusTask.ID = uuid.NewString()
+	blockedStatusTask.Status = entity.TaskStatusBlocked
+
 	taskRepo.Tasks = append(
 		taskRepo.Tasks,
 		task,
+		taskWithOpenChecklist,
+		blockingTask,
+		blockedTask,
+		openTask,
+		blockedStatusTask,
+	)
+
+	taskDependencyRepo := inmemoryrepo.NewInMemoryTaskDependencyRepo()
+	taskDependencyRepo.Dependencies = append(
+		taskDependencyRepo.Dependencies,
+		entity.TaskDependency{
+			TaskID:          blockedTask.ID,
+			BlockedByTaskID: blockingTask.ID,
+		},
+	)
+
+	checklistRepo := inmemoryrepo.NewInMemoryChecklistRepo()
+	checklistRepo.Items = append(
+		checklistRepo.Items,
+		entity.ChecklistItem{
+			ID:       uuid.NewString(),
+			TaskID:   task.ID,
+			Position: 1,
+			Required: false,
+		},
+		entity.ChecklistItem{
+			ID:       uuid.NewString(),
+			TaskID:   taskWithOpenChecklist.ID,
+			Position: 1,
+			Required: true,
+		},
 	)
 
 	type fields struct {
@@ -55,7 +125,7 @@ func TestFinishTask_Execute(t *testing.T) {
 		wantErr error
 	}{
 		{
-			name: "should update task finished at",
+			name: "should submit task for review",
 			fields: fields{
 				validator: validator.NewValidate(),
 				msgBroker: clibroker.NewCLIMessageBroker(),
@@ -71,7 +141,7 @@ func TestFinishTask_Execute(t *testing.T) {
 			wantErr: nil,
 		},
 		{
-			name: "should not update task finished at if user role is not technician",
+			name: "should not submit task for review if user role is not technician",
 			fields: fields{
 				validator: validator.NewValidate(),
 				msgBroker: clibroker.NewCLIMessageBroker(),
@@ -87,7 +157,7 @@ func TestFinishTask_Execute(t *testing.T) {
 			wantErr: entity.ErrUserNotAllowedToFinishTask,
 		},
 		{
-			name: "should not update task finished at if is a invalid task id",
+			name: "should not submit task for review if is a invalid task id",
 			fields: fields{
 				validator: validator.NewValidate(),
 				msgBroker: clibroker.NewCLIMessageBroker(),
@@ -103,7 +173,7 @@ func TestFinishTask_Execute(t *testing.T) {
 			wantErr: entity.ErrValidation,
 		},
 		{
-			name: "should not update task finished at if is a invalid user id",
+			name: "should not submit task for review if is a invalid user id",
 			fields: fields{
 				validator: validator.NewValidate(),
 				msgBroker: clibroker.NewCLIMessageBroker(),
@@ -119,7 +189,7 @@ func TestFinishTask_Execute(t *testing.T) {
 			wantErr: entity.ErrValidation,
 		},
 		{
-			name: "should not update task finished at if task does not exists",
+			name: "should not submit task for review if task does not exists",
 			fields: fields{
 				validator: validator.NewValidate(),
 				msgBroker: clibroker.NewCLIMessageBroker(),
@@ -134,6 +204,86 @@ func TestFinishTask_Execute(t *testing.T) {
 			},
 			wantErr: entity.ErrTaskNotFound,
 		},
+		{
+			name: "should not submit task for review if required checklist items are open",
+			fields: fields{
+				validator: validator.NewValidate(),
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: FinishTaskParams{
+					TaskID:   taskWithOpenChecklist.ID,
+					UserID:   technicianUser.ID,
+					UserRole: entity.RoleTechnician,
+				},
+			},
+			wantErr: entity.ErrTaskHasOpenChecklistItems,
+		},
+		{
+			name: "should not submit task for review if a blocking task is unfinished",
+			fields: fields{
+				validator: validator.NewValidate(),
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: FinishTaskParams{
+					TaskID:   blockedTask.ID,
+					UserID:   technicianUser.ID,
+					UserRole: entity.RoleTechnician,
+				},
+			},
+			wantErr: entity.ErrTaskBlocked,
+		},
+		{
+			name: "should not submit task for review if user is not assigned to the task",
+			fields: fields{
+				validator: validator.NewValidate(),
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: FinishTaskParams{
+					TaskID:   task.ID,
+					UserID:   uuid.NewString(),
+					UserRole: entity.RoleTechnician,
+				},
+			},
+			wantErr: entity.ErrUserNotAssignedToTask,
+		},
+		{
+			name: "should not submit task for review if it is not in progress",
+			fields: fields{
+				validator: validator.NewValidate(),
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: FinishTaskParams{
+					TaskID:   openTask.ID,
+					UserID:   technicianUser.ID,
+					UserRole: entity.RoleTechnician,
+				},
+			},
+			wantErr: entity.ErrInvalidTaskStatusTransition,
+		},
+		{
+			name: "should not submit task for review if it is blocked",
+			fields: fields{
+				validator: validator.NewValidate(),
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: FinishTaskParams{
+					TaskID:   blockedStatusTask.ID,
+					UserID:   technicianUser.ID,
+					UserRole: entity.RoleTechnician,
+				},
+			},
+			wantErr: entity.ErrInvalidTaskStatusTransition,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -143,7 +293,10 @@ func TestFinishTask_Execute(t *testing.T) {
 				tt.fields.validator,
 				tt.fields.msgBroker,
 				tt.fields.taskRepo,
-				nil,
+				inmemoryrepo.NewInMemoryTaskEventRepo(),
+				checklistRepo,
+				taskDependencyRepo,
+				transactioner.NewNoopTransactioner(),
 			)
 
 			sentMessages := 0
@@ -151,7 +304,7 @@ func TestFinishTask_Execute(t *testing.T) {
 			wg.Add(1)
 			if tt.wantErr == nil {
 				_ = tt.fields.msgBroker.Subscribe(
-					broker.TopicTaskFinished,
+					broker.TopicTaskStatusChanged,
 					func(_ []byte) {
 						defer wg.Done()
 						sentMessages++
@@ -169,10 +322,18 @@ func TestFinishTask_Execute(t *testing.T) {
 			}
 
 			if tt.wantErr == nil {
-				if tt.fields.taskRepo.Tasks[0].FinishedAt.IsZero() {
+				if status := tt.fields.taskRepo.Tasks[0].Status; status != entity.TaskStatusInReview {
 					t.Errorf(
-						"FinishTask.Execute() taskRepo.Tasks[0].FinishedAt = %v, want not zero",
-						tt.fields.taskRepo.Tasks[0].FinishedAt,
+						"FinishTask.Execute() taskRepo.Tasks[0].Status = %v, want %v",
+						status,
+						entity.TaskStatusInReview,
+					)
+				}
+
+				if tt.fields.taskRepo.Tasks[0].FinishedAt != nil {
+					t.Errorf(
+						"FinishTask.Execute() taskRepo.Tasks[0].FinishedAt = %v, want nil until a manager accepts it",
+						*tt.fields.taskRepo.Tasks[0].FinishedAt,
 					)
 				}
 
@@ -187,3 +348,109 @@ func TestFinishTask_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestFinishTask_Execute_FinishPolicy(t *testing.T) {
+	firstTechnicianID := uuid.NewString()
+	secondTechnicianID := uuid.NewString()
+
+	tests := []struct {
+		name             string
+		finishPolicy     entity.TaskFinishPolicy
+		signedOffUserIDs []string
+		wantSubmitted    bool
+		wantEvent        entity.TaskEventType
+	}{
+		{
+			name:          "should submit the task for review when any assignee finishes it",
+			finishPolicy:  entity.TaskFinishPolicyAny,
+			wantSubmitted: true,
+			wantEvent:     entity.TaskEventStatusChanged,
+		},
+		{
+			name:          "should only sign off the task until all assignees finish it",
+			finishPolicy:  entity.TaskFinishPolicyAll,
+			wantSubmitted: false,
+			wantEvent:     entity.TaskEventUpdated,
+		},
+		{
+			name:             "should submit the task for review when the last assignee signs it off",
+			finishPolicy:     entity.TaskFinishPolicyAll,
+			signedOffUserIDs: []string{secondTechnicianID},
+			wantSubmitted:    true,
+			wantEvent:        entity.TaskEventStatusChanged,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+			taskRepo.Tasks = append(taskRepo.Tasks, entity.Task{
+				ID:               uuid.NewString(),
+				Summary:          "Loren ipsum dolor sit amet",
+				Status:           entity.TaskStatusInProgress,
+				CreatedByUserID:  uuid.NewString(),
+				AssigneeIDs:      []string{firstTechnicianID, secondTechnicianID},
+				SignedOffUserIDs: tt.signedOffUserIDs,
+				FinishPolicy:     tt.finishPolicy,
+				CreatedAt:        time.Now(),
+				UpdatedAt:        time.Now(),
+			})
+			taskEventRepo := inmemoryrepo.NewInMemoryTaskEventRepo()
+			msgBroker := &recordingBroker{}
+
+			f := NewFinishTask(
+				validator.NewValidate(),
+				msgBroker,
+				taskRepo,
+				taskEventRepo,
+				inmemoryrepo.NewInMemoryChecklistRepo(),
+				inmemoryrepo.NewInMemoryTaskDependencyRepo(),
+				transactioner.NewNoopTransactioner(),
+			)
+
+			err := f.Execute(context.Background(), FinishTaskParams{
+				TaskID:   taskRepo.Tasks[0].ID,
+				UserID:   firstTechnicianID,
+				UserRole: entity.RoleTechnician,
+			})
+			if err != nil {
+				t.Fatalf("FinishTask.Execute() error = %v", err)
+			}
+
+			task := taskRepo.Tasks[0]
+			if submitted := task.Status == entity.TaskStatusInReview; submitted != tt.wantSubmitted {
+				t.Errorf(
+					"FinishTask.Execute() submitted = %v, want %v",
+					submitted,
+					tt.wantSubmitted,
+				)
+			}
+
+			if !slices.Contains(task.SignedOffUserIDs, firstTechnicianID) {
+				t.Errorf(
+					"FinishTask.Execute() signed off user ids = %v, want %v in them",
+					task.SignedOffUserIDs,
+					firstTechnicianID,
+				)
+			}
+
+			if len(taskEventRepo.Events) != 1 ||
+				taskEventRepo.Events[0].Type != tt.wantEvent {
+				t.Errorf(
+					"FinishTask.Execute() events = %v, want one %v event",
+					taskEventRepo.Events,
+					tt.wantEvent,
+				)
+			}
+
+			if published := len(msgBroker.topics) == 1; published != tt.wantSubmitted {
+				t.Errorf(
+					"FinishTask.Execute() published = %v, want %v",
+					msgBroker.topics,
+					tt.wantSubmitted,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/tenant"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+// calendarFeedPageSize is how many tasks are read at once while
+// building the calendar feed.
+const calendarFeedPageSize = 500
+
+type GetCalendarFeed struct {
+	validator        validator.Validator
+	symCrypto        symcrypt.SymmetricalEncrypter
+	organizationRepo repo.OrganizationRepo
+	calendarFeedRepo repo.CalendarFeedRepo
+	taskRepo         repo.TaskRepo
+}
+
+func NewGetCalendarFeed(
+	validator validator.Validator,
+	symCrypto symcrypt.SymmetricalEncrypter,
+	organizationRepo repo.OrganizationRepo,
+	calendarFeedRepo repo.CalendarFeedRepo,
+	taskRepo repo.TaskRepo,
+) *GetCalendarFeed {
+	return &GetCalendarFeed{
+		validator:        validator,
+		symCrypto:        symCrypto,
+		organizationRepo: organizationRepo,
+		calendarFeedRepo: calendarFeedRepo,
+		taskRepo:         taskRepo,
+	}
+}
+
+type GetCalendarFeedParams struct {
+	Token string `json:"token,omitempty" validate:"required"`
+}
+
+// Execute returns the iCalendar file of the user the token belongs to,
+// with an event at the due date of each unfinished task assigned to
+// them.
+func (g *GetCalendarFeed) Execute(
+	ctx context.Context,
+	params GetCalendarFeedParams,
+) ([]byte, error) {
+	if err := g.validator.Validate(params); err != nil {
+		return nil, entity.ErrInvalidCalendarFeedToken
+	}
+
+	tokenHash := hashCalendarFeedToken(params.Token)
+
+	// The feed is not requested with an access token, so the organization
+	// it acts on is the one of the token owner.
+	organizationID, err := g.organizationRepo.
+		GetOrganizationIDByCalendarFeedTokenHash(ctx, tokenHash)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	if organizationID == "" {
+		return nil, entity.ErrInvalidCalendarFeedToken
+	}
+
+	ctx = tenant.WithOrganizationID(ctx, organizationID)
+
+	feedToken, err := g.calendarFeedRepo.GetCalendarFeedTokenByHash(
+		ctx,
+		tokenHash,
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	if feedToken.UserID == "" {
+		return nil, entity.ErrInvalidCalendarFeedToken
+	}
+
+	now := time.Now()
+
+	w := &icalWriter{}
+	w.writeLine("BEGIN", "VCALENDAR")
+	w.writeLine("VERSION", "2.0")
+	w.writeLine("PRODID", "-//tasks-api//Tasks//EN")
+	w.writeLine("CALSCALE", "GREGORIAN")
+	w.writeLine("METHOD", "PUBLISH")
+	w.writeLine("X-WR-CALNAME", "Tasks")
+	w.writeLine("REFRESH-INTERVAL;VALUE=DURATION", "PT1H")
+	w.writeLine("X-PUBLISHED-TTL", "PT1H")
+
+	// Tasks without due date are sorted last, so reading stops at the
+	// first one.
+	opts := []repo.ListTasksOption{
+		repo.WithAssignedToUserID(feedToken.UserID),
+		repo.WithFinished(false),
+		repo.WithSort(repo.TaskSortByDueAt, repo.SortAsc),
+		repo.WithLimit(calendarFeedPageSize),
+	}
+
+	for {
+		tasks, err := g.taskRepo.ListTasks(ctx, opts...)
+		if err != nil {
+			return nil, entity.NewErr(err)
+		}
+
+		for _, task := range tasks {
+			if task.DueAt == nil {
+				break
+			}
+
+			task, err = decryptTask(g.symCrypto, task)
+			if err != nil {
+				return nil, entity.NewErr(err)
+			}
+
+			w.writeTaskEvent(task, now)
+		}
+
+		if len(tasks) < calendarFeedPageSize ||
+			tasks[len(tasks)-1].DueAt == nil {
+			break
+		}
+
+		cursor := repo.NewTaskCursor(repo.TaskSortByDueAt, tasks[len(tasks)-1])
+		opts = append(opts, repo.WithAfter(cursor))
+	}
+
+	w.writeLine("END", "VCALENDAR")
+
+	return w.bytes(), nil
+}
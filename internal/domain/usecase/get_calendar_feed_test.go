@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/tenant"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestGetCalendarFeed_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	organizationID := uuid.NewString()
+	technicianID := uuid.NewString()
+	otherTechnicianID := uuid.NewString()
+
+	dueAt := time.Date(2026, 10, 20, 18, 0, 0, 0, time.UTC)
+	finishedAt := dueAt.Add(-time.Hour)
+
+	newTask := func(
+		summary string,
+		assignedToUserID string,
+		dueAt *time.Time,
+		finishedAt *time.Time,
+	) entity.Task {
+		encryptedSummary, err := symCrypto.Encrypt(summary)
+		if err != nil {
+			t.Fatalf("could not encrypt summary")
+		}
+
+		return entity.Task{
+			ID:               uuid.NewString(),
+			OrganizationID:   organizationID,
+			Summary:          encryptedSummary,
+			Status:           entity.TaskStatusOpen,
+			AssignedToUserID: &assignedToUserID,
+			CreatedByUserID:  uuid.NewString(),
+			DueAt:            dueAt,
+			Priority:         entity.TaskPriorityHigh,
+			FinishedAt:       finishedAt,
+		}
+	}
+
+	dueTask := newTask(
+		"Replace the filters; check the pressure, then report back",
+		technicianID,
+		&dueAt,
+		nil,
+	)
+	undatedTask := newTask("Undated task", technicianID, nil, nil)
+	finishedTask := newTask("Finished task", technicianID, &dueAt, &finishedAt)
+	otherTask := newTask("Other task", otherTechnicianID, &dueAt, nil)
+
+	token, tokenHash, err := newCalendarFeedToken()
+	if err != nil {
+		t.Fatalf("could not create token")
+	}
+
+	tests := []struct {
+		name          string
+		token         string
+		wantSummaries []string
+		wantErr       error
+	}{
+		{
+			name:  "should list the unfinished tasks assigned to the user with due date",
+			token: token,
+			wantSummaries: []string{
+				`SUMMARY:Replace the filters\; check the pressure\, then report back`,
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "should not get the feed with an unknown token",
+			token:   "invalid",
+			wantErr: entity.ErrInvalidCalendarFeedToken,
+		},
+		{
+			name:    "should not get the feed without token",
+			token:   "",
+			wantErr: entity.ErrInvalidCalendarFeedToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+			taskRepo.Tasks = append(
+				taskRepo.Tasks,
+				undatedTask,
+				finishedTask,
+				otherTask,
+				dueTask,
+			)
+
+			userRepo := inmemoryrepo.NewInMemoryUserRepo()
+			userRepo.Users = append(userRepo.Users, entity.User{
+				ID:             technicianID,
+				OrganizationID: organizationID,
+				Role:           entity.RoleTechnician,
+			})
+
+			calendarFeedRepo := inmemoryrepo.NewInMemoryCalendarFeedRepo()
+			err := calendarFeedRepo.SetCalendarFeedToken(
+				tenant.WithOrganizationID(context.Background(), organizationID),
+				technicianID,
+				tokenHash,
+			)
+			if err != nil {
+				t.Fatalf("could not set token")
+			}
+
+			g := NewGetCalendarFeed(
+				val,
+				symCrypto,
+				inmemoryrepo.NewInMemoryOrganizationRepo(
+					userRepo,
+					calendarFeedRepo,
+				),
+				calendarFeedRepo,
+				taskRepo,
+			)
+
+			got, err := g.Execute(
+				context.Background(),
+				GetCalendarFeedParams{Token: tt.token},
+			)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Fatalf(
+					"GetCalendarFeed.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if err != nil {
+				return
+			}
+
+			feed := string(got)
+			if !strings.HasPrefix(feed, "BEGIN:VCALENDAR\r\n") ||
+				!strings.HasSuffix(feed, "END:VCALENDAR\r\n") {
+				t.Errorf("GetCalendarFeed.Execute() = %q, want a calendar", feed)
+			}
+
+			// Unfold the lines before looking for the events.
+			feed = strings.ReplaceAll(feed, "\r\n ", "")
+
+			count := strings.Count(feed, "BEGIN:VEVENT")
+			if count != len(tt.wantSummaries) {
+				t.Errorf(
+					"GetCalendarFeed.Execute() events = %v, want %v",
+					count,
+					len(tt.wantSummaries),
+				)
+			}
+
+			for _, summary := range tt.wantSummaries {
+				if !strings.Contains(feed, summary+"\r\n") {
+					t.Errorf(
+						"GetCalendarFeed.Execute() = %q, want to contain %q",
+						feed,
+						summary,
+					)
+				}
+			}
+
+			if !strings.Contains(feed, "DTSTART:20261020T180000Z\r\n") {
+				t.Errorf(
+					"GetCalendarFeed.Execute() = %q, want the due date as start",
+					feed,
+				)
+			}
+		})
+	}
+}
+
+func TestICalWriter_writeLine(t *testing.T) {
+	w := &icalWriter{}
+	w.writeLine("SUMMARY", strings.Repeat("é", 100))
+
+	lines := strings.Split(strings.TrimSuffix(string(w.bytes()), "\r\n"), "\r\n")
+	if len(lines) < 2 {
+		t.Fatalf("icalWriter.writeLine() = %q, want folded lines", lines)
+	}
+
+	var unfolded string
+	for i, line := range lines {
+		if len(line) > icalMaxLineLength {
+			t.Errorf(
+				"icalWriter.writeLine() line %d has %d octets, want at most %d",
+				i,
+				len(line),
+				icalMaxLineLength,
+			)
+		}
+		if i > 0 {
+			line = strings.TrimPrefix(line, " ")
+		}
+		unfolded += line
+	}
+
+	if want := "SUMMARY:" + strings.Repeat("é", 100); unfolded != want {
+		t.Errorf("icalWriter.writeLine() unfolded = %q, want %q", unfolded, want)
+	}
+}
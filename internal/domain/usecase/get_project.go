@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type GetProject struct {
+	validator   validator.Validator
+	projectRepo repo.ProjectRepo
+}
+
+func NewGetProject(
+	validator validator.Validator,
+	projectRepo repo.ProjectRepo,
+) *GetProject {
+	return &GetProject{
+		validator:   validator,
+		projectRepo: projectRepo,
+	}
+}
+
+type GetProjectParams struct {
+	ID       string      `json:"id,omitempty"        validate:"required,uuid"`
+	UserRole entity.Role `json:"user_role,omitempty" validate:"required,min=1,max=2"`
+	UserID   string      `json:"user_id,omitempty"   validate:"required,uuid"`
+}
+
+// Execute returns the project if the user is one of its members.
+func (g *GetProject) Execute(
+	ctx context.Context,
+	params GetProjectParams,
+) (entity.Project, error) {
+	if params.UserRole != entity.RoleManager {
+		return entity.Project{}, entity.ErrUserNotAllowedToManageProjects
+	}
+
+	if err := g.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return entity.Project{}, validationErr
+	}
+
+	return ensureProjectMember(ctx, g.projectRepo, params.ID, params.UserID)
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
@@ -10,20 +11,29 @@ import (
 )
 
 type GetTaskByID struct {
-	validator validator.Validator
-	symCrypto symcrypt.SymmetricalEncrypter
-	taskRepo  repo.TaskRepo
+	validator     validator.Validator
+	symCrypto     symcrypt.SymmetricalEncrypter
+	taskRepo      repo.TaskRepo
+	checklistRepo repo.ChecklistRepo
+	labelRepo     repo.LabelRepo
+	projectRepo   repo.ProjectRepo
 }
 
 func NewGetTaskByID(
 	validator validator.Validator,
 	symCrypto symcrypt.SymmetricalEncrypter,
 	taskRepo repo.TaskRepo,
+	checklistRepo repo.ChecklistRepo,
+	labelRepo repo.LabelRepo,
+	projectRepo repo.ProjectRepo,
 ) *GetTaskByID {
 	return &GetTaskByID{
-		validator: validator,
-		symCrypto: symCrypto,
-		taskRepo:  taskRepo,
+		validator:     validator,
+		symCrypto:     symCrypto,
+		taskRepo:      taskRepo,
+		checklistRepo: checklistRepo,
+		labelRepo:     labelRepo,
+		projectRepo:   projectRepo,
 	}
 }
 
@@ -52,22 +62,32 @@ func (u *GetTaskByID) Execute(
 		return entity.Task{}, entity.ErrTaskNotFound
 	}
 
-	if params.UserRole == entity.RoleTechnician {
-		if task.AssignedToUserID == nil {
-			return entity.Task{}, entity.ErrUserNotAllowedToViewTask
-		}
-
-		if *task.AssignedToUserID != params.UserID {
-			return entity.Task{}, entity.ErrUserNotAllowedToViewTask
-		}
+	if err := ensureTaskVisible(
+		ctx,
+		u.projectRepo,
+		task,
+		params.UserID,
+		params.UserRole,
+		entity.ErrUserNotAllowedToViewTask,
+	); err != nil {
+		return entity.Task{}, err
 	}
 
-	decryptedSummary, err := u.symCrypto.Decrypt(task.Summary)
+	task, err = decryptTask(u.symCrypto, task)
 	if err != nil {
 		return entity.Task{}, entity.NewErr(err)
 	}
 
-	task.Summary = decryptedSummary
+	task.Overdue = task.IsOverdue(time.Now())
+
+	tasks := []entity.Task{task}
+	if err := setChecklistProgress(ctx, u.checklistRepo, tasks); err != nil {
+		return entity.Task{}, entity.NewErr(err)
+	}
+
+	if err := setTaskLabels(ctx, u.taskRepo, u.labelRepo, tasks); err != nil {
+		return entity.Task{}, entity.NewErr(err)
+	}
 
-	return task, nil
+	return tasks[0], nil
 }
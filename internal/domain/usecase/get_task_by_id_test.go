@@ -34,6 +34,7 @@ func TestGetTaskByID_Execute(t *testing.T) {
 		ID:               uuid.NewString(),
 		Summary:          encryptedSummary,
 		AssignedToUserID: &technicianUserID,
+		AssigneeIDs:      []string{technicianUserID},
 		CreatedByUserID:  managerUserID,
 		CreatedAt:        time.Now(),
 		UpdatedAt:        time.Now(),
@@ -46,13 +47,39 @@ func TestGetTaskByID_Execute(t *testing.T) {
 		UpdatedAt:       time.Now(),
 	}
 
+	crewLeaderUserID := uuid.NewString()
+	crewTask := entity.Task{
+		ID:               uuid.NewString(),
+		Summary:          encryptedSummary,
+		AssignedToUserID: &crewLeaderUserID,
+		AssigneeIDs:      []string{crewLeaderUserID, technicianUserID},
+		CreatedByUserID:  managerUserID,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+	decryptedCrewTask := crewTask
+	decryptedCrewTask.Summary = summary
+
 	getDecryptedTask := func() entity.Task {
 		t := task
 		t.Summary = summary
+		t.ChecklistProgress = "1/2"
 		return t
 	}
 
-	taskRepo.Tasks = append(taskRepo.Tasks, task, taskWithoutAssignedUser)
+	taskRepo.Tasks = append(
+		taskRepo.Tasks,
+		task,
+		taskWithoutAssignedUser,
+		crewTask,
+	)
+
+	checklistRepo := inmemoryrepo.NewInMemoryChecklistRepo()
+	checklistRepo.Items = append(
+		checklistRepo.Items,
+		entity.ChecklistItem{ID: uuid.NewString(), TaskID: task.ID, Done: true},
+		entity.ChecklistItem{ID: uuid.NewString(), TaskID: task.ID},
+	)
 
 	type fields struct {
 		validator validator.Validator
@@ -103,6 +130,23 @@ func TestGetTaskByID_Execute(t *testing.T) {
 			want:    getDecryptedTask(),
 			wantErr: nil,
 		},
+		{
+			name: "should return the task to any of its assignees",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: GetTaskByIDParams{
+					ID:       crewTask.ID,
+					UserID:   technicianUserID,
+					UserRole: entity.RoleTechnician,
+				},
+			},
+			want:    decryptedCrewTask,
+			wantErr: nil,
+		},
 		{
 			name: "should not return task if id is invalid",
 			fields: fields{
@@ -213,6 +257,9 @@ func TestGetTaskByID_Execute(t *testing.T) {
 				tt.fields.validator,
 				tt.fields.symCrypto,
 				tt.fields.taskRepo,
+				checklistRepo,
+				inmemoryrepo.NewInMemoryLabelRepo(),
+				inmemoryrepo.NewInMemoryProjectRepo(),
 			)
 			got, err := u.Execute(context.Background(), tt.args.params)
 			if !testutil.IsSameErr(err, tt.wantErr) {
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type GetTaskHistory struct {
+	validator     validator.Validator
+	symCrypto     symcrypt.SymmetricalEncrypter
+	taskRepo      repo.TaskRepo
+	projectRepo   repo.ProjectRepo
+	taskEventRepo repo.TaskEventRepo
+}
+
+func NewGetTaskHistory(
+	validator validator.Validator,
+	symCrypto symcrypt.SymmetricalEncrypter,
+	taskRepo repo.TaskRepo,
+	projectRepo repo.ProjectRepo,
+	taskEventRepo repo.TaskEventRepo,
+) *GetTaskHistory {
+	return &GetTaskHistory{
+		validator:     validator,
+		symCrypto:     symCrypto,
+		taskRepo:      taskRepo,
+		projectRepo:   projectRepo,
+		taskEventRepo: taskEventRepo,
+	}
+}
+
+type GetTaskHistoryParams struct {
+	ID       string      `json:"id,omitempty"        validate:"required,uuid"`
+	UserID   string      `json:"user_id,omitempty"   validate:"required,uuid"`
+	UserRole entity.Role `json:"user_role,omitempty" validate:"required,min=1,max=2"`
+}
+
+// Execute returns the task events, oldest first. Managers can also see
+// the history of deleted tasks, which ends with the deletion event.
+func (g *GetTaskHistory) Execute(
+	ctx context.Context,
+	params GetTaskHistoryParams,
+) ([]entity.TaskEvent, error) {
+	if err := g.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return nil, validationErr
+	}
+
+	task, err := g.taskRepo.GetTaskByID(ctx, params.ID)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	// Managers can also see the history of the tasks in the trash.
+	if task.ID == "" && params.UserRole == entity.RoleManager {
+		task, err = g.taskRepo.GetDeletedTaskByID(ctx, params.ID)
+		if err != nil {
+			return nil, entity.NewErr(err)
+		}
+	}
+
+	if task.ID == "" {
+		return nil, entity.ErrTaskNotFound
+	}
+
+	if err := ensureTaskVisible(
+		ctx,
+		g.projectRepo,
+		task,
+		params.UserID,
+		params.UserRole,
+		entity.ErrUserNotAllowedToViewTask,
+	); err != nil {
+		return nil, err
+	}
+
+	events, err := g.taskEventRepo.ListTaskEvents(ctx, params.ID)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	for i, event := range events {
+		events[i], err = decryptTaskEvent(g.symCrypto, event)
+		if err != nil {
+			return nil, entity.NewErr(err)
+		}
+	}
+
+	return events, nil
+}
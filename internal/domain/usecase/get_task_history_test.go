@@ -0,0 +1,269 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestGetTaskHistory_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	managerID := uuid.NewString()
+	technicianID := uuid.NewString()
+
+	beforeSummary := "Loren ipsum dolor sit amet"
+	afterSummary := "Consectetur adipiscing elit"
+
+	encryptedBeforeSummary, err := symCrypto.Encrypt(beforeSummary)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encryptedAfterSummary, err := symCrypto.Encrypt(afterSummary)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	task := entity.Task{
+		ID:               uuid.NewString(),
+		Summary:          encryptedAfterSummary,
+		Status:           entity.TaskStatusOpen,
+		AssignedToUserID: &technicianID,
+		CreatedByUserID:  managerID,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+	deletedAt := time.Now()
+	deletedTask := task
+	deletedTask.ID = uuid.NewString()
+	deletedTask.DeletedAt = &deletedAt
+	deletedTaskID := deletedTask.ID
+
+	project := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Maintenance",
+		ManagerUserID: uuid.NewString(),
+	}
+	projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+	projectRepo.Projects = append(projectRepo.Projects, project)
+
+	projectTask := task
+	projectTask.ID = uuid.NewString()
+	projectTask.ProjectID = &project.ID
+
+	deletedProjectTask := deletedTask
+	deletedProjectTask.ID = uuid.NewString()
+	deletedProjectTask.ProjectID = &project.ID
+
+	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+	taskRepo.Tasks = append(
+		taskRepo.Tasks,
+		task,
+		deletedTask,
+		projectTask,
+		deletedProjectTask,
+	)
+
+	taskEventRepo := inmemoryrepo.NewInMemoryTaskEventRepo()
+	taskEventRepo.Events = append(
+		taskEventRepo.Events,
+		entity.TaskEvent{
+			ID:          uuid.NewString(),
+			TaskID:      task.ID,
+			ActorUserID: managerID,
+			Type:        entity.TaskEventCreated,
+			Changes: entity.DiffTasks(entity.Task{}, entity.Task{
+				Summary: encryptedBeforeSummary,
+				Status:  entity.TaskStatusOpen,
+			}),
+		},
+		entity.TaskEvent{
+			ID:          uuid.NewString(),
+			TaskID:      task.ID,
+			ActorUserID: technicianID,
+			Type:        entity.TaskEventUpdated,
+			Changes: entity.DiffTasks(
+				entity.Task{Summary: encryptedBeforeSummary},
+				entity.Task{Summary: encryptedAfterSummary},
+			),
+		},
+		entity.TaskEvent{
+			ID:          uuid.NewString(),
+			TaskID:      deletedTaskID,
+			ActorUserID: managerID,
+			Type:        entity.TaskEventDeleted,
+			Changes: entity.DiffTasks(
+				entity.Task{Summary: encryptedBeforeSummary},
+				entity.Task{},
+			),
+		},
+	)
+
+	type args struct {
+		params GetTaskHistoryParams
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantEvents  int
+		wantSummary string
+		wantErr     error
+	}{
+		{
+			name: "should return history with decrypted summaries to the assignee",
+			args: args{
+				params: GetTaskHistoryParams{
+					ID:       task.ID,
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+				},
+			},
+			wantEvents:  2,
+			wantSummary: afterSummary,
+			wantErr:     nil,
+		},
+		{
+			name: "should return history to managers",
+			args: args{
+				params: GetTaskHistoryParams{
+					ID:       task.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+				},
+			},
+			wantEvents:  2,
+			wantSummary: afterSummary,
+			wantErr:     nil,
+		},
+		{
+			name: "should return history of deleted task to managers",
+			args: args{
+				params: GetTaskHistoryParams{
+					ID:       deletedTaskID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+				},
+			},
+			wantEvents: 1,
+			wantErr:    nil,
+		},
+		{
+			name: "should not return history of deleted task to technicians",
+			args: args{
+				params: GetTaskHistoryParams{
+					ID:       deletedTaskID,
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+				},
+			},
+			wantErr: entity.ErrTaskNotFound,
+		},
+		{
+			name: "should not return history if user is not the assignee",
+			args: args{
+				params: GetTaskHistoryParams{
+					ID:       task.ID,
+					UserID:   uuid.NewString(),
+					UserRole: entity.RoleTechnician,
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToViewTask,
+		},
+		{
+			name: "should not return history if task does not exists",
+			args: args{
+				params: GetTaskHistoryParams{
+					ID:       uuid.NewString(),
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+				},
+			},
+			wantErr: entity.ErrTaskNotFound,
+		},
+		{
+			name: "should not return history if is a invalid task id",
+			args: args{
+				params: GetTaskHistoryParams{
+					ID:       "invalid-task-id",
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+		{
+			name: "should not return history of a project task if user is not a project member",
+			args: args{
+				params: GetTaskHistoryParams{
+					ID:       projectTask.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+				},
+			},
+			wantErr: entity.ErrUserNotProjectMember,
+		},
+		{
+			name: "should not return history of a deleted project task if user is not a project member",
+			args: args{
+				params: GetTaskHistoryParams{
+					ID:       deletedProjectTask.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+				},
+			},
+			wantErr: entity.ErrUserNotProjectMember,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			g := NewGetTaskHistory(val, symCrypto, taskRepo, projectRepo, taskEventRepo)
+
+			events, err := g.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"GetTaskHistory.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if len(events) != tt.wantEvents {
+				t.Fatalf(
+					"GetTaskHistory.Execute() events = %v, want %v",
+					len(events),
+					tt.wantEvents,
+				)
+			}
+
+			if tt.wantSummary == "" {
+				return
+			}
+
+			change := events[len(events)-1].Changes[0]
+			if change.Field != entity.TaskFieldSummary ||
+				*change.From != beforeSummary ||
+				*change.To != tt.wantSummary {
+				t.Errorf(
+					"GetTaskHistory.Execute() summary change = %v -> %v, want %v -> %v",
+					*change.From,
+					*change.To,
+					beforeSummary,
+					tt.wantSummary,
+				)
+			}
+		})
+	}
+}
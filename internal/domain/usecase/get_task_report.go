@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+// taskReportColumns are the header of the CSV reports, in the same order
+// as the fields of each row.
+var taskReportColumns = []string{
+	"bucket_start",
+	"user_id",
+	"created_count",
+	"finished_count",
+	"median_finish_seconds",
+	"p90_finish_seconds",
+}
+
+type GetTaskReport struct {
+	validator   validator.Validator
+	reportRepo  repo.ReportRepo
+	projectRepo repo.ProjectRepo
+}
+
+func NewGetTaskReport(
+	validator validator.Validator,
+	reportRepo repo.ReportRepo,
+	projectRepo repo.ProjectRepo,
+) *GetTaskReport {
+	return &GetTaskReport{
+		validator:   validator,
+		reportRepo:  reportRepo,
+		projectRepo: projectRepo,
+	}
+}
+
+// GetTaskReportParams reports on the tasks created or finished from From
+// until before To.
+type GetTaskReportParams struct {
+	UserID   string               `json:"user_id,omitempty"   validate:"required,uuid"`
+	UserRole entity.Role          `json:"user_role,omitempty" validate:"required,min=1,max=2"`
+	GroupBy  entity.ReportGroupBy `json:"group_by,omitempty"  validate:"required,oneof=technician creator"`
+	Bucket   entity.ReportBucket  `json:"bucket,omitempty"    validate:"required,oneof=day week month"`
+	From     time.Time            `json:"from,omitempty"      validate:"required"`
+	To       time.Time            `json:"to,omitempty"        validate:"required,gtfield=From"`
+}
+
+// Execute returns how many tasks each technician or creator had created
+// and finished in each bucket of the period, along with how long the
+// finished ones took. Managers only see the tasks without a project and
+// the ones of the projects they are members of.
+func (g *GetTaskReport) Execute(
+	ctx context.Context,
+	params GetTaskReportParams,
+) (entity.TaskReport, error) {
+	if err := g.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return entity.TaskReport{}, validationErr
+	}
+
+	if params.UserRole != entity.RoleManager {
+		return entity.TaskReport{}, entity.ErrUserNotAllowedToViewReports
+	}
+
+	projects, err := g.projectRepo.ListProjectsByMemberUserID(
+		ctx,
+		params.UserID,
+	)
+	if err != nil {
+		return entity.TaskReport{}, entity.NewErr(err)
+	}
+
+	repoParams := repo.TaskReportParams{
+		GroupBy:         params.GroupBy,
+		Bucket:          params.Bucket,
+		From:            params.From,
+		To:              params.To,
+		ProjectScoped:   true,
+		ScopeProjectIDs: make([]string, len(projects)),
+	}
+	for i, project := range projects {
+		repoParams.ScopeProjectIDs[i] = project.ID
+	}
+
+	rows, err := g.reportRepo.GetTaskReport(ctx, repoParams)
+	if err != nil {
+		return entity.TaskReport{}, entity.NewErr(err)
+	}
+
+	return entity.TaskReport{
+		GroupBy: params.GroupBy,
+		Bucket:  params.Bucket,
+		From:    params.From,
+		To:      params.To,
+		Rows:    rows,
+	}, nil
+}
+
+// WriteTaskReportCSV writes the report rows to w as CSV, with a header.
+// Buckets are written as dates, and percentiles are left empty when no
+// task was finished in the bucket.
+func WriteTaskReportCSV(w io.Writer, report entity.TaskReport) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(taskReportColumns); err != nil {
+		return entity.NewErr(err)
+	}
+
+	for _, row := range report.Rows {
+		if err := cw.Write([]string{
+			row.BucketStart.Format(time.DateOnly),
+			row.UserID,
+			strconv.Itoa(row.CreatedCount),
+			strconv.Itoa(row.FinishedCount),
+			formatOptionalSeconds(row.MedianFinishSeconds),
+			formatOptionalSeconds(row.P90FinishSeconds),
+		}); err != nil {
+			return entity.NewErr(err)
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func formatOptionalSeconds(seconds *int64) string {
+	if seconds == nil {
+		return ""
+	}
+
+	return strconv.FormatInt(*seconds, 10)
+}
@@ -0,0 +1,298 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestGetTaskReport_Execute(t *testing.T) {
+	val := validator.NewValidate()
+
+	managerID := "00000000-0000-4000-8000-000000000001"
+	otherManagerID := "00000000-0000-4000-8000-000000000002"
+	technicianID := "00000000-0000-4000-8000-000000000003"
+	otherTechnicianID := "00000000-0000-4000-8000-000000000004"
+	otherProjectID := uuid.NewString()
+
+	monday := time.Date(2026, time.October, 5, 0, 0, 0, 0, time.UTC)
+	at := func(days int, hours int) time.Time {
+		return monday.AddDate(0, 0, days).Add(time.Duration(hours) * time.Hour)
+	}
+	newTask := func(
+		createdBy string,
+		assigneeIDs []string,
+		createdAt time.Time,
+		finishedAt *time.Time,
+	) entity.Task {
+		status := entity.TaskStatusOpen
+		if finishedAt != nil {
+			status = entity.TaskStatusDone
+		}
+
+		return entity.Task{
+			ID:              uuid.NewString(),
+			Summary:         "Loren ipsum dolor sit amet",
+			Status:          status,
+			AssigneeIDs:     assigneeIDs,
+			CreatedByUserID: createdBy,
+			CreatedAt:       createdAt,
+			UpdatedAt:       createdAt,
+			FinishedAt:      finishedAt,
+		}
+	}
+	ptr := func(value time.Time) *time.Time { return &value }
+
+	deletedTask := newTask(
+		managerID,
+		[]string{technicianID},
+		at(0, 8),
+		ptr(at(0, 9)),
+	)
+	deletedTask.DeletedAt = ptr(at(1, 0))
+
+	otherProjectTask := newTask(
+		managerID,
+		[]string{technicianID},
+		at(0, 8),
+		ptr(at(0, 9)),
+	)
+	otherProjectTask.ProjectID = &otherProjectID
+
+	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+	taskRepo.Tasks = append(
+		taskRepo.Tasks,
+		newTask(managerID, []string{technicianID}, at(0, 8), ptr(at(0, 9))),
+		newTask(
+			managerID,
+			[]string{technicianID, otherTechnicianID},
+			at(0, 10),
+			ptr(at(1, 10)),
+		),
+		newTask(otherManagerID, []string{technicianID}, at(1, 8), ptr(at(1, 12))),
+		newTask(otherManagerID, []string{otherTechnicianID}, at(1, 9), nil),
+		// Created before the period and finished in it.
+		newTask(managerID, []string{technicianID}, at(-4, 8), ptr(at(2, 8))),
+		deletedTask,
+		otherProjectTask,
+	)
+
+	seconds := func(value int64) *int64 { return &value }
+
+	type args struct {
+		params GetTaskReportParams
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []entity.TaskReportRow
+		wantErr error
+	}{
+		{
+			name: "should report by technician and day",
+			args: args{
+				params: GetTaskReportParams{
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					GroupBy:  entity.ReportGroupByTechnician,
+					Bucket:   entity.ReportBucketDay,
+					From:     monday,
+					To:       monday.AddDate(0, 0, 7),
+				},
+			},
+			want: []entity.TaskReportRow{
+				{
+					BucketStart:         at(0, 0),
+					UserID:              technicianID,
+					CreatedCount:        2,
+					FinishedCount:       1,
+					MedianFinishSeconds: seconds(60 * 60),
+					P90FinishSeconds:    seconds(60 * 60),
+				},
+				{
+					BucketStart:  at(0, 0),
+					UserID:       otherTechnicianID,
+					CreatedCount: 1,
+				},
+				{
+					BucketStart:         at(1, 0),
+					UserID:              technicianID,
+					CreatedCount:        1,
+					FinishedCount:       2,
+					MedianFinishSeconds: seconds(4 * 60 * 60),
+					P90FinishSeconds:    seconds(24 * 60 * 60),
+				},
+				{
+					BucketStart:         at(1, 0),
+					UserID:              otherTechnicianID,
+					CreatedCount:        1,
+					FinishedCount:       1,
+					MedianFinishSeconds: seconds(24 * 60 * 60),
+					P90FinishSeconds:    seconds(24 * 60 * 60),
+				},
+				{
+					BucketStart:         at(2, 0),
+					UserID:              technicianID,
+					FinishedCount:       1,
+					MedianFinishSeconds: seconds(6 * 24 * 60 * 60),
+					P90FinishSeconds:    seconds(6 * 24 * 60 * 60),
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should report by creator and week",
+			args: args{
+				params: GetTaskReportParams{
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					GroupBy:  entity.ReportGroupByCreator,
+					Bucket:   entity.ReportBucketWeek,
+					From:     monday,
+					To:       monday.AddDate(0, 0, 7),
+				},
+			},
+			want: []entity.TaskReportRow{
+				{
+					BucketStart:         monday,
+					UserID:              managerID,
+					CreatedCount:        2,
+					FinishedCount:       3,
+					MedianFinishSeconds: seconds(24 * 60 * 60),
+					P90FinishSeconds:    seconds(6 * 24 * 60 * 60),
+				},
+				{
+					BucketStart:         monday,
+					UserID:              otherManagerID,
+					CreatedCount:        2,
+					FinishedCount:       1,
+					MedianFinishSeconds: seconds(4 * 60 * 60),
+					P90FinishSeconds:    seconds(4 * 60 * 60),
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should report by month from the first day of the month",
+			args: args{
+				params: GetTaskReportParams{
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					GroupBy:  entity.ReportGroupByCreator,
+					Bucket:   entity.ReportBucketMonth,
+					From:     at(1, 0),
+					To:       at(1, 9),
+				},
+			},
+			want: []entity.TaskReportRow{
+				{
+					BucketStart:  time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC),
+					UserID:       otherManagerID,
+					CreatedCount: 1,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should not report if user is not a manager",
+			args: args{
+				params: GetTaskReportParams{
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+					GroupBy:  entity.ReportGroupByTechnician,
+					Bucket:   entity.ReportBucketDay,
+					From:     monday,
+					To:       monday.AddDate(0, 0, 7),
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToViewReports,
+		},
+		{
+			name: "should not report on an unknown bucket",
+			args: args{
+				params: GetTaskReportParams{
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					GroupBy:  entity.ReportGroupByTechnician,
+					Bucket:   "year",
+					From:     monday,
+					To:       monday.AddDate(0, 0, 7),
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			g := NewGetTaskReport(
+				val,
+				inmemoryrepo.NewInMemoryReportRepo(taskRepo),
+				inmemoryrepo.NewInMemoryProjectRepo(),
+			)
+
+			got, err := g.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"GetTaskReport.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(got.Rows, tt.want) {
+				t.Errorf(
+					"GetTaskReport.Execute() = %+v, want %+v",
+					got.Rows,
+					tt.want,
+				)
+			}
+		})
+	}
+}
+
+func TestWriteTaskReportCSV(t *testing.T) {
+	seconds := int64(3600)
+	report := entity.TaskReport{
+		Rows: []entity.TaskReportRow{
+			{
+				BucketStart:         time.Date(2026, time.October, 5, 0, 0, 0, 0, time.UTC),
+				UserID:              "00000000-0000-4000-8000-000000000001",
+				CreatedCount:        2,
+				FinishedCount:       1,
+				MedianFinishSeconds: &seconds,
+				P90FinishSeconds:    &seconds,
+			},
+			{
+				BucketStart:  time.Date(2026, time.October, 6, 0, 0, 0, 0, time.UTC),
+				UserID:       "00000000-0000-4000-8000-000000000002",
+				CreatedCount: 1,
+			},
+		},
+	}
+
+	var sb strings.Builder
+	if err := WriteTaskReportCSV(&sb, report); err != nil {
+		t.Fatalf("WriteTaskReportCSV() error = %v", err)
+	}
+
+	want := "bucket_start,user_id,created_count,finished_count,median_finish_seconds,p90_finish_seconds\n" +
+		"2026-10-05,00000000-0000-4000-8000-000000000001,2,1,3600,3600\n" +
+		"2026-10-06,00000000-0000-4000-8000-000000000002,1,0,,\n"
+	if sb.String() != want {
+		t.Errorf("WriteTaskReportCSV() = %q, want %q", sb.String(), want)
+	}
+}
@@ -0,0 +1,294 @@
+package usecase
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+)
+
+// maxImportLineSize is the longest NDJSON line accepted on import.
+const maxImportLineSize = 64 * 1024
+
+type ImportTasks struct {
+	validator         validator.Validator
+	createTaskUseCase *CreateTask
+}
+
+func NewImportTasks(
+	validator validator.Validator,
+	createTaskUseCase *CreateTask,
+) *ImportTasks {
+	return &ImportTasks{
+		validator:         validator,
+		createTaskUseCase: createTaskUseCase,
+	}
+}
+
+type ImportTasksParams struct {
+	UserRole entity.Role    `json:"user_role,omitempty" validate:"required,min=1,max=2"`
+	UserID   string         `json:"user_id,omitempty"   validate:"required,uuid"`
+	Format   TaskFileFormat `json:"format,omitempty"    validate:"required,oneof=csv ndjson"`
+}
+
+// ImportedTask is a row of an import file. Other columns, such as the
+// ones written by the export, are ignored.
+type ImportedTask struct {
+	Summary          string                  `json:"summary"             validate:"required,max=2500"`
+	AssignedToUserID string                  `json:"assigned_to_user_id" validate:"omitempty,uuid"`
+	LabelIDs         []string                `json:"label_ids"           validate:"omitempty,dive,uuid"`
+	DueAt            *time.Time              `json:"due_at"`
+	Priority         entity.TaskPriority     `json:"priority"            validate:"omitempty,oneof=low normal high critical"`
+	AssigneeIDs      []string                `json:"assignee_ids"        validate:"omitempty,dive,uuid"`
+	FinishPolicy     entity.TaskFinishPolicy `json:"finish_policy"       validate:"omitempty,oneof=any all"`
+}
+
+// ImportTaskLineError is the error of a line of the import file, lines
+// are counted from 1 and include the CSV header. Err wraps an
+// *entity.Err.
+type ImportTaskLineError struct {
+	Line int   `json:"line"`
+	Err  error `json:"-"`
+}
+
+type ImportTasksResult struct {
+	Imported int                   `json:"imported"`
+	Failed   int                   `json:"failed"`
+	Errors   []ImportTaskLineError `json:"errors"`
+}
+
+// Execute creates a task for every line read from r, following the
+// same rules as CreateTask. A line that fails does not stop the import,
+// its error is reported with the line number instead.
+func (i *ImportTasks) Execute(
+	ctx context.Context,
+	params ImportTasksParams,
+	r io.Reader,
+) (ImportTasksResult, error) {
+	if err := i.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return ImportTasksResult{}, validationErr
+	}
+
+	result := ImportTasksResult{}
+
+	importLine := func(line int, task ImportedTask, err error) {
+		if err == nil {
+			err = i.importTask(ctx, params, task)
+		}
+
+		if err != nil {
+			result.Failed++
+			result.Errors = append(result.Errors, ImportTaskLineError{
+				Line: line,
+				Err:  snapshotErr(err),
+			})
+			return
+		}
+
+		result.Imported++
+	}
+
+	var err error
+	switch params.Format {
+	case TaskFileFormatCSV:
+		err = readCSVTasks(r, importLine)
+	case TaskFileFormatNDJSON:
+		err = readNDJSONTasks(r, importLine)
+	}
+	if err != nil {
+		return ImportTasksResult{}, err
+	}
+
+	return result, nil
+}
+
+func (i *ImportTasks) importTask(
+	ctx context.Context,
+	params ImportTasksParams,
+	task ImportedTask,
+) error {
+	if err := i.validator.Validate(task); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return validationErr
+	}
+
+	_, err := i.createTaskUseCase.Execute(ctx, CreateTaskParams{
+		UserRole:         params.UserRole,
+		Summary:          task.Summary,
+		CreatedByUserID:  params.UserID,
+		AssignedToUserID: task.AssignedToUserID,
+		AssigneeIDs:      task.AssigneeIDs,
+		LabelIDs:         task.LabelIDs,
+		DueAt:            task.DueAt,
+		Priority:         task.Priority,
+		FinishPolicy:     task.FinishPolicy,
+	})
+	return err
+}
+
+// readCSVTasks calls fn for every row after the header, which must
+// have a summary column. Malformed lines are passed to fn with their
+// error and skipped, while reading stops at the first error of the
+// reader itself, which is passed to fn for the line after the last one
+// read.
+func readCSVTasks(
+	r io.Reader,
+	fn func(line int, task ImportedTask, err error),
+) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		validationErr := entity.ErrInvalidImportFile
+		validationErr.Message = err.Error()
+		return validationErr
+	}
+
+	column := func(name string) int {
+		return slices.IndexFunc(header, func(h string) bool {
+			return strings.EqualFold(strings.TrimSpace(h), name)
+		})
+	}
+
+	summaryColumn := column("summary")
+	assignedToUserIDColumn := column("assigned_to_user_id")
+	labelIDsColumn := column("label_ids")
+	dueAtColumn := column("due_at")
+	priorityColumn := column("priority")
+	assigneeIDsColumn := column("assignee_ids")
+	finishPolicyColumn := column("finish_policy")
+
+	if summaryColumn == -1 {
+		validationErr := entity.ErrInvalidImportFile
+		validationErr.Message = "header must have a summary column"
+		return validationErr
+	}
+
+	line, _ := reader.FieldPos(0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			validationErr := entity.ErrValidation
+			validationErr.Message = parseErr.Err.Error()
+			line = parseErr.Line
+			fn(line, ImportedTask{}, validationErr)
+			continue
+		}
+		if err != nil {
+			fn(line+1, ImportedTask{}, err)
+			return nil
+		}
+
+		line, _ = reader.FieldPos(0)
+
+		field := func(column int) string {
+			if column == -1 || column >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[column])
+		}
+
+		task := ImportedTask{
+			Summary:          field(summaryColumn),
+			AssignedToUserID: field(assignedToUserIDColumn),
+			Priority:         entity.TaskPriority(field(priorityColumn)),
+			FinishPolicy:     entity.TaskFinishPolicy(field(finishPolicyColumn)),
+		}
+
+		if dueAt := field(dueAtColumn); dueAt != "" {
+			parsedDueAt, err := time.Parse(time.RFC3339, dueAt)
+			if err != nil {
+				validationErr := entity.ErrValidation
+				validationErr.Message = "due_at must be a date in RFC 3339 format"
+				fn(line, ImportedTask{}, validationErr)
+				continue
+			}
+			task.DueAt = &parsedDueAt
+		}
+
+		if labelIDs := field(labelIDsColumn); labelIDs != "" {
+			for _, labelID := range strings.Split(labelIDs, csvIDsSeparator) {
+				task.LabelIDs = append(task.LabelIDs, strings.TrimSpace(labelID))
+			}
+		}
+
+		if assigneeIDs := field(assigneeIDsColumn); assigneeIDs != "" {
+			for _, assigneeID := range strings.Split(assigneeIDs, csvIDsSeparator) {
+				task.AssigneeIDs = append(
+					task.AssigneeIDs,
+					strings.TrimSpace(assigneeID),
+				)
+			}
+		}
+
+		fn(line, task, nil)
+	}
+}
+
+// readNDJSONTasks calls fn for every non blank line, which must hold
+// a JSON object. Reading stops at the first line that cannot be read,
+// which is passed to fn with its error.
+func readNDJSONTasks(
+	r io.Reader,
+	fn func(line int, task ImportedTask, err error),
+) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxImportLineSize)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		lineBytes := bytes.TrimSpace(scanner.Bytes())
+		if len(lineBytes) == 0 {
+			continue
+		}
+
+		task := ImportedTask{}
+		if err := json.Unmarshal(lineBytes, &task); err != nil {
+			validationErr := entity.ErrValidation
+			validationErr.Message = fmt.Sprintf("invalid JSON: %s", err)
+			fn(line, ImportedTask{}, validationErr)
+			continue
+		}
+
+		fn(line, task, nil)
+	}
+
+	if err := scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			validationErr := entity.ErrValidation
+			validationErr.Message = fmt.Sprintf(
+				"line is longer than %d bytes",
+				maxImportLineSize,
+			)
+			err = validationErr
+		}
+		fn(line+1, ImportedTask{}, err)
+	}
+
+	return nil
+}
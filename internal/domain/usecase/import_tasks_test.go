@@ -0,0 +1,226 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestImportTasks_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	managerUser := entity.User{
+		ID:   uuid.NewString(),
+		Role: entity.RoleManager,
+	}
+
+	technicianUser := entity.User{
+		ID:   uuid.NewString(),
+		Role: entity.RoleTechnician,
+	}
+
+	type args struct {
+		params ImportTasksParams
+		file   string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantImported int
+		wantErrLines []int
+		wantLineErrs []error
+		wantErr      error
+	}{
+		{
+			name: "should import tasks from CSV",
+			args: args{
+				params: ImportTasksParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerUser.ID,
+					Format:   TaskFileFormatCSV,
+				},
+				file: "id,summary,assigned_to_user_id\n" +
+					"1,\"Loren ipsum,\ndolor sit amet\"," + technicianUser.ID + "\n" +
+					"2,Loren ipsum,\n",
+			},
+			wantImported: 2,
+			wantErr:      nil,
+		},
+		{
+			name: "should report the errors of each CSV line",
+			args: args{
+				params: ImportTasksParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerUser.ID,
+					Format:   TaskFileFormatCSV,
+				},
+				file: "summary,assigned_to_user_id\n" +
+					"Loren ipsum," + uuid.NewString() + "\n" +
+					"Loren ipsum,invalid\n" +
+					",\n" +
+					"Loren ipsum," + managerUser.ID + "\n" +
+					"Loren ipsum," + technicianUser.ID + "\n",
+			},
+			wantImported: 1,
+			wantErrLines: []int{2, 3, 4, 5},
+			wantLineErrs: []error{
+				entity.ErrAssignToUserNotFound,
+				entity.ErrValidation,
+				entity.ErrValidation,
+				entity.ErrInvalidRoleForAssignedUser,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should import the due date and priority of CSV lines",
+			args: args{
+				params: ImportTasksParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerUser.ID,
+					Format:   TaskFileFormatCSV,
+				},
+				file: "summary,due_at,priority\n" +
+					"Loren ipsum,2026-10-20T18:00:00Z,high\n" +
+					"Loren ipsum,,\n" +
+					"Loren ipsum,tomorrow,high\n" +
+					"Loren ipsum,,urgent\n",
+			},
+			wantImported: 2,
+			wantErrLines: []int{4, 5},
+			wantLineErrs: []error{
+				entity.ErrValidation,
+				entity.ErrValidation,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should import tasks from NDJSON",
+			args: args{
+				params: ImportTasksParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerUser.ID,
+					Format:   TaskFileFormatNDJSON,
+				},
+				file: `{"summary": "Loren ipsum", "status": "done"}` + "\n\n" +
+					`{"summary": "Dolor sit amet"` + "\n" +
+					`{"summary": "Dolor sit amet", "assigned_to_user_id": "` +
+					technicianUser.ID + `"}`,
+			},
+			wantImported: 2,
+			wantErrLines: []int{3},
+			wantLineErrs: []error{entity.ErrValidation},
+			wantErr:      nil,
+		},
+		{
+			name: "should not import tasks if user role is not manager",
+			args: args{
+				params: ImportTasksParams{
+					UserRole: entity.RoleTechnician,
+					UserID:   technicianUser.ID,
+					Format:   TaskFileFormatNDJSON,
+				},
+				file: `{"summary": "Loren ipsum"}`,
+			},
+			wantImported: 0,
+			wantErrLines: []int{1},
+			wantLineErrs: []error{entity.ErrUserNotAllowedToCreateTask},
+			wantErr:      nil,
+		},
+		{
+			name: "should not import a CSV file without a summary column",
+			args: args{
+				params: ImportTasksParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerUser.ID,
+					Format:   TaskFileFormatCSV,
+				},
+				file: "title\nLoren ipsum\n",
+			},
+			wantErr: entity.ErrInvalidImportFile,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userRepo := inmemoryrepo.NewInMemoryUserRepo()
+			userRepo.Users = append(userRepo.Users, managerUser, technicianUser)
+
+			taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+
+			i := NewImportTasks(
+				val,
+				NewCreateTask(
+					val,
+					symCrypto,
+					taskRepo,
+					userRepo,
+					inmemoryrepo.NewInMemoryLabelRepo(),
+					inmemoryrepo.NewInMemoryProjectRepo(),
+					inmemoryrepo.NewInMemoryTaskEventRepo(),
+					transactioner.NewNoopTransactioner(),
+				),
+			)
+
+			got, err := i.Execute(
+				context.Background(),
+				tt.args.params,
+				strings.NewReader(tt.args.file),
+			)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"ImportTasks.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.Imported != tt.wantImported ||
+				len(taskRepo.Tasks) != tt.wantImported {
+				t.Errorf(
+					"ImportTasks.Execute() imported = %v, want %v",
+					got.Imported,
+					tt.wantImported,
+				)
+			}
+
+			if got.Failed != len(tt.wantErrLines) ||
+				len(got.Errors) != len(tt.wantErrLines) {
+				t.Fatalf(
+					"ImportTasks.Execute() errors = %v, want lines %v",
+					got.Errors,
+					tt.wantErrLines,
+				)
+			}
+
+			for i, lineErr := range got.Errors {
+				if lineErr.Line != tt.wantErrLines[i] ||
+					!testutil.IsSameErr(lineErr.Err, tt.wantLineErrs[i]) {
+					t.Errorf(
+						"ImportTasks.Execute() errors[%d] = line %v %v, want line %v %v",
+						i,
+						lineErr.Line,
+						lineErr.Err,
+						tt.wantErrLines[i],
+						tt.wantLineErrs[i],
+					)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+// setTaskLabels fills the labels of the tasks.
+func setTaskLabels(
+	ctx context.Context,
+	taskRepo repo.TaskRepo,
+	labelRepo repo.LabelRepo,
+	tasks []entity.Task,
+) error {
+	taskIDs := make([]string, len(tasks))
+	for i, task := range tasks {
+		taskIDs[i] = task.ID
+	}
+
+	labelIDsByTaskID, err := taskRepo.ListTaskLabelIDs(ctx, taskIDs)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if len(labelIDsByTaskID) == 0 {
+		return nil
+	}
+
+	labels, err := labelRepo.ListLabels(ctx)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	for i, task := range tasks {
+		labelIDs := labelIDsByTaskID[task.ID]
+
+		// Labels are listed by name, so the task labels are too.
+		for _, label := range labels {
+			if slices.Contains(labelIDs, label.ID) {
+				tasks[i].Labels = append(tasks[i].Labels, label)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ensureLabelsExist returns the label IDs without duplicates, or
+// ErrLabelNotFound if any of them does not exist.
+func ensureLabelsExist(
+	ctx context.Context,
+	labelRepo repo.LabelRepo,
+	labelIDs []string,
+) ([]string, error) {
+	uniqueLabelIDs := slices.Clone(labelIDs)
+	slices.Sort(uniqueLabelIDs)
+	uniqueLabelIDs = slices.Compact(uniqueLabelIDs)
+
+	for _, labelID := range uniqueLabelIDs {
+		label, err := labelRepo.GetLabelByID(ctx, labelID)
+		if err != nil {
+			return nil, entity.NewErr(err)
+		}
+
+		if label.ID == "" {
+			return nil, entity.ErrLabelNotFound
+		}
+	}
+
+	return uniqueLabelIDs, nil
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type ListAttachments struct {
+	validator      validator.Validator
+	taskRepo       repo.TaskRepo
+	projectRepo    repo.ProjectRepo
+	attachmentRepo repo.AttachmentRepo
+}
+
+func NewListAttachments(
+	validator validator.Validator,
+	taskRepo repo.TaskRepo,
+	projectRepo repo.ProjectRepo,
+	attachmentRepo repo.AttachmentRepo,
+) *ListAttachments {
+	return &ListAttachments{
+		validator:      validator,
+		taskRepo:       taskRepo,
+		projectRepo:    projectRepo,
+		attachmentRepo: attachmentRepo,
+	}
+}
+
+type ListAttachmentsParams struct {
+	TaskID   string      `json:"task_id,omitempty"   validate:"required,uuid"`
+	UserID   string      `json:"user_id,omitempty"   validate:"required,uuid"`
+	UserRole entity.Role `json:"user_role,omitempty" validate:"required,min=1,max=2"`
+}
+
+// Execute returns the task attachments metadata, oldest first.
+func (l *ListAttachments) Execute(
+	ctx context.Context,
+	params ListAttachmentsParams,
+) ([]entity.Attachment, error) {
+	if err := l.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return nil, validationErr
+	}
+
+	task, err := l.taskRepo.GetTaskByID(ctx, params.TaskID)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	if task.ID == "" {
+		return nil, entity.ErrTaskNotFound
+	}
+
+	if err := ensureTaskVisible(
+		ctx,
+		l.projectRepo,
+		task,
+		params.UserID,
+		params.UserRole,
+		entity.ErrUserNotAllowedToViewTask,
+	); err != nil {
+		return nil, err
+	}
+
+	attachments, err := l.attachmentRepo.ListAttachments(ctx, task.ID)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return attachments, nil
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type ListChecklistItems struct {
+	validator     validator.Validator
+	symCrypto     symcrypt.SymmetricalEncrypter
+	taskRepo      repo.TaskRepo
+	projectRepo   repo.ProjectRepo
+	checklistRepo repo.ChecklistRepo
+}
+
+func NewListChecklistItems(
+	validator validator.Validator,
+	symCrypto symcrypt.SymmetricalEncrypter,
+	taskRepo repo.TaskRepo,
+	projectRepo repo.ProjectRepo,
+	checklistRepo repo.ChecklistRepo,
+) *ListChecklistItems {
+	return &ListChecklistItems{
+		validator:     validator,
+		symCrypto:     symCrypto,
+		taskRepo:      taskRepo,
+		projectRepo:   projectRepo,
+		checklistRepo: checklistRepo,
+	}
+}
+
+type ListChecklistItemsParams struct {
+	TaskID   string      `json:"task_id,omitempty"   validate:"required,uuid"`
+	UserID   string      `json:"user_id,omitempty"   validate:"required,uuid"`
+	UserRole entity.Role `json:"user_role,omitempty" validate:"required,min=1,max=2"`
+}
+
+type ListChecklistItemsResult struct {
+	Data     []entity.ChecklistItem   `json:"data"`
+	Progress entity.ChecklistProgress `json:"progress"`
+}
+
+// Execute returns the task checklist items ordered by position,
+// along with the checklist progress.
+func (l *ListChecklistItems) Execute(
+	ctx context.Context,
+	params ListChecklistItemsParams,
+) (ListChecklistItemsResult, error) {
+	if err := l.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return ListChecklistItemsResult{}, validationErr
+	}
+
+	task, err := l.taskRepo.GetTaskByID(ctx, params.TaskID)
+	if err != nil {
+		return ListChecklistItemsResult{}, entity.NewErr(err)
+	}
+
+	if task.ID == "" {
+		return ListChecklistItemsResult{}, entity.ErrTaskNotFound
+	}
+
+	if err := ensureTaskVisible(
+		ctx,
+		l.projectRepo,
+		task,
+		params.UserID,
+		params.UserRole,
+		entity.ErrUserNotAllowedToViewTask,
+	); err != nil {
+		return ListChecklistItemsResult{}, err
+	}
+
+	items, err := l.checklistRepo.ListChecklistItems(ctx, task.ID)
+	if err != nil {
+		return ListChecklistItemsResult{}, entity.NewErr(err)
+	}
+
+	result := ListChecklistItemsResult{
+		Data: items,
+		Progress: entity.ChecklistProgress{
+			Total: len(items),
+		},
+	}
+
+	for i, item := range items {
+		decryptedTitle, err := l.symCrypto.Decrypt(item.Title)
+		if err != nil {
+			return ListChecklistItemsResult{}, entity.NewErr(err)
+		}
+		items[i].Title = decryptedTitle
+
+		if item.Done {
+			result.Progress.Done++
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestListChecklistItems_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	managerID := uuid.NewString()
+	technicianID := uuid.NewString()
+
+	task := entity.Task{
+		ID:               uuid.NewString(),
+		Summary:          "Loren ipsum dolor sit amet",
+		Status:           entity.TaskStatusOpen,
+		AssignedToUserID: &technicianID,
+		CreatedByUserID:  managerID,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+
+	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+	taskRepo.Tasks = append(taskRepo.Tasks, task)
+
+	project := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Maintenance",
+		ManagerUserID: uuid.NewString(),
+	}
+	projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+	projectRepo.Projects = append(projectRepo.Projects, project)
+
+	projectTask := task
+	projectTask.ID = uuid.NewString()
+	projectTask.ProjectID = &project.ID
+	taskRepo.Tasks = append(taskRepo.Tasks, projectTask)
+
+	titles := []string{"Turn off the power", "Replace the filter", "Test"}
+	checklistRepo := inmemoryrepo.NewInMemoryChecklistRepo()
+	// Items are stored out of order, to check they are sorted by position.
+	for i := len(titles) - 1; i >= 0; i-- {
+		encryptedTitle, err := symCrypto.Encrypt(titles[i])
+		if err != nil {
+			t.Fatalf("Error encrypting title: %v", err)
+		}
+
+		checklistRepo.Items = append(checklistRepo.Items, entity.ChecklistItem{
+			ID:       uuid.NewString(),
+			TaskID:   task.ID,
+			Position: i + 1,
+			Title:    encryptedTitle,
+			Done:     i == 0,
+		})
+	}
+
+	type args struct {
+		params ListChecklistItemsParams
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantProgress string
+		wantErr      error
+	}{
+		{
+			name: "should list checklist items if user is the assignee",
+			args: args{
+				params: ListChecklistItemsParams{
+					TaskID:   task.ID,
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+				},
+			},
+			wantProgress: "1/3",
+			wantErr:      nil,
+		},
+		{
+			name: "should not list checklist items if user is not the assignee",
+			args: args{
+				params: ListChecklistItemsParams{
+					TaskID:   task.ID,
+					UserID:   uuid.NewString(),
+					UserRole: entity.RoleTechnician,
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToViewTask,
+		},
+		{
+			name: "should not list checklist items if user is not a project member",
+			args: args{
+				params: ListChecklistItemsParams{
+					TaskID:   projectTask.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+				},
+			},
+			wantErr: entity.ErrUserNotProjectMember,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			l := NewListChecklistItems(val, symCrypto, taskRepo, projectRepo, checklistRepo)
+
+			got, err := l.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"ListChecklistItems.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.Progress.String() != tt.wantProgress {
+				t.Errorf(
+					"ListChecklistItems.Execute() progress = %v, want %v",
+					got.Progress,
+					tt.wantProgress,
+				)
+			}
+
+			for i, item := range got.Data {
+				if item.Title != titles[i] {
+					t.Errorf(
+						"ListChecklistItems.Execute() items[%d].Title = %v, want %v",
+						i,
+						item.Title,
+						titles[i],
+					)
+				}
+			}
+		})
+	}
+}
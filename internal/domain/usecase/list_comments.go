@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type ListComments struct {
+	validator   validator.Validator
+	symCrypto   symcrypt.SymmetricalEncrypter
+	taskRepo    repo.TaskRepo
+	projectRepo repo.ProjectRepo
+	commentRepo repo.CommentRepo
+}
+
+func NewListComments(
+	validator validator.Validator,
+	symCrypto symcrypt.SymmetricalEncrypter,
+	taskRepo repo.TaskRepo,
+	projectRepo repo.ProjectRepo,
+	commentRepo repo.CommentRepo,
+) *ListComments {
+	return &ListComments{
+		validator:   validator,
+		symCrypto:   symCrypto,
+		taskRepo:    taskRepo,
+		projectRepo: projectRepo,
+		commentRepo: commentRepo,
+	}
+}
+
+type ListCommentsParams struct {
+	TaskID   string      `json:"task_id,omitempty"   validate:"required,uuid"`
+	UserID   string      `json:"user_id,omitempty"   validate:"required,uuid"`
+	UserRole entity.Role `json:"user_role,omitempty" validate:"required,min=1,max=2"`
+}
+
+// Execute returns the task comments, oldest first.
+func (l *ListComments) Execute(
+	ctx context.Context,
+	params ListCommentsParams,
+) ([]entity.Comment, error) {
+	if err := l.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return nil, validationErr
+	}
+
+	task, err := l.taskRepo.GetTaskByID(ctx, params.TaskID)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	if task.ID == "" {
+		return nil, entity.ErrTaskNotFound
+	}
+
+	if err := ensureTaskVisible(
+		ctx,
+		l.projectRepo,
+		task,
+		params.UserID,
+		params.UserRole,
+		entity.ErrUserNotAllowedToViewTask,
+	); err != nil {
+		return nil, err
+	}
+
+	comments, err := l.commentRepo.ListComments(ctx, task.ID)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	for i, comment := range comments {
+		decryptedBody, err := l.symCrypto.Decrypt(comment.Body)
+		if err != nil {
+			return nil, entity.NewErr(err)
+		}
+		comments[i].Body = decryptedBody
+	}
+
+	return comments, nil
+}
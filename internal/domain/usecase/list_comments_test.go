@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestListComments_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	managerID := uuid.NewString()
+	technicianID := uuid.NewString()
+
+	task := entity.Task{
+		ID:               uuid.NewString(),
+		Summary:          "Loren ipsum dolor sit amet",
+		Status:           entity.TaskStatusOpen,
+		AssignedToUserID: &technicianID,
+		CreatedByUserID:  managerID,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+
+	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+	taskRepo.Tasks = append(taskRepo.Tasks, task)
+
+	project := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Maintenance",
+		ManagerUserID: uuid.NewString(),
+	}
+	projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+	projectRepo.Projects = append(projectRepo.Projects, project)
+
+	projectTask := task
+	projectTask.ID = uuid.NewString()
+	projectTask.ProjectID = &project.ID
+	taskRepo.Tasks = append(taskRepo.Tasks, projectTask)
+
+	body := "Waiting on parts"
+	encryptedBody, err := symCrypto.Encrypt(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commentRepo := inmemoryrepo.NewInMemoryCommentRepo()
+	commentRepo.Comments = append(
+		commentRepo.Comments,
+		entity.Comment{
+			ID:        uuid.NewString(),
+			TaskID:    task.ID,
+			UserID:    technicianID,
+			Body:      encryptedBody,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+		entity.Comment{
+			ID:        uuid.NewString(),
+			TaskID:    uuid.NewString(),
+			UserID:    managerID,
+			Body:      encryptedBody,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	)
+
+	type args struct {
+		params ListCommentsParams
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantComments int
+		wantErr      error
+	}{
+		{
+			name: "should list the task comments to the assignee",
+			args: args{
+				params: ListCommentsParams{
+					TaskID:   task.ID,
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+				},
+			},
+			wantComments: 1,
+			wantErr:      nil,
+		},
+		{
+			name: "should list the task comments to managers",
+			args: args{
+				params: ListCommentsParams{
+					TaskID:   task.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+				},
+			},
+			wantComments: 1,
+			wantErr:      nil,
+		},
+		{
+			name: "should not list the task comments if user is not the assignee",
+			args: args{
+				params: ListCommentsParams{
+					TaskID:   task.ID,
+					UserID:   uuid.NewString(),
+					UserRole: entity.RoleTechnician,
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToViewTask,
+		},
+		{
+			name: "should not list the task comments if task does not exists",
+			args: args{
+				params: ListCommentsParams{
+					TaskID:   uuid.NewString(),
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+				},
+			},
+			wantErr: entity.ErrTaskNotFound,
+		},
+		{
+			name: "should not list the task comments if user is not a project member",
+			args: args{
+				params: ListCommentsParams{
+					TaskID:   projectTask.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+				},
+			},
+			wantErr: entity.ErrUserNotProjectMember,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			l := NewListComments(val, symCrypto, taskRepo, projectRepo, commentRepo)
+
+			comments, err := l.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"ListComments.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if len(comments) != tt.wantComments {
+				t.Fatalf(
+					"ListComments.Execute() comments = %v, want %v",
+					len(comments),
+					tt.wantComments,
+				)
+			}
+
+			for _, comment := range comments {
+				if comment.Body != body {
+					t.Errorf(
+						"ListComments.Execute() comment.Body = %v, want %v",
+						comment.Body,
+						body,
+					)
+				}
+			}
+		})
+	}
+}
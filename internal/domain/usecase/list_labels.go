@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type ListLabels struct {
+	labelRepo repo.LabelRepo
+}
+
+func NewListLabels(labelRepo repo.LabelRepo) *ListLabels {
+	return &ListLabels{
+		labelRepo: labelRepo,
+	}
+}
+
+// Execute returns every label ordered by name.
+func (l *ListLabels) Execute(ctx context.Context) ([]entity.Label, error) {
+	labels, err := l.labelRepo.ListLabels(ctx)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return labels, nil
+}
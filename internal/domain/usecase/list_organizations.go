@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type ListOrganizations struct {
+	organizationRepo repo.OrganizationRepo
+}
+
+func NewListOrganizations(
+	organizationRepo repo.OrganizationRepo,
+) *ListOrganizations {
+	return &ListOrganizations{
+		organizationRepo: organizationRepo,
+	}
+}
+
+// Execute returns every organization ordered by name.
+func (l *ListOrganizations) Execute(
+	ctx context.Context,
+) ([]entity.Organization, error) {
+	organizations, err := l.organizationRepo.ListOrganizations(ctx)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return organizations, nil
+}
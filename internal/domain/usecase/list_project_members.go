@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type ListProjectMembers struct {
+	validator   validator.Validator
+	projectRepo repo.ProjectRepo
+}
+
+func NewListProjectMembers(
+	validator validator.Validator,
+	projectRepo repo.ProjectRepo,
+) *ListProjectMembers {
+	return &ListProjectMembers{
+		validator:   validator,
+		projectRepo: projectRepo,
+	}
+}
+
+type ListProjectMembersParams struct {
+	ProjectID string      `json:"project_id,omitempty" validate:"required,uuid"`
+	UserRole  entity.Role `json:"user_role,omitempty"  validate:"required,min=1,max=2"`
+	UserID    string      `json:"user_id,omitempty"    validate:"required,uuid"`
+}
+
+// Execute returns the project members in the order they were added, if
+// the user is one of them.
+func (l *ListProjectMembers) Execute(
+	ctx context.Context,
+	params ListProjectMembersParams,
+) ([]entity.ProjectMember, error) {
+	if params.UserRole != entity.RoleManager {
+		return nil, entity.ErrUserNotAllowedToManageProjects
+	}
+
+	if err := l.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return nil, validationErr
+	}
+
+	if _, err := ensureProjectMember(
+		ctx,
+		l.projectRepo,
+		params.ProjectID,
+		params.UserID,
+	); err != nil {
+		return nil, err
+	}
+
+	members, err := l.projectRepo.ListProjectMembers(ctx, params.ProjectID)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return members, nil
+}
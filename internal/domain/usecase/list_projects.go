@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type ListProjects struct {
+	validator   validator.Validator
+	projectRepo repo.ProjectRepo
+}
+
+func NewListProjects(
+	validator validator.Validator,
+	projectRepo repo.ProjectRepo,
+) *ListProjects {
+	return &ListProjects{
+		validator:   validator,
+		projectRepo: projectRepo,
+	}
+}
+
+type ListProjectsParams struct {
+	UserRole entity.Role `json:"user_role,omitempty" validate:"required,min=1,max=2"`
+	UserID   string      `json:"user_id,omitempty"   validate:"required,uuid"`
+}
+
+// Execute returns the projects the user is a member of ordered by name.
+func (l *ListProjects) Execute(
+	ctx context.Context,
+	params ListProjectsParams,
+) ([]entity.Project, error) {
+	if params.UserRole != entity.RoleManager {
+		return nil, entity.ErrUserNotAllowedToManageProjects
+	}
+
+	if err := l.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return nil, validationErr
+	}
+
+	projects, err := l.projectRepo.ListProjectsByMemberUserID(ctx, params.UserID)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return projects, nil
+}
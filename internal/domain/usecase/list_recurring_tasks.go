@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type ListRecurringTasks struct {
+	symCrypto         symcrypt.SymmetricalEncrypter
+	recurringTaskRepo repo.RecurringTaskRepo
+}
+
+func NewListRecurringTasks(
+	symCrypto symcrypt.SymmetricalEncrypter,
+	recurringTaskRepo repo.RecurringTaskRepo,
+) *ListRecurringTasks {
+	return &ListRecurringTasks{
+		symCrypto:         symCrypto,
+		recurringTaskRepo: recurringTaskRepo,
+	}
+}
+
+// Execute returns every recurring task, oldest first.
+func (l *ListRecurringTasks) Execute(
+	ctx context.Context,
+	userRole entity.Role,
+) ([]entity.RecurringTask, error) {
+	if userRole != entity.RoleManager {
+		return nil, entity.ErrUserNotAllowedToManageRecurringTasks
+	}
+
+	recurringTasks, err := l.recurringTaskRepo.ListRecurringTasks(ctx)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	for i, recurringTask := range recurringTasks {
+		summary, err := l.symCrypto.Decrypt(recurringTask.Summary)
+		if err != nil {
+			return nil, entity.NewErr(err)
+		}
+		recurringTasks[i].Summary = summary
+	}
+
+	return recurringTasks, nil
+}
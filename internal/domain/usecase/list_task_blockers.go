@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type ListTaskBlockers struct {
+	validator          validator.Validator
+	symCrypto          symcrypt.SymmetricalEncrypter
+	taskRepo           repo.TaskRepo
+	projectRepo        repo.ProjectRepo
+	taskDependencyRepo repo.TaskDependencyRepo
+}
+
+func NewListTaskBlockers(
+	validator validator.Validator,
+	symCrypto symcrypt.SymmetricalEncrypter,
+	taskRepo repo.TaskRepo,
+	projectRepo repo.ProjectRepo,
+	taskDependencyRepo repo.TaskDependencyRepo,
+) *ListTaskBlockers {
+	return &ListTaskBlockers{
+		validator:          validator,
+		symCrypto:          symCrypto,
+		taskRepo:           taskRepo,
+		projectRepo:        projectRepo,
+		taskDependencyRepo: taskDependencyRepo,
+	}
+}
+
+type ListTaskBlockersParams struct {
+	TaskID   string      `json:"task_id,omitempty"   validate:"required,uuid"`
+	UserID   string      `json:"user_id,omitempty"   validate:"required,uuid"`
+	UserRole entity.Role `json:"user_role,omitempty" validate:"required,min=1,max=2"`
+}
+
+// Execute returns the tasks blocking the task, leaving deleted
+// ones out.
+func (l *ListTaskBlockers) Execute(
+	ctx context.Context,
+	params ListTaskBlockersParams,
+) ([]entity.Task, error) {
+	if err := l.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return nil, validationErr
+	}
+
+	task, err := l.taskRepo.GetTaskByID(ctx, params.TaskID)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	if task.ID == "" {
+		return nil, entity.ErrTaskNotFound
+	}
+
+	if err := ensureTaskVisible(
+		ctx,
+		l.projectRepo,
+		task,
+		params.UserID,
+		params.UserRole,
+		entity.ErrUserNotAllowedToViewTask,
+	); err != nil {
+		return nil, err
+	}
+
+	dependencies, err := l.taskDependencyRepo.ListTaskBlockers(ctx, task.ID)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	blockers := []entity.Task{}
+	for _, dependency := range dependencies {
+		blocker, err := l.taskRepo.GetTaskByID(ctx, dependency.BlockedByTaskID)
+		if err != nil {
+			return nil, entity.NewErr(err)
+		}
+
+		if blocker.ID == "" {
+			continue
+		}
+
+		blocker, err = decryptTask(l.symCrypto, blocker)
+		if err != nil {
+			return nil, entity.NewErr(err)
+		}
+
+		blockers = append(blockers, blocker)
+	}
+
+	return blockers, nil
+}
@@ -1,7 +1,11 @@
 package usecase
 
 import (
+	"cmp"
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
 
 	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
@@ -10,68 +14,331 @@ import (
 )
 
 type ListTasks struct {
-	validator validator.Validator
-	symCrypto symcrypt.SymmetricalEncrypter
-	taskRepo  repo.TaskRepo
+	validator     validator.Validator
+	symCrypto     symcrypt.SymmetricalEncrypter
+	taskRepo      repo.TaskRepo
+	checklistRepo repo.ChecklistRepo
+	labelRepo     repo.LabelRepo
+	projectRepo   repo.ProjectRepo
 }
 
 func NewListTasks(
 	validator validator.Validator,
 	symCrypto symcrypt.SymmetricalEncrypter,
 	taskRepo repo.TaskRepo,
+	checklistRepo repo.ChecklistRepo,
+	labelRepo repo.LabelRepo,
+	projectRepo repo.ProjectRepo,
 ) *ListTasks {
 	return &ListTasks{
-		validator: validator,
-		symCrypto: symCrypto,
-		taskRepo:  taskRepo,
+		validator:     validator,
+		symCrypto:     symCrypto,
+		taskRepo:      taskRepo,
+		checklistRepo: checklistRepo,
+		labelRepo:     labelRepo,
+		projectRepo:   projectRepo,
 	}
 }
 
+const defaultListTasksLimit = 20
+
+const (
+	TaskStatusFilterOpen     = "open"
+	TaskStatusFilterFinished = "finished"
+)
+
 type ListTasksParams struct {
-	UserRole entity.Role `json:"user_role,omitempty" validate:"required,min=1,max=2"`
-	UserID   string      `json:"user_id,omitempty"   validate:"required,uuid"`
+	UserRole        entity.Role           `json:"user_role,omitempty"          validate:"required,min=1,max=2"`
+	UserID          string                `json:"user_id,omitempty"            validate:"required,uuid"`
+	Status          string                `json:"status,omitempty"             validate:"omitempty,oneof=open finished"`
+	CreatedByUserID string                `json:"created_by_user_id,omitempty" validate:"omitempty,uuid"`
+	Unassigned      bool                  `json:"unassigned,omitempty"`
+	Deleted         bool                  `json:"deleted,omitempty"`
+	CreatedFrom     *time.Time            `json:"created_from,omitempty"`
+	CreatedTo       *time.Time            `json:"created_to,omitempty"`
+	FinishedFrom    *time.Time            `json:"finished_from,omitempty"`
+	FinishedTo      *time.Time            `json:"finished_to,omitempty"`
+	DueFrom         *time.Time            `json:"due_from,omitempty"`
+	DueTo           *time.Time            `json:"due_to,omitempty"`
+	Overdue         bool                  `json:"overdue,omitempty"`
+	Priorities      []entity.TaskPriority `json:"priorities,omitempty"         validate:"omitempty,dive,oneof=low normal high critical"`
+	LabelIDs        []string              `json:"label_ids,omitempty"          validate:"omitempty,dive,uuid"`
+	LabelMatch      repo.LabelMatch       `json:"label_match,omitempty"        validate:"omitempty,oneof=any all"`
+	ProjectID       string                `json:"project_id,omitempty"         validate:"omitempty,uuid"`
+	SortBy          repo.TaskSortField    `json:"sort_by,omitempty"            validate:"omitempty,oneof=created_at updated_at finished_at due_at priority"`
+	SortDirection   repo.SortDirection    `json:"sort_direction,omitempty"     validate:"omitempty,oneof=asc desc"`
+	Limit           int                   `json:"limit,omitempty"              validate:"omitempty,min=1,max=100"`
+	Cursor          string                `json:"cursor,omitempty"`
+}
+
+type ListTasksResult struct {
+	Data       []entity.Task `json:"data"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+	PrevCursor string        `json:"prev_cursor,omitempty"`
+}
+
+type cursorDirection string
+
+const (
+	cursorDirectionNext cursorDirection = "next"
+	cursorDirectionPrev cursorDirection = "prev"
+)
+
+// taskCursor is the content of the opaque cursors handed to clients.
+// It holds the sorting it was created with, since the position of a
+// task in the listing depends on it.
+type taskCursor struct {
+	repo.TaskCursor
+	SortBy        repo.TaskSortField `json:"sort_by"`
+	SortDirection repo.SortDirection `json:"sort_direction"`
+	Direction     cursorDirection    `json:"direction"`
 }
 
 func (l *ListTasks) Execute(
 	ctx context.Context,
 	params ListTasksParams,
-) ([]entity.Task, error) {
+) (ListTasksResult, error) {
 	if err := l.validator.Validate(params); err != nil {
 		validationErr := entity.ErrValidation
 		validationErr.Message = err.Error()
-		return nil, validationErr
+		return ListTasksResult{}, validationErr
+	}
+
+	limit := cmp.Or(params.Limit, defaultListTasksLimit)
+	sortBy := cmp.Or(params.SortBy, repo.TaskSortByCreatedAt)
+	sortDirection := cmp.Or(params.SortDirection, repo.SortAsc)
+
+	// Fetch one extra task to know if there is another page.
+	opts := []repo.ListTasksOption{
+		repo.WithLimit(limit + 1),
+		repo.WithSort(sortBy, sortDirection),
 	}
 
-	var results []entity.Task
-	var err error
+	now := time.Now()
+	opts = append(opts, listTasksFilterOptions(params, now)...)
+
 	switch params.UserRole {
 	case entity.RoleManager:
-		results, err = l.taskRepo.ListTasks(ctx)
+		// Managers can see the tasks without a project and the ones of
+		// the projects they are members of.
+		scopeOpt, err := projectScopeOption(ctx, l.projectRepo, params.UserID)
+		if err != nil {
+			return ListTasksResult{}, err
+		}
+		opts = append(opts, scopeOpt)
 
 	case entity.RoleTechnician:
-		results, err = l.taskRepo.ListTasks(
-			ctx,
-			repo.WithAssignedToUserID(params.UserID),
-		)
+		if params.Deleted {
+			return ListTasksResult{}, entity.ErrUserNotAllowedToViewTrash
+		}
+		opts = append(opts, repo.WithAssignedToUserID(params.UserID))
 
 	default:
-		return nil, entity.ErrValidation
+		return ListTasksResult{}, entity.ErrValidation
 	}
 
+	var cursor *taskCursor
+	if params.Cursor != "" {
+		decodedCursor, err := decodeTaskCursor(params.Cursor)
+		if err != nil {
+			return ListTasksResult{}, entity.ErrInvalidCursor
+		}
+		cursor = &decodedCursor
+
+		if cursor.SortBy != sortBy || cursor.SortDirection != sortDirection {
+			return ListTasksResult{}, entity.ErrInvalidCursor
+		}
+
+		if cursor.Direction == cursorDirectionPrev {
+			opts = append(opts, repo.WithBefore(cursor.TaskCursor))
+		} else {
+			opts = append(opts, repo.WithAfter(cursor.TaskCursor))
+		}
+	}
+
+	results, err := l.taskRepo.ListTasks(ctx, opts...)
 	if err != nil {
-		return nil, entity.NewErr(err)
+		return ListTasksResult{}, entity.NewErr(err)
+	}
+
+	isBackwards := cursor != nil && cursor.Direction == cursorDirectionPrev
+	hasMore := len(results) > limit
+	if hasMore {
+		if isBackwards {
+			results = results[len(results)-limit:]
+		} else {
+			results = results[:limit]
+		}
 	}
 
 	tasks := make([]entity.Task, len(results))
 	copy(tasks, results)
 
 	for i, task := range tasks {
-		decryptedSummary, err := l.symCrypto.Decrypt(task.Summary)
+		tasks[i], err = decryptTask(l.symCrypto, task)
 		if err != nil {
-			return nil, entity.NewErr(err)
+			return ListTasksResult{}, entity.NewErr(err)
 		}
-		tasks[i].Summary = decryptedSummary
+		tasks[i].Overdue = task.IsOverdue(now)
+	}
+
+	if err := setChecklistProgress(ctx, l.checklistRepo, tasks); err != nil {
+		return ListTasksResult{}, entity.NewErr(err)
+	}
+
+	if err := setTaskLabels(ctx, l.taskRepo, l.labelRepo, tasks); err != nil {
+		return ListTasksResult{}, entity.NewErr(err)
+	}
+
+	result := ListTasksResult{
+		Data: tasks,
+	}
+
+	if len(tasks) == 0 {
+		return result, nil
+	}
+
+	// Going backwards there is always a next page, the one the cursor
+	// came from, and going forwards there is always a previous one.
+	hasNext := isBackwards || hasMore
+	hasPrev := (isBackwards && hasMore) || (!isBackwards && cursor != nil)
+
+	if hasNext {
+		result.NextCursor, err = newTaskCursor(
+			tasks[len(tasks)-1],
+			sortBy,
+			sortDirection,
+			cursorDirectionNext,
+		).encode()
+		if err != nil {
+			return ListTasksResult{}, entity.NewErr(err)
+		}
+	}
+
+	if hasPrev {
+		result.PrevCursor, err = newTaskCursor(
+			tasks[0],
+			sortBy,
+			sortDirection,
+			cursorDirectionPrev,
+		).encode()
+		if err != nil {
+			return ListTasksResult{}, entity.NewErr(err)
+		}
+	}
+
+	return result, nil
+}
+
+// listTasksFilterOptions returns the repo options for the filters in
+// the params, overdue tasks being the ones past their due date at now.
+func listTasksFilterOptions(
+	params ListTasksParams,
+	now time.Time,
+) []repo.ListTasksOption {
+	var opts []repo.ListTasksOption
+
+	switch params.Status {
+	case TaskStatusFilterOpen:
+		opts = append(opts, repo.WithFinished(false))
+	case TaskStatusFilterFinished:
+		opts = append(opts, repo.WithFinished(true))
+	}
+
+	if params.CreatedByUserID != "" {
+		opts = append(opts, repo.WithCreatedByUserID(params.CreatedByUserID))
+	}
+
+	if params.Unassigned {
+		opts = append(opts, repo.WithUnassigned())
+	}
+
+	if params.Deleted {
+		opts = append(opts, repo.WithDeleted())
+	}
+
+	if params.CreatedFrom != nil {
+		opts = append(opts, repo.WithCreatedFrom(*params.CreatedFrom))
+	}
+
+	if params.CreatedTo != nil {
+		opts = append(opts, repo.WithCreatedTo(*params.CreatedTo))
+	}
+
+	if params.FinishedFrom != nil {
+		opts = append(opts, repo.WithFinishedFrom(*params.FinishedFrom))
+	}
+
+	if params.FinishedTo != nil {
+		opts = append(opts, repo.WithFinishedTo(*params.FinishedTo))
+	}
+
+	if params.DueFrom != nil {
+		opts = append(opts, repo.WithDueFrom(*params.DueFrom))
+	}
+
+	if params.DueTo != nil {
+		opts = append(opts, repo.WithDueTo(*params.DueTo))
+	}
+
+	if params.Overdue {
+		opts = append(opts, repo.WithOverdue(now))
+	}
+
+	if len(params.Priorities) > 0 {
+		opts = append(opts, repo.WithPriorities(params.Priorities...))
+	}
+
+	if len(params.LabelIDs) > 0 {
+		opts = append(opts, repo.WithLabels(params.LabelMatch, params.LabelIDs...))
+	}
+
+	if params.ProjectID != "" {
+		opts = append(opts, repo.WithProjectID(params.ProjectID))
+	}
+
+	return opts
+}
+
+func newTaskCursor(
+	task entity.Task,
+	sortBy repo.TaskSortField,
+	sortDirection repo.SortDirection,
+	direction cursorDirection,
+) taskCursor {
+	return taskCursor{
+		TaskCursor:    repo.NewTaskCursor(sortBy, task),
+		SortBy:        sortBy,
+		SortDirection: sortDirection,
+		Direction:     direction,
+	}
+}
+
+func (c taskCursor) encode() (string, error) {
+	cursorBytes, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(cursorBytes), nil
+}
+
+func decodeTaskCursor(encoded string) (taskCursor, error) {
+	cursorBytes, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return taskCursor{}, err
+	}
+
+	cursor := taskCursor{}
+	if err := json.Unmarshal(cursorBytes, &cursor); err != nil {
+		return taskCursor{}, err
+	}
+
+	if cursor.ID == "" ||
+		(cursor.Direction != cursorDirectionNext &&
+			cursor.Direction != cursorDirectionPrev) {
+		return taskCursor{}, entity.ErrInvalidCursor
 	}
 
-	return tasks, nil
+	return cursor, nil
 }
@@ -23,6 +23,7 @@ func TestListTasks_Execute(t *testing.T) {
 	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
 
 	managerID := uuid.NewString()
+	secondManagerID := uuid.NewString()
 	firstTechnicianID := uuid.NewString()
 	secondTechnicianID := uuid.NewString()
 
@@ -31,13 +32,21 @@ func TestListTasks_Execute(t *testing.T) {
 		t.Fatalf("could not encrypt summary")
 	}
 
+	now := time.Now()
+	task1DueAt := now.Add(-time.Hour)
+	task2DueAt := now.Add(-time.Hour * 2)
+	task2FinishedAt := now.Add(-time.Minute * 90)
+	task3DueAt := now.Add(time.Hour)
+
 	task1 := entity.Task{
 		ID:               uuid.NewString(),
 		Summary:          encryptedSummary,
 		AssignedToUserID: &firstTechnicianID,
 		CreatedByUserID:  managerID,
-		CreatedAt:        time.Now(),
-		UpdatedAt:        time.Now(),
+		DueAt:            &task1DueAt,
+		Priority:         entity.TaskPriorityLow,
+		CreatedAt:        now.Add(-time.Hour * 3),
+		UpdatedAt:        now,
 	}
 
 	task2 := entity.Task{
@@ -45,8 +54,12 @@ func TestListTasks_Execute(t *testing.T) {
 		Summary:          encryptedSummary,
 		AssignedToUserID: &firstTechnicianID,
 		CreatedByUserID:  managerID,
-		CreatedAt:        time.Now(),
-		UpdatedAt:        time.Now(),
+		Status:           entity.TaskStatusDone,
+		DueAt:            &task2DueAt,
+		Priority:         entity.TaskPriorityNormal,
+		CreatedAt:        now.Add(-time.Hour * 2),
+		UpdatedAt:        now,
+		FinishedAt:       &task2FinishedAt,
 	}
 
 	task3 := entity.Task{
@@ -54,17 +67,73 @@ func TestListTasks_Execute(t *testing.T) {
 		Summary:          encryptedSummary,
 		AssignedToUserID: &secondTechnicianID,
 		CreatedByUserID:  managerID,
-		CreatedAt:        time.Now(),
-		UpdatedAt:        time.Now(),
+		DueAt:            &task3DueAt,
+		Priority:         entity.TaskPriorityCritical,
+		CreatedAt:        now.Add(-time.Hour),
+		UpdatedAt:        now,
+	}
+
+	task4 := entity.Task{
+		ID:              uuid.NewString(),
+		Summary:         encryptedSummary,
+		CreatedByUserID: secondManagerID,
+		Priority:        entity.TaskPriorityHigh,
+		CreatedAt:       now.Add(-time.Minute * 30),
+		UpdatedAt:       now,
+	}
+
+	task5DeletedAt := now.Add(-time.Minute * 10)
+	task5 := entity.Task{
+		ID:               uuid.NewString(),
+		Summary:          encryptedSummary,
+		AssignedToUserID: &firstTechnicianID,
+		CreatedByUserID:  managerID,
+		CreatedAt:        now.Add(-time.Minute * 20),
+		UpdatedAt:        now,
+		DeletedAt:        &task5DeletedAt,
 	}
 
 	taskRepo.Tasks = append(
 		taskRepo.Tasks,
+		task3,
 		task1,
+		task5,
+		task4,
 		task2,
-		task3,
 	)
 
+	urgentLabelID := uuid.NewString()
+	hardwareLabelID := uuid.NewString()
+	labelRepo := inmemoryrepo.NewInMemoryLabelRepo()
+	labelRepo.Labels = append(
+		labelRepo.Labels,
+		entity.Label{ID: urgentLabelID, Name: "urgent"},
+		entity.Label{ID: hardwareLabelID, Name: "hardware"},
+	)
+	taskRepo.LabelIDs[task1.ID] = []string{urgentLabelID, hardwareLabelID}
+	taskRepo.LabelIDs[task3.ID] = []string{urgentLabelID}
+	taskRepo.LabelIDs[task4.ID] = []string{hardwareLabelID}
+
+	afterTask1Cursor, err := newTaskCursor(
+		task1,
+		repo.TaskSortByCreatedAt,
+		repo.SortAsc,
+		cursorDirectionNext,
+	).encode()
+	if err != nil {
+		t.Fatalf("could not encode cursor")
+	}
+
+	beforeTask3Cursor, err := newTaskCursor(
+		task3,
+		repo.TaskSortByCreatedAt,
+		repo.SortAsc,
+		cursorDirectionPrev,
+	).encode()
+	if err != nil {
+		t.Fatalf("could not encode cursor")
+	}
+
 	type fields struct {
 		validator validator.Validator
 		symCrypto symcrypt.SymmetricalEncrypter
@@ -74,11 +143,13 @@ func TestListTasks_Execute(t *testing.T) {
 		params ListTasksParams
 	}
 	tests := []struct {
-		name      string
-		fields    fields
-		args      args
-		wantTasks []entity.Task
-		wantErr   error
+		name           string
+		fields         fields
+		args           args
+		wantTasks      []entity.Task
+		wantNextCursor bool
+		wantPrevCursor bool
+		wantErr        error
 	}{
 		{
 			name: "should list all tasks for the manager",
@@ -97,6 +168,7 @@ func TestListTasks_Execute(t *testing.T) {
 				task1,
 				task2,
 				task3,
+				task4,
 			},
 			wantErr: nil,
 		},
@@ -137,6 +209,480 @@ func TestListTasks_Execute(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "should list the first page of tasks",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerID,
+					Limit:    2,
+				},
+			},
+			wantTasks: []entity.Task{
+				task1,
+				task2,
+			},
+			wantNextCursor: true,
+			wantPrevCursor: false,
+			wantErr:        nil,
+		},
+		{
+			name: "should list the tasks after the cursor",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerID,
+					Limit:    2,
+					Cursor:   afterTask1Cursor,
+				},
+			},
+			wantTasks: []entity.Task{
+				task2,
+				task3,
+			},
+			wantNextCursor: true,
+			wantPrevCursor: true,
+			wantErr:        nil,
+		},
+		{
+			name: "should list the tasks before the cursor",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerID,
+					Limit:    1,
+					Cursor:   beforeTask3Cursor,
+				},
+			},
+			wantTasks: []entity.Task{
+				task2,
+			},
+			wantNextCursor: true,
+			wantPrevCursor: true,
+			wantErr:        nil,
+		},
+		{
+			name: "should list only the finished tasks",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerID,
+					Status:   TaskStatusFilterFinished,
+				},
+			},
+			wantTasks: []entity.Task{
+				task2,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should list only the open tasks assigned to the technician",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole: entity.RoleTechnician,
+					UserID:   firstTechnicianID,
+					Status:   TaskStatusFilterOpen,
+				},
+			},
+			wantTasks: []entity.Task{
+				task1,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should list only the unassigned tasks",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole:   entity.RoleManager,
+					UserID:     managerID,
+					Unassigned: true,
+				},
+			},
+			wantTasks: []entity.Task{
+				task4,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should list only the deleted tasks for the manager",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerID,
+					Deleted:  true,
+				},
+			},
+			wantTasks: []entity.Task{
+				task5,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should not list deleted tasks for the technician",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole: entity.RoleTechnician,
+					UserID:   firstTechnicianID,
+					Deleted:  true,
+				},
+			},
+			wantTasks: nil,
+			wantErr:   entity.ErrUserNotAllowedToViewTrash,
+		},
+		{
+			name: "should list only the tasks created by the user",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole:        entity.RoleManager,
+					UserID:          managerID,
+					CreatedByUserID: secondManagerID,
+				},
+			},
+			wantTasks: []entity.Task{
+				task4,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should list only the tasks created in the date range",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole:    entity.RoleManager,
+					UserID:      managerID,
+					CreatedFrom: &task2FinishedAt,
+				},
+			},
+			wantTasks: []entity.Task{
+				task3,
+				task4,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should list tasks sorted by creation date in descending order",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole:      entity.RoleManager,
+					UserID:        managerID,
+					SortDirection: repo.SortDesc,
+				},
+			},
+			wantTasks: []entity.Task{
+				task4,
+				task3,
+				task2,
+				task1,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should list finished tasks first when sorting by finished date",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerID,
+					SortBy:   repo.TaskSortByFinishedAt,
+					Limit:    1,
+				},
+			},
+			wantTasks: []entity.Task{
+				task2,
+			},
+			wantNextCursor: true,
+			wantErr:        nil,
+		},
+		{
+			name: "should list tasks sorted by due date with the undated ones last",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerID,
+					SortBy:   repo.TaskSortByDueAt,
+				},
+			},
+			wantTasks: []entity.Task{
+				task2,
+				task1,
+				task3,
+				task4,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should list the most urgent tasks first when sorting by priority in descending order",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole:      entity.RoleManager,
+					UserID:        managerID,
+					SortBy:        repo.TaskSortByPriority,
+					SortDirection: repo.SortDesc,
+				},
+			},
+			wantTasks: []entity.Task{
+				task3,
+				task4,
+				task2,
+				task1,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should list only the tasks with the priorities",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerID,
+					Priorities: []entity.TaskPriority{
+						entity.TaskPriorityHigh,
+						entity.TaskPriorityCritical,
+					},
+				},
+			},
+			wantTasks: []entity.Task{
+				task3,
+				task4,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should list only the overdue tasks",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerID,
+					Overdue:  true,
+				},
+			},
+			wantTasks: []entity.Task{
+				task1,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should not list tasks if invalid priority is provided",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole:   entity.RoleManager,
+					UserID:     managerID,
+					Priorities: []entity.TaskPriority{"urgent"},
+				},
+			},
+			wantTasks: nil,
+			wantErr:   entity.ErrValidation,
+		},
+		{
+			name: "should list tasks with any of the labels",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerID,
+					LabelIDs: []string{urgentLabelID, hardwareLabelID},
+				},
+			},
+			wantTasks: []entity.Task{
+				task1,
+				task3,
+				task4,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should list tasks with all of the labels",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole:   entity.RoleManager,
+					UserID:     managerID,
+					LabelIDs:   []string{urgentLabelID, hardwareLabelID},
+					LabelMatch: repo.LabelMatchAll,
+				},
+			},
+			wantTasks: []entity.Task{
+				task1,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should not list tasks if invalid label match is provided",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole:   entity.RoleManager,
+					UserID:     managerID,
+					LabelIDs:   []string{urgentLabelID},
+					LabelMatch: "some",
+				},
+			},
+			wantTasks: nil,
+			wantErr:   entity.ErrValidation,
+		},
+		{
+			name: "should not list tasks if invalid sort field is provided",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerID,
+					SortBy:   "summary",
+				},
+			},
+			wantTasks: nil,
+			wantErr:   entity.ErrValidation,
+		},
+		{
+			name: "should not list tasks if cursor was created with another sorting",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole:      entity.RoleManager,
+					UserID:        managerID,
+					SortDirection: repo.SortDesc,
+					Cursor:        afterTask1Cursor,
+				},
+			},
+			wantTasks: nil,
+			wantErr:   entity.ErrInvalidCursor,
+		},
+		{
+			name: "should not list tasks if invalid cursor is provided",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerID,
+					Cursor:   "invalid-cursor",
+				},
+			},
+			wantTasks: nil,
+			wantErr:   entity.ErrInvalidCursor,
+		},
+		{
+			name: "should not list tasks if limit is greater than 100",
+			fields: fields{
+				validator: val,
+				symCrypto: symCrypto,
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ListTasksParams{
+					UserRole: entity.RoleManager,
+					UserID:   managerID,
+					Limit:    101,
+				},
+			},
+			wantTasks: nil,
+			wantErr:   entity.ErrValidation,
+		},
 		{
 			name: "should not list task if invalid id is provided",
 			fields: fields{
@@ -178,9 +724,12 @@ func TestListTasks_Execute(t *testing.T) {
 				tt.fields.validator,
 				tt.fields.symCrypto,
 				tt.fields.taskRepo,
+				inmemoryrepo.NewInMemoryChecklistRepo(),
+				labelRepo,
+				inmemoryrepo.NewInMemoryProjectRepo(),
 			)
 
-			gotTasks, err := l.Execute(context.Background(), tt.args.params)
+			got, err := l.Execute(context.Background(), tt.args.params)
 			if !testutil.IsSameErr(err, tt.wantErr) {
 				t.Errorf(
 					"ListTasks.Execute() error = %v, wantErr %v",
@@ -193,11 +742,161 @@ func TestListTasks_Execute(t *testing.T) {
 				}
 				return
 			}
-			for i, task := range gotTasks {
+			if len(got.Data) != len(tt.wantTasks) {
+				t.Errorf(
+					"ListTasks.Execute() = %v, want %v",
+					got.Data,
+					tt.wantTasks,
+				)
+				return
+			}
+			for i, task := range got.Data {
+				if task.ID != tt.wantTasks[i].ID {
+					t.Errorf(
+						"ListTasks.Execute() = %v, want %v",
+						got.Data,
+						tt.wantTasks,
+					)
+				}
+				if wantOverdue := task.ID == task1.ID; task.Overdue != wantOverdue {
+					t.Errorf(
+						"ListTasks.Execute() task %v Overdue = %v, want %v",
+						task.ID,
+						task.Overdue,
+						wantOverdue,
+					)
+				}
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			gotNextCursor := got.NextCursor != ""
+			if gotNextCursor != tt.wantNextCursor {
+				t.Errorf(
+					"ListTasks.Execute() NextCursor = %v, want present %v",
+					got.NextCursor,
+					tt.wantNextCursor,
+				)
+			}
+			gotPrevCursor := got.PrevCursor != ""
+			if gotPrevCursor != tt.wantPrevCursor {
+				t.Errorf(
+					"ListTasks.Execute() PrevCursor = %v, want present %v",
+					got.PrevCursor,
+					tt.wantPrevCursor,
+				)
+			}
+		})
+	}
+}
+
+func TestListTasks_Execute_ProjectScope(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	memberManagerID := uuid.NewString()
+	otherManagerID := uuid.NewString()
+
+	encryptedSummary, err := symCrypto.Encrypt("Lorem ipsum dolor sit amet")
+	if err != nil {
+		t.Fatalf("could not encrypt summary")
+	}
+
+	project := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Maintenance",
+		ManagerUserID: memberManagerID,
+	}
+	projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+	projectRepo.Projects = append(projectRepo.Projects, project)
+	projectRepo.Members = append(
+		projectRepo.Members,
+		entity.ProjectMember{ProjectID: project.ID, UserID: memberManagerID},
+	)
+
+	now := time.Now()
+	taskWithoutProject := entity.Task{
+		ID:              uuid.NewString(),
+		Summary:         encryptedSummary,
+		CreatedByUserID: otherManagerID,
+		CreatedAt:       now.Add(-time.Hour * 2),
+		UpdatedAt:       now,
+	}
+	projectTask := entity.Task{
+		ID:              uuid.NewString(),
+		Summary:         encryptedSummary,
+		CreatedByUserID: memberManagerID,
+		ProjectID:       &project.ID,
+		CreatedAt:       now.Add(-time.Hour),
+		UpdatedAt:       now,
+	}
+
+	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+	taskRepo.Tasks = append(taskRepo.Tasks, taskWithoutProject, projectTask)
+
+	tests := []struct {
+		name      string
+		params    ListTasksParams
+		wantTasks []entity.Task
+	}{
+		{
+			name: "should list the project tasks for a project member",
+			params: ListTasksParams{
+				UserRole: entity.RoleManager,
+				UserID:   memberManagerID,
+			},
+			wantTasks: []entity.Task{taskWithoutProject, projectTask},
+		},
+		{
+			name: "should not list the project tasks for other managers",
+			params: ListTasksParams{
+				UserRole: entity.RoleManager,
+				UserID:   otherManagerID,
+			},
+			wantTasks: []entity.Task{taskWithoutProject},
+		},
+		{
+			name: "should list only the tasks of the project",
+			params: ListTasksParams{
+				UserRole:  entity.RoleManager,
+				UserID:    memberManagerID,
+				ProjectID: project.ID,
+			},
+			wantTasks: []entity.Task{projectTask},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			l := NewListTasks(
+				val,
+				symCrypto,
+				taskRepo,
+				inmemoryrepo.NewInMemoryChecklistRepo(),
+				inmemoryrepo.NewInMemoryLabelRepo(),
+				projectRepo,
+			)
+
+			got, err := l.Execute(context.Background(), tt.params)
+			if err != nil {
+				t.Fatalf("ListTasks.Execute() error = %v", err)
+			}
+
+			if len(got.Data) != len(tt.wantTasks) {
+				t.Fatalf(
+					"ListTasks.Execute() = %v, want %v",
+					got.Data,
+					tt.wantTasks,
+				)
+			}
+
+			for i, task := range got.Data {
 				if task.ID != tt.wantTasks[i].ID {
 					t.Errorf(
 						"ListTasks.Execute() = %v, want %v",
-						gotTasks,
+						got.Data,
 						tt.wantTasks,
 					)
 				}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type ListWorkSessions struct {
+	validator       validator.Validator
+	symCrypto       symcrypt.SymmetricalEncrypter
+	taskRepo        repo.TaskRepo
+	workSessionRepo repo.WorkSessionRepo
+	projectRepo     repo.ProjectRepo
+}
+
+func NewListWorkSessions(
+	validator validator.Validator,
+	symCrypto symcrypt.SymmetricalEncrypter,
+	taskRepo repo.TaskRepo,
+	workSessionRepo repo.WorkSessionRepo,
+	projectRepo repo.ProjectRepo,
+) *ListWorkSessions {
+	return &ListWorkSessions{
+		validator:       validator,
+		symCrypto:       symCrypto,
+		taskRepo:        taskRepo,
+		workSessionRepo: workSessionRepo,
+		projectRepo:     projectRepo,
+	}
+}
+
+type ListWorkSessionsParams struct {
+	TaskID   string      `json:"task_id,omitempty"   validate:"required,uuid"`
+	UserID   string      `json:"user_id,omitempty"   validate:"required,uuid"`
+	UserRole entity.Role `json:"user_role,omitempty" validate:"required,min=1,max=2"`
+}
+
+// Execute returns the task work sessions ordered by start, along with the
+// time worked on the task in total and by technician. Running timers
+// count up to now.
+func (l *ListWorkSessions) Execute(
+	ctx context.Context,
+	params ListWorkSessionsParams,
+) (entity.TaskWorkTime, error) {
+	if err := l.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return entity.TaskWorkTime{}, validationErr
+	}
+
+	task, err := l.taskRepo.GetTaskByID(ctx, params.TaskID)
+	if err != nil {
+		return entity.TaskWorkTime{}, entity.NewErr(err)
+	}
+
+	if task.ID == "" {
+		return entity.TaskWorkTime{}, entity.ErrTaskNotFound
+	}
+
+	if err := ensureTaskVisible(
+		ctx,
+		l.projectRepo,
+		task,
+		params.UserID,
+		params.UserRole,
+		entity.ErrUserNotAllowedToViewTask,
+	); err != nil {
+		return entity.TaskWorkTime{}, err
+	}
+
+	sessions, err := l.workSessionRepo.ListWorkSessions(ctx, task.ID)
+	if err != nil {
+		return entity.TaskWorkTime{}, entity.NewErr(err)
+	}
+
+	result := entity.TaskWorkTime{
+		TaskID:      task.ID,
+		Technicians: []entity.WorkTimeTotal{},
+		Sessions:    sessions,
+		Locked:      task.AreWorkSessionsLocked(),
+	}
+
+	now := time.Now()
+	secondsByUserID := map[string]int64{}
+	for i, session := range sessions {
+		session, err = decryptWorkSession(l.symCrypto, session, now)
+		if err != nil {
+			return entity.TaskWorkTime{}, err
+		}
+		sessions[i] = session
+
+		result.TotalSeconds += session.DurationSeconds
+		secondsByUserID[session.UserID] += session.DurationSeconds
+	}
+
+	for userID, seconds := range secondsByUserID {
+		result.Technicians = append(result.Technicians, entity.WorkTimeTotal{
+			UserID:  userID,
+			Seconds: seconds,
+		})
+	}
+
+	slices.SortFunc(result.Technicians, func(a, b entity.WorkTimeTotal) int {
+		return strings.Compare(a.UserID, b.UserID)
+	})
+
+	return result, nil
+}
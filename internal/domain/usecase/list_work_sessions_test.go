@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestListWorkSessions_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	managerID := uuid.NewString()
+	technicianID := "00000000-0000-4000-8000-000000000001"
+	otherTechnicianID := "00000000-0000-4000-8000-000000000002"
+
+	task := entity.Task{
+		ID:              uuid.NewString(),
+		Summary:         "Loren ipsum dolor sit amet",
+		Status:          entity.TaskStatusDone,
+		AssigneeIDs:     []string{technicianID, otherTechnicianID},
+		CreatedByUserID: managerID,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+
+	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+	taskRepo.Tasks = append(taskRepo.Tasks, task)
+
+	encryptedNote, err := symCrypto.Encrypt("Replaced the compressor")
+	if err != nil {
+		t.Fatalf("Error encrypting note: %v", err)
+	}
+
+	startedAt := time.Now().Add(-5 * time.Hour)
+	newSession := func(
+		userID string,
+		offset time.Duration,
+		duration time.Duration,
+	) entity.WorkSession {
+		endedAt := startedAt.Add(offset + duration)
+		return entity.WorkSession{
+			ID:        uuid.NewString(),
+			TaskID:    task.ID,
+			UserID:    userID,
+			StartedAt: startedAt.Add(offset),
+			EndedAt:   &endedAt,
+			Note:      &encryptedNote,
+			Manual:    true,
+		}
+	}
+
+	workSessionRepo := inmemoryrepo.NewInMemoryWorkSessionRepo(taskRepo)
+	workSessionRepo.Sessions = append(
+		workSessionRepo.Sessions,
+		newSession(technicianID, 0, time.Hour),
+		newSession(otherTechnicianID, time.Hour, 30*time.Minute),
+		newSession(technicianID, 2*time.Hour, 15*time.Minute),
+	)
+
+	type args struct {
+		params ListWorkSessionsParams
+	}
+	tests := []struct {
+		name             string
+		args             args
+		wantTotal        int64
+		wantTechnicians  []entity.WorkTimeTotal
+		wantSessionCount int
+		wantErr          error
+	}{
+		{
+			name: "should sum work time by technician for a manager",
+			args: args{
+				params: ListWorkSessionsParams{
+					TaskID:   task.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+				},
+			},
+			wantTotal: (60 + 30 + 15) * 60,
+			wantTechnicians: []entity.WorkTimeTotal{
+				{UserID: technicianID, Seconds: 75 * 60},
+				{UserID: otherTechnicianID, Seconds: 30 * 60},
+			},
+			wantSessionCount: 3,
+			wantErr:          nil,
+		},
+		{
+			name: "should list work sessions for an assignee",
+			args: args{
+				params: ListWorkSessionsParams{
+					TaskID:   task.ID,
+					UserID:   otherTechnicianID,
+					UserRole: entity.RoleTechnician,
+				},
+			},
+			wantTotal: (60 + 30 + 15) * 60,
+			wantTechnicians: []entity.WorkTimeTotal{
+				{UserID: technicianID, Seconds: 75 * 60},
+				{UserID: otherTechnicianID, Seconds: 30 * 60},
+			},
+			wantSessionCount: 3,
+			wantErr:          nil,
+		},
+		{
+			name: "should not list work sessions if user is not the assignee",
+			args: args{
+				params: ListWorkSessionsParams{
+					TaskID:   task.ID,
+					UserID:   uuid.NewString(),
+					UserRole: entity.RoleTechnician,
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToViewTask,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			l := NewListWorkSessions(
+				val,
+				symCrypto,
+				taskRepo,
+				workSessionRepo,
+				inmemoryrepo.NewInMemoryProjectRepo(),
+			)
+
+			got, err := l.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"ListWorkSessions.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.TotalSeconds != tt.wantTotal {
+				t.Errorf(
+					"ListWorkSessions.Execute() total = %v, want %v",
+					got.TotalSeconds,
+					tt.wantTotal,
+				)
+			}
+
+			if !reflect.DeepEqual(got.Technicians, tt.wantTechnicians) {
+				t.Errorf(
+					"ListWorkSessions.Execute() technicians = %v, want %v",
+					got.Technicians,
+					tt.wantTechnicians,
+				)
+			}
+
+			if len(got.Sessions) != tt.wantSessionCount {
+				t.Fatalf(
+					"ListWorkSessions.Execute() sessions = %v, want %v",
+					len(got.Sessions),
+					tt.wantSessionCount,
+				)
+			}
+
+			if !got.Locked {
+				t.Errorf("ListWorkSessions.Execute() locked = false, want true")
+			}
+
+			if *got.Sessions[0].Note != "Replaced the compressor" {
+				t.Errorf(
+					"ListWorkSessions.Execute() note = %v, want decrypted",
+					*got.Sessions[0].Note,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type ListWorkTimeTotals struct {
+	validator       validator.Validator
+	workSessionRepo repo.WorkSessionRepo
+	projectRepo     repo.ProjectRepo
+}
+
+func NewListWorkTimeTotals(
+	validator validator.Validator,
+	workSessionRepo repo.WorkSessionRepo,
+	projectRepo repo.ProjectRepo,
+) *ListWorkTimeTotals {
+	return &ListWorkTimeTotals{
+		validator:       validator,
+		workSessionRepo: workSessionRepo,
+		projectRepo:     projectRepo,
+	}
+}
+
+// ListWorkTimeTotalsParams sums the work sessions started from From until
+// before To.
+type ListWorkTimeTotalsParams struct {
+	UserID   string      `json:"user_id,omitempty"   validate:"required,uuid"`
+	UserRole entity.Role `json:"user_role,omitempty" validate:"required,min=1,max=2"`
+	From     time.Time   `json:"from,omitempty"      validate:"required"`
+	To       time.Time   `json:"to,omitempty"        validate:"required,gtfield=From"`
+}
+
+type ListWorkTimeTotalsResult struct {
+	Data         []entity.WorkTimeTotal `json:"data"`
+	TotalSeconds int64                  `json:"total_seconds"`
+}
+
+// Execute returns the time worked by each technician in the period,
+// ordered by user ID. Managers see every technician, but only the time
+// worked on the tasks without a project or in the projects they are
+// members of, while technicians only see their own time.
+func (l *ListWorkTimeTotals) Execute(
+	ctx context.Context,
+	params ListWorkTimeTotalsParams,
+) (ListWorkTimeTotalsResult, error) {
+	if err := l.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return ListWorkTimeTotalsResult{}, validationErr
+	}
+
+	repoParams := repo.SumWorkTimeByUserParams{
+		StartedFrom: params.From,
+		StartedTo:   params.To,
+		Now:         time.Now(),
+	}
+
+	if params.UserRole == entity.RoleManager {
+		projects, err := l.projectRepo.ListProjectsByMemberUserID(
+			ctx,
+			params.UserID,
+		)
+		if err != nil {
+			return ListWorkTimeTotalsResult{}, entity.NewErr(err)
+		}
+
+		repoParams.ProjectScoped = true
+		repoParams.ScopeProjectIDs = make([]string, len(projects))
+		for i, project := range projects {
+			repoParams.ScopeProjectIDs[i] = project.ID
+		}
+	}
+
+	totals, err := l.workSessionRepo.SumWorkTimeByUser(ctx, repoParams)
+	if err != nil {
+		return ListWorkTimeTotalsResult{}, entity.NewErr(err)
+	}
+
+	result := ListWorkTimeTotalsResult{
+		Data: []entity.WorkTimeTotal{},
+	}
+
+	for _, total := range totals {
+		if params.UserRole != entity.RoleManager &&
+			total.UserID != params.UserID {
+			continue
+		}
+
+		result.Data = append(result.Data, total)
+		result.TotalSeconds += total.Seconds
+	}
+
+	return result, nil
+}
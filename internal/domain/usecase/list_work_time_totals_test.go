@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestListWorkTimeTotals_Execute(t *testing.T) {
+	val := validator.NewValidate()
+
+	managerID := uuid.NewString()
+	technicianID := "00000000-0000-4000-8000-000000000001"
+	otherTechnicianID := "00000000-0000-4000-8000-000000000002"
+
+	now := time.Now()
+	task := entity.Task{
+		ID:              uuid.NewString(),
+		Summary:         "Loren ipsum dolor sit amet",
+		Status:          entity.TaskStatusInProgress,
+		AssigneeIDs:     []string{technicianID, otherTechnicianID},
+		CreatedByUserID: managerID,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	deletedTask := task
+	deletedTask.ID = uuid.NewString()
+	deletedTask.DeletedAt = &now
+
+	project := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Maintenance",
+		ManagerUserID: managerID,
+	}
+	foreignProject := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Installation",
+		ManagerUserID: uuid.NewString(),
+	}
+	projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+	projectRepo.Projects = append(projectRepo.Projects, project, foreignProject)
+	projectRepo.Members = append(
+		projectRepo.Members,
+		entity.ProjectMember{ProjectID: project.ID, UserID: managerID},
+		entity.ProjectMember{
+			ProjectID: foreignProject.ID,
+			UserID:    foreignProject.ManagerUserID,
+		},
+	)
+
+	projectTask := task
+	projectTask.ID = uuid.NewString()
+	projectTask.ProjectID = &project.ID
+	foreignProjectTask := task
+	foreignProjectTask.ID = uuid.NewString()
+	foreignProjectTask.ProjectID = &foreignProject.ID
+
+	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+	taskRepo.Tasks = append(
+		taskRepo.Tasks,
+		task,
+		deletedTask,
+		projectTask,
+		foreignProjectTask,
+	)
+
+	from := now.Add(-24 * time.Hour)
+	to := now.Add(time.Hour)
+	newSession := func(
+		taskID string,
+		userID string,
+		startedAt time.Time,
+		duration time.Duration,
+	) entity.WorkSession {
+		endedAt := startedAt.Add(duration)
+		return entity.WorkSession{
+			ID:        uuid.NewString(),
+			TaskID:    taskID,
+			UserID:    userID,
+			StartedAt: startedAt,
+			EndedAt:   &endedAt,
+		}
+	}
+
+	workSessionRepo := inmemoryrepo.NewInMemoryWorkSessionRepo(taskRepo)
+	workSessionRepo.Sessions = append(
+		workSessionRepo.Sessions,
+		newSession(task.ID, technicianID, now.Add(-5*time.Hour), time.Hour),
+		newSession(task.ID, otherTechnicianID, now.Add(-4*time.Hour), 2*time.Hour),
+		// Started before the period.
+		newSession(task.ID, technicianID, from.Add(-time.Hour), 2*time.Hour),
+		// Logged on a deleted task.
+		newSession(deletedTask.ID, technicianID, now.Add(-3*time.Hour), time.Hour),
+		newSession(projectTask.ID, technicianID, now.Add(-2*time.Hour), 15*time.Minute),
+		// Logged on a task of a project the manager is not a member of.
+		newSession(foreignProjectTask.ID, otherTechnicianID, now.Add(-time.Hour), 30*time.Minute),
+	)
+
+	type args struct {
+		params ListWorkTimeTotalsParams
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      []entity.WorkTimeTotal
+		wantTotal int64
+		wantErr   error
+	}{
+		{
+			name: "should sum work time of every technician on the projects of a manager",
+			args: args{
+				params: ListWorkTimeTotalsParams{
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					From:     from,
+					To:       to,
+				},
+			},
+			want: []entity.WorkTimeTotal{
+				{UserID: technicianID, Seconds: 75 * 60},
+				{UserID: otherTechnicianID, Seconds: 2 * 60 * 60},
+			},
+			wantTotal: 195 * 60,
+			wantErr:   nil,
+		},
+		{
+			name: "should only sum own work time for a technician",
+			args: args{
+				params: ListWorkTimeTotalsParams{
+					UserID:   otherTechnicianID,
+					UserRole: entity.RoleTechnician,
+					From:     from,
+					To:       to,
+				},
+			},
+			want: []entity.WorkTimeTotal{
+				{UserID: otherTechnicianID, Seconds: 150 * 60},
+			},
+			wantTotal: 150 * 60,
+			wantErr:   nil,
+		},
+		{
+			name: "should not sum work time if period ends before it starts",
+			args: args{
+				params: ListWorkTimeTotalsParams{
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					From:     to,
+					To:       from,
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			l := NewListWorkTimeTotals(val, workSessionRepo, projectRepo)
+
+			got, err := l.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"ListWorkTimeTotals.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(got.Data, tt.want) {
+				t.Errorf(
+					"ListWorkTimeTotals.Execute() = %v, want %v",
+					got.Data,
+					tt.want,
+				)
+			}
+
+			if got.TotalSeconds != tt.wantTotal {
+				t.Errorf(
+					"ListWorkTimeTotals.Execute() total = %v, want %v",
+					got.TotalSeconds,
+					tt.wantTotal,
+				)
+			}
+		})
+	}
+}
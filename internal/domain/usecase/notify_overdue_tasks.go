@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/provider/broker"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+// notifyOverdueTasksBatchSize is how many overdue tasks are notified
+// on each run, the remaining ones are left for the next runs.
+const notifyOverdueTasksBatchSize = 100
+
+type NotifyOverdueTasks struct {
+	symCrypto symcrypt.SymmetricalEncrypter
+	msgBroker broker.MessageBroker
+	taskRepo  repo.TaskRepo
+	tx        transactioner.Transactioner
+}
+
+func NewNotifyOverdueTasks(
+	symCrypto symcrypt.SymmetricalEncrypter,
+	msgBroker broker.MessageBroker,
+	taskRepo repo.TaskRepo,
+	tx transactioner.Transactioner,
+) *NotifyOverdueTasks {
+	return &NotifyOverdueTasks{
+		symCrypto: symCrypto,
+		msgBroker: msgBroker,
+		taskRepo:  taskRepo,
+		tx:        tx,
+	}
+}
+
+// Execute publishes a task overdue message for the tasks that became
+// overdue at the given time and returns how many were published. Each
+// task is notified once per due date: it is marked in the same
+// transaction that publishes its message, so when several replicas run
+// at once only one of them notifies it.
+func (n *NotifyOverdueTasks) Execute(
+	ctx context.Context,
+	now time.Time,
+) (int, error) {
+	tasks, err := n.taskRepo.ListOverdueTasksToNotify(
+		ctx,
+		now,
+		notifyOverdueTasksBatchSize,
+	)
+	if err != nil {
+		return 0, entity.NewErr(err)
+	}
+
+	var count int
+	var errs error
+	for _, task := range tasks {
+		notified, err := n.notify(ctx, task, now)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+
+		if notified {
+			count++
+		}
+	}
+
+	if errs != nil {
+		return count, entity.NewErr(errs)
+	}
+
+	return count, nil
+}
+
+func (n *NotifyOverdueTasks) notify(
+	ctx context.Context,
+	task entity.Task,
+	now time.Time,
+) (notified bool, err error) {
+	task, err = decryptTask(n.symCrypto, task)
+	if err != nil {
+		return false, entity.NewErr(err)
+	}
+	task.Overdue = true
+
+	taskBytes, err := json.Marshal(task)
+	if err != nil {
+		return false, entity.NewErr(err)
+	}
+
+	err = n.tx.Do(ctx, func(ctx context.Context) error {
+		notified, err = n.taskRepo.MarkTaskOverdueNotified(ctx, task.ID, now)
+		if err != nil || !notified {
+			return err
+		}
+
+		return publishAfterCommit(
+			ctx,
+			n.msgBroker,
+			broker.TopicTaskOverdue,
+			taskBytes,
+		)
+	})
+	if err != nil {
+		return false, entity.NewErr(err)
+	}
+
+	return notified, nil
+}
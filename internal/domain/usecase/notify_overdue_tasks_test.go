@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/broker"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/google/uuid"
+)
+
+func TestNotifyOverdueTasks_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	encryptedSummary, err := symCrypto.Encrypt("Replace the air filters")
+	if err != nil {
+		t.Fatalf("could not encrypt summary")
+	}
+
+	now := time.Date(2026, 10, 19, 8, 30, 0, 0, time.UTC)
+	pastDueAt := now.Add(-time.Hour)
+	futureDueAt := now.Add(time.Hour)
+
+	newTask := func(status entity.TaskStatus, dueAt *time.Time) entity.Task {
+		return entity.Task{
+			ID:              uuid.NewString(),
+			Summary:         encryptedSummary,
+			Status:          status,
+			CreatedByUserID: uuid.NewString(),
+			DueAt:           dueAt,
+			Priority:        entity.TaskPriorityNormal,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		task      entity.Task
+		notified  bool
+		wantCount int
+	}{
+		{
+			name:      "should notify an open task past its due date",
+			task:      newTask(entity.TaskStatusOpen, &pastDueAt),
+			wantCount: 1,
+		},
+		{
+			name:      "should not notify a task before its due date",
+			task:      newTask(entity.TaskStatusOpen, &futureDueAt),
+			wantCount: 0,
+		},
+		{
+			name:      "should not notify a task without due date",
+			task:      newTask(entity.TaskStatusOpen, nil),
+			wantCount: 0,
+		},
+		{
+			name:      "should not notify a done task",
+			task:      newTask(entity.TaskStatusDone, &pastDueAt),
+			wantCount: 0,
+		},
+		{
+			name:      "should not notify a task already notified by another replica",
+			task:      newTask(entity.TaskStatusInProgress, &pastDueAt),
+			notified:  true,
+			wantCount: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+			taskRepo.Tasks = append(taskRepo.Tasks, tt.task)
+			if tt.notified {
+				taskRepo.OverdueNotifiedAt[tt.task.ID] = pastDueAt
+			}
+
+			msgBroker := &recordingBroker{}
+			n := NewNotifyOverdueTasks(
+				symCrypto,
+				msgBroker,
+				taskRepo,
+				transactioner.NewNoopTransactioner(),
+			)
+
+			// Running twice must not notify the task again.
+			for range 2 {
+				count, err := n.Execute(context.Background(), now)
+				if err != nil {
+					t.Fatalf("NotifyOverdueTasks.Execute() error = %v", err)
+				}
+				if count > tt.wantCount {
+					t.Errorf(
+						"NotifyOverdueTasks.Execute() = %v, want %v",
+						count,
+						tt.wantCount,
+					)
+				}
+			}
+
+			if len(msgBroker.topics) != tt.wantCount {
+				t.Fatalf(
+					"NotifyOverdueTasks.Execute() published = %v, want %v",
+					len(msgBroker.topics),
+					tt.wantCount,
+				)
+			}
+
+			for _, topic := range msgBroker.topics {
+				if topic != broker.TopicTaskOverdue {
+					t.Errorf(
+						"NotifyOverdueTasks.Execute() topic = %v, want %v",
+						topic,
+						broker.TopicTaskOverdue,
+					)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+// ensureProjectMember returns the project, or ErrProjectNotFound if it
+// does not exist and ErrUserNotProjectMember if the user is not one of
+// its members.
+func ensureProjectMember(
+	ctx context.Context,
+	projectRepo repo.ProjectRepo,
+	projectID string,
+	userID string,
+) (entity.Project, error) {
+	project, err := projectRepo.GetProjectByID(ctx, projectID)
+	if err != nil {
+		return entity.Project{}, entity.NewErr(err)
+	}
+
+	if project.ID == "" {
+		return entity.Project{}, entity.ErrProjectNotFound
+	}
+
+	member, err := projectRepo.GetProjectMember(ctx, projectID, userID)
+	if err != nil {
+		return entity.Project{}, entity.NewErr(err)
+	}
+
+	if member.UserID == "" {
+		return entity.Project{}, entity.ErrUserNotProjectMember
+	}
+
+	return project, nil
+}
+
+// ensureTaskProjectMember checks that the user is a member of the task
+// project. Tasks without a project can be managed by every manager.
+func ensureTaskProjectMember(
+	ctx context.Context,
+	projectRepo repo.ProjectRepo,
+	task entity.Task,
+	userID string,
+) error {
+	if task.ProjectID == nil {
+		return nil
+	}
+
+	_, err := ensureProjectMember(ctx, projectRepo, *task.ProjectID, userID)
+	return err
+}
+
+// ensureTaskVisible checks that the user can see the task and what is
+// attached to it. Technicians get notVisibleErr for the tasks they are
+// not assigned to, and managers ErrUserNotProjectMember for the tasks of
+// the projects they are not members of.
+func ensureTaskVisible(
+	ctx context.Context,
+	projectRepo repo.ProjectRepo,
+	task entity.Task,
+	userID string,
+	role entity.Role,
+	notVisibleErr error,
+) error {
+	if !task.IsVisibleTo(userID, role) {
+		return notVisibleErr
+	}
+
+	if role != entity.RoleManager {
+		return nil
+	}
+
+	return ensureTaskProjectMember(ctx, projectRepo, task, userID)
+}
+
+// ensureProjectManager returns the project, or ErrProjectNotFound if it
+// does not exist and ErrUserNotProjectManager if the user is not its
+// project manager.
+func ensureProjectManager(
+	ctx context.Context,
+	projectRepo repo.ProjectRepo,
+	projectID string,
+	userID string,
+) (entity.Project, error) {
+	project, err := projectRepo.GetProjectByID(ctx, projectID)
+	if err != nil {
+		return entity.Project{}, entity.NewErr(err)
+	}
+
+	if project.ID == "" {
+		return entity.Project{}, entity.ErrProjectNotFound
+	}
+
+	if project.ManagerUserID != userID {
+		return entity.Project{}, entity.ErrUserNotProjectManager
+	}
+
+	return project, nil
+}
+
+// projectScopeOption returns the option that leaves out of a task
+// listing the tasks of the projects the user is not a member of.
+func projectScopeOption(
+	ctx context.Context,
+	projectRepo repo.ProjectRepo,
+	userID string,
+) (repo.ListTasksOption, error) {
+	projects, err := projectRepo.ListProjectsByMemberUserID(ctx, userID)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	projectIDs := make([]string, len(projects))
+	for i, project := range projects {
+		projectIDs[i] = project.ID
+	}
+
+	return repo.WithProjectScope(projectIDs...), nil
+}
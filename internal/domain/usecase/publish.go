@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/provider/broker"
+)
+
+// publishAfterCommit publishes the message once the transaction in ctx
+// commits, so subscribers never see changes that were rolled back.
+func publishAfterCommit(
+	ctx context.Context,
+	msgBroker broker.MessageBroker,
+	topic broker.Topic,
+	message []byte,
+) error {
+	return transactioner.AfterCommit(ctx, func() error {
+		return msgBroker.Publish(topic, message)
+	})
+}
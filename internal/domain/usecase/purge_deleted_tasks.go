@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type PurgeDeletedTasks struct {
+	validator validator.Validator
+	taskRepo  repo.TaskRepo
+}
+
+func NewPurgeDeletedTasks(
+	validator validator.Validator,
+	taskRepo repo.TaskRepo,
+) *PurgeDeletedTasks {
+	return &PurgeDeletedTasks{
+		validator: validator,
+		taskRepo:  taskRepo,
+	}
+}
+
+type PurgeDeletedTasksParams struct {
+	RetentionPeriod time.Duration `json:"retention_period,omitempty" validate:"required,min=1h"`
+}
+
+// Execute permanently deletes the tasks that have been in the trash
+// for longer than the retention period, returning how many were purged.
+func (p *PurgeDeletedTasks) Execute(
+	ctx context.Context,
+	params PurgeDeletedTasksParams,
+) (int64, error) {
+	if err := p.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return 0, validationErr
+	}
+
+	deletedBefore := time.Now().Add(-params.RetentionPeriod)
+
+	count, err := p.taskRepo.PurgeDeletedTasks(ctx, deletedBefore)
+	if err != nil {
+		return 0, entity.NewErr(err)
+	}
+
+	return count, nil
+}
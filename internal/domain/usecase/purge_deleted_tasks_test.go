@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestPurgeDeletedTasks_Execute(t *testing.T) {
+	newTaskRepo := func() *inmemoryrepo.InMemoryTaskRepo {
+		taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+
+		newTask := func(deletedAt *time.Time) entity.Task {
+			return entity.Task{
+				ID:              uuid.NewString(),
+				Summary:         "Loren ipsum dolor sit amet",
+				CreatedByUserID: uuid.NewString(),
+				CreatedAt:       time.Now(),
+				UpdatedAt:       time.Now(),
+				DeletedAt:       deletedAt,
+			}
+		}
+
+		longAgo := time.Now().Add(-time.Hour * 24 * 60)
+		recently := time.Now().Add(-time.Hour)
+
+		taskRepo.Tasks = append(
+			taskRepo.Tasks,
+			newTask(nil),
+			newTask(&longAgo),
+			newTask(&recently),
+		)
+
+		return taskRepo
+	}
+
+	type args struct {
+		params PurgeDeletedTasksParams
+	}
+	tests := []struct {
+		name      string
+		taskRepo  *inmemoryrepo.InMemoryTaskRepo
+		args      args
+		wantCount int64
+		wantTasks int
+		wantErr   error
+	}{
+		{
+			name:     "should purge tasks deleted before the retention period",
+			taskRepo: newTaskRepo(),
+			args: args{
+				params: PurgeDeletedTasksParams{
+					RetentionPeriod: time.Hour * 24 * 30,
+				},
+			},
+			wantCount: 1,
+			wantTasks: 2,
+			wantErr:   nil,
+		},
+		{
+			name:     "should not purge tasks if retention period is not set",
+			taskRepo: newTaskRepo(),
+			args: args{
+				params: PurgeDeletedTasksParams{},
+			},
+			wantCount: 0,
+			wantTasks: 3,
+			wantErr:   entity.ErrValidation,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := NewPurgeDeletedTasks(validator.NewValidate(), tt.taskRepo)
+
+			count, err := p.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"PurgeDeletedTasks.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if count != tt.wantCount {
+				t.Errorf(
+					"PurgeDeletedTasks.Execute() count = %v, want %v",
+					count,
+					tt.wantCount,
+				)
+			}
+
+			if len(tt.taskRepo.Tasks) != tt.wantTasks {
+				t.Errorf(
+					"PurgeDeletedTasks.Execute() tasks = %v, want %v",
+					len(tt.taskRepo.Tasks),
+					tt.wantTasks,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+type ReassignTask struct {
+	validator     validator.Validator
+	taskRepo      repo.TaskRepo
+	projectRepo   repo.ProjectRepo
+	userRepo      repo.UserRepo
+	taskEventRepo repo.TaskEventRepo
+	tx            transactioner.Transactioner
+}
+
+func NewReassignTask(
+	validator validator.Validator,
+	taskRepo repo.TaskRepo,
+	projectRepo repo.ProjectRepo,
+	userRepo repo.UserRepo,
+	taskEventRepo repo.TaskEventRepo,
+	tx transactioner.Transactioner,
+) *ReassignTask {
+	return &ReassignTask{
+		validator:     validator,
+		taskRepo:      taskRepo,
+		projectRepo:   projectRepo,
+		userRepo:      userRepo,
+		taskEventRepo: taskEventRepo,
+		tx:            tx,
+	}
+}
+
+type ReassignTaskParams struct {
+	TaskID           string      `json:"task_id,omitempty"             validate:"required,uuid"`
+	UserID           string      `json:"user_id,omitempty"             validate:"required,uuid"`
+	UserRole         entity.Role `json:"role,omitempty"                validate:"required,min=1,max=2"`
+	AssignedToUserID string      `json:"assigned_to_user_id,omitempty" validate:"required,uuid"`
+}
+
+// Execute makes another technician the only assignee of the task,
+// keeping the rest of the task as it is.
+func (r *ReassignTask) Execute(
+	ctx context.Context,
+	params ReassignTaskParams,
+) error {
+	if params.UserRole != entity.RoleManager {
+		return entity.ErrUserNotAllowedToUpdateAssignedUser
+	}
+
+	if err := r.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return validationErr
+	}
+
+	task, err := r.taskRepo.GetTaskByID(ctx, params.TaskID)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if task.ID == "" {
+		return entity.ErrTaskNotFound
+	}
+
+	if err := ensureTaskProjectMember(
+		ctx,
+		r.projectRepo,
+		task,
+		params.UserID,
+	); err != nil {
+		return err
+	}
+
+	assignedToUser, err := r.userRepo.GetUserByID(ctx, params.AssignedToUserID)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if assignedToUser.ID == "" {
+		return entity.ErrAssignToUserNotFound
+	}
+
+	if assignedToUser.Role != entity.RoleTechnician {
+		return entity.ErrInvalidRoleForAssignedUser
+	}
+
+	updatedTask := withAssignees(task, []string{assignedToUser.ID})
+
+	repoParams := repo.UpdateTaskParams{}
+	if err := copier.Copy(&repoParams, updatedTask); err != nil {
+		return entity.NewErr(err)
+	}
+
+	err = r.tx.Do(ctx, func(ctx context.Context) error {
+		if err := r.taskRepo.UpdateTask(ctx, repoParams); err != nil {
+			return entity.NewErr(err)
+		}
+
+		if err := r.taskRepo.SetTaskAssignees(
+			ctx,
+			task.ID,
+			updatedTask.AssigneeIDs,
+		); err != nil {
+			return entity.NewErr(err)
+		}
+
+		return recordTaskEvent(
+			ctx,
+			r.taskEventRepo,
+			entity.TaskEventUpdated,
+			params.UserID,
+			task,
+			updatedTask,
+		)
+	})
+
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/robfig/cron/v3"
+)
+
+// parseRecurrenceSchedule parses a standard cron expression, such as
+// "0 8 * * MON", or a descriptor, such as "@weekly". Schedules run in
+// UTC unless prefixed with a time zone, as in "CRON_TZ=America/Sao_Paulo".
+func parseRecurrenceSchedule(schedule string) (cron.Schedule, error) {
+	parsedSchedule, err := cron.ParseStandard(schedule)
+	if err != nil {
+		return nil, entity.ErrInvalidRecurrenceSchedule
+	}
+
+	return parsedSchedule, nil
+}
+
+// nextRecurrence returns the first run of the schedule after the given
+// time.
+func nextRecurrence(schedule cron.Schedule, after time.Time) time.Time {
+	return schedule.Next(after.UTC())
+}
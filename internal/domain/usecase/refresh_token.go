@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/tenant"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type RefreshToken struct {
+	val              validator.Validator
+	jwt              jwtutil.JWTManager
+	organizationRepo repo.OrganizationRepo
+	userRepo         repo.UserRepo
+}
+
+func NewRefreshToken(
+	val validator.Validator,
+	jwt jwtutil.JWTManager,
+	organizationRepo repo.OrganizationRepo,
+	userRepo repo.UserRepo,
+) *RefreshToken {
+	return &RefreshToken{
+		val:              val,
+		jwt:              jwt,
+		organizationRepo: organizationRepo,
+		userRepo:         userRepo,
+	}
+}
+
+type RefreshTokenParams struct {
+	RefreshToken string `json:"refresh_token,omitempty" validate:"required"`
+}
+
+func (r *RefreshToken) Execute(
+	ctx context.Context,
+	params RefreshTokenParams,
+) (accessToken, refreshToken string, err error) {
+	if err = r.val.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return "", "", validationErr
+	}
+
+	claims, err := r.jwt.ValidateRefreshToken(params.RefreshToken)
+	if err != nil {
+		return "", "", entity.ErrInvalidRefreshToken
+	}
+
+	if claims.Subject == "" {
+		return "", "", entity.ErrInvalidRefreshToken
+	}
+
+	organizationID, err := r.organizationRepo.GetOrganizationIDByUserID(
+		ctx,
+		claims.Subject,
+	)
+	if err != nil {
+		return "", "", entity.NewErr(err)
+	}
+
+	if organizationID == "" {
+		return "", "", entity.ErrInvalidRefreshToken
+	}
+
+	ctx = tenant.WithOrganizationID(ctx, organizationID)
+
+	user, err := r.userRepo.GetUserByID(ctx, claims.Subject)
+	if err != nil {
+		return "", "", entity.NewErr(err)
+	}
+
+	if user.ID == "" {
+		return "", "", entity.ErrInvalidRefreshToken
+	}
+
+	return issueTokens(r.jwt, user)
+}
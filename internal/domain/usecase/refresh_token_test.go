@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/jwtutil"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+func TestRefreshToken_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	j := jwtutil.NewJWT(env)
+
+	userRepo := inmemoryrepo.NewInMemoryUserRepo()
+
+	user := entity.User{
+		ID:             uuid.NewString(),
+		OrganizationID: uuid.NewString(),
+		Role:           entity.RoleTechnician,
+		Name:           "John Doe",
+		Email:          "johndoe@email.com",
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	userRepo.Users = append(
+		userRepo.Users,
+		user,
+	)
+
+	organizationRepo := inmemoryrepo.NewInMemoryOrganizationRepo(userRepo, nil)
+
+	accessToken, refreshToken, err := issueTokens(j, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unknownUserRefreshToken, err := j.NewRefreshToken(jwt.RegisteredClaims{
+		Subject:   uuid.NewString(),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expiredRefreshToken, err := j.NewRefreshToken(jwt.RegisteredClaims{
+		Subject:   user.ID,
+		IssuedAt:  jwt.NewNumericDate(time.Now().Add(-time.Hour * 2)),
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Hour)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type fields struct {
+		val              validator.Validator
+		jwt              jwtutil.JWTManager
+		organizationRepo *inmemoryrepo.InMemoryOrganizationRepo
+		userRepo         *inmemoryrepo.InMemoryUserRepo
+	}
+	type args struct {
+		params RefreshTokenParams
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "should refresh tokens",
+			fields: fields{
+				val:              val,
+				jwt:              j,
+				organizationRepo: organizationRepo,
+				userRepo:         userRepo,
+			},
+			args: args{
+				params: RefreshTokenParams{
+					RefreshToken: refreshToken,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should not refresh tokens without refresh token",
+			fields: fields{
+				val:              val,
+				jwt:              j,
+				organizationRepo: organizationRepo,
+				userRepo:         userRepo,
+			},
+			args: args{
+				params: RefreshTokenParams{},
+			},
+			wantErr: entity.ErrValidation,
+		},
+		{
+			name: "should not refresh tokens with malformed refresh token",
+			fields: fields{
+				val:              val,
+				jwt:              j,
+				organizationRepo: organizationRepo,
+				userRepo:         userRepo,
+			},
+			args: args{
+				params: RefreshTokenParams{
+					RefreshToken: "invalid-refresh-token",
+				},
+			},
+			wantErr: entity.ErrInvalidRefreshToken,
+		},
+		{
+			name: "should not refresh tokens with expired refresh token",
+			fields: fields{
+				val:              val,
+				jwt:              j,
+				organizationRepo: organizationRepo,
+				userRepo:         userRepo,
+			},
+			args: args{
+				params: RefreshTokenParams{
+					RefreshToken: expiredRefreshToken,
+				},
+			},
+			wantErr: entity.ErrInvalidRefreshToken,
+		},
+		{
+			name: "should not refresh tokens with an access token",
+			fields: fields{
+				val:              val,
+				jwt:              j,
+				organizationRepo: organizationRepo,
+				userRepo:         userRepo,
+			},
+			args: args{
+				params: RefreshTokenParams{
+					RefreshToken: accessToken,
+				},
+			},
+			wantErr: entity.ErrInvalidRefreshToken,
+		},
+		{
+			name: "should not refresh tokens of non-existing user",
+			fields: fields{
+				val:              val,
+				jwt:              j,
+				organizationRepo: organizationRepo,
+				userRepo:         userRepo,
+			},
+			args: args{
+				params: RefreshTokenParams{
+					RefreshToken: unknownUserRefreshToken,
+				},
+			},
+			wantErr: entity.ErrInvalidRefreshToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := NewRefreshToken(
+				tt.fields.val,
+				tt.fields.jwt,
+				tt.fields.organizationRepo,
+				tt.fields.userRepo,
+			)
+
+			gotAccessToken, gotRefreshToken, err := r.Execute(
+				context.Background(),
+				tt.args.params,
+			)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"RefreshToken.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+				return
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if gotAccessToken == "" {
+				t.Errorf(
+					"RefreshToken.Execute() gotAccessToken = %v, want not empty",
+					gotAccessToken,
+				)
+			}
+
+			if gotRefreshToken == "" {
+				t.Errorf(
+					"RefreshToken.Execute() gotRefreshToken = %v, want not empty",
+					gotRefreshToken,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type RemoveProjectMember struct {
+	validator   validator.Validator
+	projectRepo repo.ProjectRepo
+}
+
+func NewRemoveProjectMember(
+	validator validator.Validator,
+	projectRepo repo.ProjectRepo,
+) *RemoveProjectMember {
+	return &RemoveProjectMember{
+		validator:   validator,
+		projectRepo: projectRepo,
+	}
+}
+
+type RemoveProjectMemberParams struct {
+	ProjectID    string      `json:"project_id,omitempty"     validate:"required,uuid"`
+	UserRole     entity.Role `json:"user_role,omitempty"      validate:"required,min=1,max=2"`
+	UserID       string      `json:"user_id,omitempty"        validate:"required,uuid"`
+	MemberUserID string      `json:"member_user_id,omitempty" validate:"required,uuid"`
+}
+
+// Execute removes the user from the project members, which only the
+// project manager can do. The project manager can not be removed, the
+// project must be handed over to another member first.
+func (r *RemoveProjectMember) Execute(
+	ctx context.Context,
+	params RemoveProjectMemberParams,
+) error {
+	if params.UserRole != entity.RoleManager {
+		return entity.ErrUserNotAllowedToManageProjects
+	}
+
+	if err := r.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return validationErr
+	}
+
+	project, err := ensureProjectManager(
+		ctx,
+		r.projectRepo,
+		params.ProjectID,
+		params.UserID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if params.MemberUserID == project.ManagerUserID {
+		return entity.ErrProjectManagerCannotBeRemoved
+	}
+
+	member, err := r.projectRepo.GetProjectMember(
+		ctx,
+		project.ID,
+		params.MemberUserID,
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if member.UserID == "" {
+		return entity.ErrProjectMemberNotFound
+	}
+
+	if err := r.projectRepo.RemoveProjectMember(
+		ctx,
+		project.ID,
+		member.UserID,
+	); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type RemoveTaskDependency struct {
+	validator          validator.Validator
+	taskRepo           repo.TaskRepo
+	projectRepo        repo.ProjectRepo
+	taskDependencyRepo repo.TaskDependencyRepo
+}
+
+func NewRemoveTaskDependency(
+	validator validator.Validator,
+	taskRepo repo.TaskRepo,
+	projectRepo repo.ProjectRepo,
+	taskDependencyRepo repo.TaskDependencyRepo,
+) *RemoveTaskDependency {
+	return &RemoveTaskDependency{
+		validator:          validator,
+		taskRepo:           taskRepo,
+		projectRepo:        projectRepo,
+		taskDependencyRepo: taskDependencyRepo,
+	}
+}
+
+type RemoveTaskDependencyParams struct {
+	TaskID          string      `json:"task_id,omitempty"            validate:"required,uuid"`
+	BlockedByTaskID string      `json:"blocked_by_task_id,omitempty" validate:"required,uuid"`
+	UserID          string      `json:"user_id,omitempty"            validate:"required,uuid"`
+	UserRole        entity.Role `json:"role,omitempty"               validate:"required,min=1,max=2"`
+}
+
+func (r *RemoveTaskDependency) Execute(
+	ctx context.Context,
+	params RemoveTaskDependencyParams,
+) error {
+	if params.UserRole != entity.RoleManager {
+		return entity.ErrUserNotAllowedToEditTaskDependencies
+	}
+
+	if err := r.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return validationErr
+	}
+
+	task, err := r.taskRepo.GetTaskByID(ctx, params.TaskID)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if task.ID == "" {
+		return entity.ErrTaskNotFound
+	}
+
+	if err := ensureTaskProjectMember(
+		ctx,
+		r.projectRepo,
+		task,
+		params.UserID,
+	); err != nil {
+		return err
+	}
+
+	blockers, err := r.taskDependencyRepo.ListTaskBlockers(ctx, params.TaskID)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if !slices.ContainsFunc(blockers, func(d entity.TaskDependency) bool {
+		return d.BlockedByTaskID == params.BlockedByTaskID
+	}) {
+		return entity.ErrTaskDependencyNotFound
+	}
+
+	if err := r.taskDependencyRepo.DeleteTaskDependency(
+		ctx,
+		repo.TaskDependencyParams{
+			TaskID:          params.TaskID,
+			BlockedByTaskID: params.BlockedByTaskID,
+		},
+	); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/broker"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+type ReopenTask struct {
+	validator     validator.Validator
+	symCrypto     symcrypt.SymmetricalEncrypter
+	msgBroker     broker.MessageBroker
+	taskRepo      repo.TaskRepo
+	projectRepo   repo.ProjectRepo
+	taskEventRepo repo.TaskEventRepo
+	tx            transactioner.Transactioner
+}
+
+func NewReopenTask(
+	validator validator.Validator,
+	symCrypto symcrypt.SymmetricalEncrypter,
+	msgBroker broker.MessageBroker,
+	taskRepo repo.TaskRepo,
+	projectRepo repo.ProjectRepo,
+	taskEventRepo repo.TaskEventRepo,
+	tx transactioner.Transactioner,
+) *ReopenTask {
+	return &ReopenTask{
+		validator:     validator,
+		symCrypto:     symCrypto,
+		msgBroker:     msgBroker,
+		taskRepo:      taskRepo,
+		projectRepo:   projectRepo,
+		taskEventRepo: taskEventRepo,
+		tx:            tx,
+	}
+}
+
+type ReopenTaskParams struct {
+	TaskID   string      `json:"task_id,omitempty" validate:"required,uuid"`
+	UserID   string      `json:"user_id,omitempty" validate:"required,uuid"`
+	UserRole entity.Role `json:"role,omitempty"    validate:"required,min=1,max=2"`
+	Reason   string      `json:"reason,omitempty"  validate:"required,max=2500"`
+}
+
+func (r *ReopenTask) Execute(
+	ctx context.Context,
+	params ReopenTaskParams,
+) error {
+	if err := r.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return validationErr
+	}
+
+	task, err := r.taskRepo.GetTaskByID(ctx, params.TaskID)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if task.ID == "" {
+		return entity.ErrTaskNotFound
+	}
+
+	if params.UserRole != entity.RoleManager &&
+		!task.IsAssignedTo(params.UserID) {
+		return entity.ErrUserNotAllowedToReopenTask
+	}
+
+	if params.UserRole == entity.RoleManager {
+		if err := ensureTaskProjectMember(
+			ctx,
+			r.projectRepo,
+			task,
+			params.UserID,
+		); err != nil {
+			return err
+		}
+	}
+
+	if task.FinishedAt == nil {
+		return entity.ErrTaskNotFinished
+	}
+
+	encryptedReason, err := r.symCrypto.Encrypt(params.Reason)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	previousTask := task
+
+	reopenedAt := time.Now()
+	task.FinishedAt = nil
+	task.Status = entity.TaskStatusOpen
+	task.ReopenReason = &encryptedReason
+	task.ReopenedAt = &reopenedAt
+	task.SignedOffUserIDs = nil
+
+	var repoParams repo.UpdateTaskParams
+	if err = copier.Copy(&repoParams, task); err != nil {
+		return entity.NewErr(err)
+	}
+
+	err = r.tx.Do(ctx, func(ctx context.Context) error {
+		if err := r.taskRepo.UpdateTask(ctx, repoParams); err != nil {
+			return entity.NewErr(err)
+		}
+
+		if err := r.taskRepo.ClearTaskSignOffs(ctx, task.ID); err != nil {
+			return entity.NewErr(err)
+		}
+
+		if err := recordTaskEvent(
+			ctx,
+			r.taskEventRepo,
+			entity.TaskEventReopened,
+			params.UserID,
+			previousTask,
+			task,
+		); err != nil {
+			return err
+		}
+
+		task.UpdatedAt = time.Now()
+
+		taskBytes, err := json.Marshal(task)
+		if err != nil {
+			return entity.NewErr(err)
+		}
+
+		if err := publishAfterCommit(
+			ctx,
+			r.msgBroker,
+			broker.TopicTaskReopened,
+			taskBytes,
+		); err != nil {
+			return entity.NewErr(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
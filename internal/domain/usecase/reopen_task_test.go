@@ -0,0 +1,267 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/broker/clibroker"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestReopenTask_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	managerID := uuid.NewString()
+	technicianID := uuid.NewString()
+	otherTechnicianID := uuid.NewString()
+
+	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+
+	newTask := func(finished bool) entity.Task {
+		task := entity.Task{
+			ID:               uuid.NewString(),
+			Summary:          "Loren ipsum dolor sit amet",
+			Status:           entity.TaskStatusOpen,
+			AssignedToUserID: &technicianID,
+			CreatedByUserID:  managerID,
+			CreatedAt:        time.Now(),
+			UpdatedAt:        time.Now(),
+		}
+		if finished {
+			finishedAt := time.Now()
+			task.Status = entity.TaskStatusDone
+			task.FinishedAt = &finishedAt
+		}
+		taskRepo.Tasks = append(taskRepo.Tasks, task)
+		return task
+	}
+
+	finishedTask := newTask(true)
+	otherFinishedTask := newTask(true)
+	openTask := newTask(false)
+
+	project := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Maintenance",
+		ManagerUserID: uuid.NewString(),
+	}
+	projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+	projectRepo.Projects = append(projectRepo.Projects, project)
+
+	projectTask := newTask(true)
+	taskRepo.Tasks[len(taskRepo.Tasks)-1].ProjectID = &project.ID
+
+	type fields struct {
+		validator validator.Validator
+		msgBroker *clibroker.CLIMessageBroker
+		taskRepo  *inmemoryrepo.InMemoryTaskRepo
+	}
+	type args struct {
+		params ReopenTaskParams
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "should not reopen task if user is not the assignee",
+			fields: fields{
+				validator: val,
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ReopenTaskParams{
+					TaskID:   finishedTask.ID,
+					UserID:   otherTechnicianID,
+					UserRole: entity.RoleTechnician,
+					Reason:   "Still failing",
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToReopenTask,
+		},
+		{
+			name: "should not reopen task without a reason",
+			fields: fields{
+				validator: val,
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ReopenTaskParams{
+					TaskID:   finishedTask.ID,
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+		{
+			name: "should not reopen task if reason is too long",
+			fields: fields{
+				validator: val,
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ReopenTaskParams{
+					TaskID:   finishedTask.ID,
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+					Reason:   strings.Repeat("a", 2501),
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+		{
+			name: "should reopen task if user is the assignee",
+			fields: fields{
+				validator: val,
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ReopenTaskParams{
+					TaskID:   finishedTask.ID,
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+					Reason:   "Still failing",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should reopen task if user is a manager",
+			fields: fields{
+				validator: val,
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ReopenTaskParams{
+					TaskID:   otherFinishedTask.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					Reason:   "Customer reported it again",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should not reopen task if it is not finished",
+			fields: fields{
+				validator: val,
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ReopenTaskParams{
+					TaskID:   openTask.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					Reason:   "Still failing",
+				},
+			},
+			wantErr: entity.ErrTaskNotFinished,
+		},
+		{
+			name: "should not reopen task if task does not exists",
+			fields: fields{
+				validator: val,
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ReopenTaskParams{
+					TaskID:   uuid.NewString(),
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					Reason:   "Still failing",
+				},
+			},
+			wantErr: entity.ErrTaskNotFound,
+		},
+		{
+			name: "should not reopen task of a project the user is not a member of",
+			fields: fields{
+				validator: val,
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: ReopenTaskParams{
+					TaskID:   projectTask.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					Reason:   "Still failing",
+				},
+			},
+			wantErr: entity.ErrUserNotProjectMember,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewReopenTask(
+				tt.fields.validator,
+				symCrypto,
+				tt.fields.msgBroker,
+				tt.fields.taskRepo,
+				projectRepo,
+				inmemoryrepo.NewInMemoryTaskEventRepo(),
+				transactioner.NewNoopTransactioner(),
+			)
+
+			err := r.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"ReopenTask.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			task, _ := tt.fields.taskRepo.GetTaskByID(
+				context.Background(),
+				tt.args.params.TaskID,
+			)
+
+			if task.Status != entity.TaskStatusOpen || task.FinishedAt != nil {
+				t.Errorf(
+					"ReopenTask.Execute() task status = %v, finished at = %v",
+					task.Status,
+					task.FinishedAt,
+				)
+			}
+
+			if task.ReopenedAt == nil || task.ReopenReason == nil {
+				t.Fatalf("ReopenTask.Execute() reopen fields not set")
+			}
+
+			reason, err := symCrypto.Decrypt(*task.ReopenReason)
+			if err != nil || reason != tt.args.params.Reason {
+				t.Errorf(
+					"ReopenTask.Execute() reason = %v, want %v",
+					reason,
+					tt.args.params.Reason,
+				)
+			}
+		})
+	}
+}
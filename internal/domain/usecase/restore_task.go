@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type RestoreTask struct {
+	validator     validator.Validator
+	taskRepo      repo.TaskRepo
+	projectRepo   repo.ProjectRepo
+	taskEventRepo repo.TaskEventRepo
+	tx            transactioner.Transactioner
+}
+
+func NewRestoreTask(
+	validator validator.Validator,
+	taskRepo repo.TaskRepo,
+	projectRepo repo.ProjectRepo,
+	taskEventRepo repo.TaskEventRepo,
+	tx transactioner.Transactioner,
+) *RestoreTask {
+	return &RestoreTask{
+		validator:     validator,
+		taskRepo:      taskRepo,
+		projectRepo:   projectRepo,
+		taskEventRepo: taskEventRepo,
+		tx:            tx,
+	}
+}
+
+type RestoreTaskParams struct {
+	TaskID   string      `json:"task_id,omitempty" validate:"required,uuid"`
+	UserID   string      `json:"user_id,omitempty" validate:"required,uuid"`
+	UserRole entity.Role `json:"role,omitempty"    validate:"required,min=1,max=2"`
+}
+
+func (r *RestoreTask) Execute(
+	ctx context.Context,
+	params RestoreTaskParams,
+) error {
+	if err := r.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return validationErr
+	}
+
+	if params.UserRole != entity.RoleManager {
+		return entity.ErrUserNotAllowedToRestoreTask
+	}
+
+	task, err := r.taskRepo.GetDeletedTaskByID(ctx, params.TaskID)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if task.ID == "" {
+		return entity.ErrTaskNotFound
+	}
+
+	if err := ensureTaskProjectMember(
+		ctx,
+		r.projectRepo,
+		task,
+		params.UserID,
+	); err != nil {
+		return err
+	}
+
+	restoredTask := task
+	restoredTask.DeletedAt = nil
+
+	err = r.tx.Do(ctx, func(ctx context.Context) error {
+		if err := r.taskRepo.RestoreTask(ctx, task.ID); err != nil {
+			return entity.NewErr(err)
+		}
+
+		return recordTaskEvent(
+			ctx,
+			r.taskEventRepo,
+			entity.TaskEventRestored,
+			params.UserID,
+			task,
+			restoredTask,
+		)
+	})
+
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestRestoreTask_Execute(t *testing.T) {
+	managerID := uuid.NewString()
+
+	newTaskRepo := func(deleted bool) *inmemoryrepo.InMemoryTaskRepo {
+		taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+
+		task := entity.Task{
+			ID:              uuid.NewString(),
+			Summary:         "Loren ipsum dolor sit amet",
+			CreatedByUserID: managerID,
+			CreatedAt:       time.Now(),
+			UpdatedAt:       time.Now(),
+		}
+		if deleted {
+			deletedAt := time.Now()
+			task.DeletedAt = &deletedAt
+		}
+
+		taskRepo.Tasks = append(taskRepo.Tasks, task)
+
+		return taskRepo
+	}
+
+	project := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Maintenance",
+		ManagerUserID: uuid.NewString(),
+	}
+	projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+	projectRepo.Projects = append(projectRepo.Projects, project)
+
+	type fields struct {
+		validator validator.Validator
+		taskRepo  *inmemoryrepo.InMemoryTaskRepo
+	}
+	type args struct {
+		params RestoreTaskParams
+	}
+	type test struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}
+	tests := []test{
+		func() test {
+			taskRepo := newTaskRepo(true)
+
+			return test{
+				name: "should restore a deleted task",
+				fields: fields{
+					validator: validator.NewValidate(),
+					taskRepo:  taskRepo,
+				},
+				args: args{
+					params: RestoreTaskParams{
+						TaskID:   taskRepo.Tasks[0].ID,
+						UserID:   managerID,
+						UserRole: entity.RoleManager,
+					},
+				},
+				wantErr: nil,
+			}
+		}(),
+		func() test {
+			taskRepo := newTaskRepo(true)
+
+			return test{
+				name: "should not restore a task with not allowed role",
+				fields: fields{
+					validator: validator.NewValidate(),
+					taskRepo:  taskRepo,
+				},
+				args: args{
+					params: RestoreTaskParams{
+						TaskID:   taskRepo.Tasks[0].ID,
+						UserID:   uuid.NewString(),
+						UserRole: entity.RoleTechnician,
+					},
+				},
+				wantErr: entity.ErrUserNotAllowedToRestoreTask,
+			}
+		}(),
+		func() test {
+			taskRepo := newTaskRepo(false)
+
+			return test{
+				name: "should not restore a task that is not deleted",
+				fields: fields{
+					validator: validator.NewValidate(),
+					taskRepo:  taskRepo,
+				},
+				args: args{
+					params: RestoreTaskParams{
+						TaskID:   taskRepo.Tasks[0].ID,
+						UserID:   managerID,
+						UserRole: entity.RoleManager,
+					},
+				},
+				wantErr: entity.ErrTaskNotFound,
+			}
+		}(),
+		{
+			name: "should not restore a task with invalid task id",
+			fields: fields{
+				validator: validator.NewValidate(),
+				taskRepo:  newTaskRepo(true),
+			},
+			args: args{
+				params: RestoreTaskParams{
+					TaskID:   "invalid-task-id",
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+		func() test {
+			taskRepo := newTaskRepo(true)
+			taskRepo.Tasks[0].ProjectID = &project.ID
+
+			return test{
+				name: "should not restore a task of a project the user is not a member of",
+				fields: fields{
+					validator: validator.NewValidate(),
+					taskRepo:  taskRepo,
+				},
+				args: args{
+					params: RestoreTaskParams{
+						TaskID:   taskRepo.Tasks[0].ID,
+						UserID:   managerID,
+						UserRole: entity.RoleManager,
+					},
+				},
+				wantErr: entity.ErrUserNotProjectMember,
+			}
+		}(),
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			taskEventRepo := inmemoryrepo.NewInMemoryTaskEventRepo()
+			r := NewRestoreTask(
+				tt.fields.validator,
+				tt.fields.taskRepo,
+				projectRepo,
+				taskEventRepo,
+				transactioner.NewNoopTransactioner(),
+			)
+
+			err := r.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"RestoreTask.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			task, _ := tt.fields.taskRepo.GetTaskByID(
+				context.Background(),
+				tt.args.params.TaskID,
+			)
+			if task.ID == "" {
+				t.Errorf("RestoreTask.Execute() task was not restored")
+			}
+
+			if len(taskEventRepo.Events) != 1 ||
+				taskEventRepo.Events[0].Type != entity.TaskEventRestored {
+				t.Errorf(
+					"RestoreTask.Execute() events = %v, want one restored event",
+					taskEventRepo.Events,
+				)
+			}
+		})
+	}
+}
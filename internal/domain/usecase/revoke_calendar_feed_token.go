@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type RevokeCalendarFeedToken struct {
+	validator        validator.Validator
+	calendarFeedRepo repo.CalendarFeedRepo
+}
+
+func NewRevokeCalendarFeedToken(
+	validator validator.Validator,
+	calendarFeedRepo repo.CalendarFeedRepo,
+) *RevokeCalendarFeedToken {
+	return &RevokeCalendarFeedToken{
+		validator:        validator,
+		calendarFeedRepo: calendarFeedRepo,
+	}
+}
+
+type RevokeCalendarFeedTokenParams struct {
+	UserID string `json:"user_id,omitempty" validate:"required,uuid"`
+}
+
+// Execute revokes the calendar feed token of the user, if any, so the
+// feed can no longer be read until a new token is created.
+func (r *RevokeCalendarFeedToken) Execute(
+	ctx context.Context,
+	params RevokeCalendarFeedTokenParams,
+) error {
+	if err := r.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return validationErr
+	}
+
+	if err := r.calendarFeedRepo.DeleteCalendarFeedToken(
+		ctx,
+		params.UserID,
+	); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type RunRecurringTasks struct {
+	symCrypto         symcrypt.SymmetricalEncrypter
+	recurringTaskRepo repo.RecurringTaskRepo
+	userRepo          repo.UserRepo
+	createTaskUseCase *CreateTask
+	tx                transactioner.Transactioner
+}
+
+func NewRunRecurringTasks(
+	symCrypto symcrypt.SymmetricalEncrypter,
+	recurringTaskRepo repo.RecurringTaskRepo,
+	userRepo repo.UserRepo,
+	createTaskUseCase *CreateTask,
+	tx transactioner.Transactioner,
+) *RunRecurringTasks {
+	return &RunRecurringTasks{
+		symCrypto:         symCrypto,
+		recurringTaskRepo: recurringTaskRepo,
+		userRepo:          userRepo,
+		createTaskUseCase: createTaskUseCase,
+		tx:                tx,
+	}
+}
+
+// Execute creates a task for every recurring task that is due at the
+// given time and returns how many were created. Each occurrence is
+// claimed in the same transaction that creates its task, so when
+// several replicas run at once only one of them creates it. Occurrences
+// missed while no replica was running are collapsed into a single task.
+// Occurrences failing with an error that retrying would not fix, such as
+// a deleted assignee, are skipped, so they are reported once instead of
+// on every run.
+func (r *RunRecurringTasks) Execute(
+	ctx context.Context,
+	now time.Time,
+) (int, error) {
+	recurringTasks, err := r.recurringTaskRepo.ListDueRecurringTasks(ctx, now)
+	if err != nil {
+		return 0, entity.NewErr(err)
+	}
+
+	var count int
+	var errs error
+	for _, recurringTask := range recurringTasks {
+		created, err := r.runOccurrence(ctx, recurringTask, now)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+
+		if created {
+			count++
+		}
+	}
+
+	if errs != nil {
+		return count, entity.NewErr(errs)
+	}
+
+	return count, nil
+}
+
+func (r *RunRecurringTasks) runOccurrence(
+	ctx context.Context,
+	recurringTask entity.RecurringTask,
+	now time.Time,
+) (bool, error) {
+	schedule, err := parseRecurrenceSchedule(recurringTask.Schedule)
+	if err != nil {
+		return false, err
+	}
+
+	summary, err := r.symCrypto.Decrypt(recurringTask.Summary)
+	if err != nil {
+		return false, entity.NewErr(err)
+	}
+
+	var createErr error
+	var created bool
+	err = r.tx.Do(ctx, func(ctx context.Context) error {
+		claimed, err := r.recurringTaskRepo.ClaimRecurringTaskOccurrence(
+			ctx,
+			recurringTask.ID,
+			recurringTask.NextRunAt,
+		)
+		if err != nil {
+			return entity.NewErr(err)
+		}
+
+		if !claimed {
+			return nil
+		}
+
+		// Errors that retrying would not fix skip the occurrence, while
+		// the others roll its claim back to retry it on the next run.
+		createErr = r.createOccurrence(ctx, recurringTask, summary)
+		if createErr != nil && !isPersistentErr(createErr) {
+			return createErr
+		}
+
+		if err := r.recurringTaskRepo.UpdateRecurringTaskNextRunAt(
+			ctx,
+			recurringTask.ID,
+			nextRecurrence(schedule, now),
+		); err != nil {
+			return entity.NewErr(err)
+		}
+
+		created = createErr == nil
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return created, createErr
+}
+
+// createOccurrence creates the task of the occurrence on behalf of the
+// creator of the recurring task, with their current role.
+func (r *RunRecurringTasks) createOccurrence(
+	ctx context.Context,
+	recurringTask entity.RecurringTask,
+	summary string,
+) error {
+	createdByUser, err := r.userRepo.GetUserByID(
+		ctx,
+		recurringTask.CreatedByUserID,
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if createdByUser.ID == "" {
+		return entity.ErrCreatedByUserNotFound
+	}
+
+	params := CreateTaskParams{
+		UserRole:        createdByUser.Role,
+		Summary:         summary,
+		CreatedByUserID: createdByUser.ID,
+	}
+
+	if recurringTask.AssignedToUserID != nil {
+		params.AssignedToUserID = *recurringTask.AssignedToUserID
+	}
+
+	_, err = r.createTaskUseCase.Execute(ctx, params)
+	return err
+}
+
+// isPersistentErr tells whether err is an expected error, such as a
+// validation error or a missing user, which retrying would not fix.
+func isPersistentErr(err error) bool {
+	appErr := &entity.Err{}
+	return errors.As(err, &appErr) && appErr.Type != entity.ErrTypeUnknown
+}
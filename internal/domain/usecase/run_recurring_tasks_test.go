@@ -0,0 +1,216 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/google/uuid"
+)
+
+func TestRunRecurringTasks_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	managerUser := entity.User{
+		ID:   uuid.NewString(),
+		Role: entity.RoleManager,
+	}
+
+	technicianUser := entity.User{
+		ID:   uuid.NewString(),
+		Role: entity.RoleTechnician,
+	}
+
+	encryptedSummary, err := symCrypto.Encrypt("Check the generators")
+	if err != nil {
+		t.Fatalf("could not encrypt summary")
+	}
+
+	now := time.Date(2026, 10, 19, 8, 30, 0, 0, time.UTC)
+	scheduledAt := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+
+	formerManagerUser := entity.User{
+		ID:   uuid.NewString(),
+		Role: entity.RoleTechnician,
+	}
+
+	deletedUserID := uuid.NewString()
+
+	newRecurringTask := func(nextRunAt time.Time) entity.RecurringTask {
+		return entity.RecurringTask{
+			ID:               uuid.NewString(),
+			Summary:          encryptedSummary,
+			Schedule:         "0 8 * * MON",
+			AssignedToUserID: &technicianUser.ID,
+			CreatedByUserID:  managerUser.ID,
+			NextRunAt:        nextRunAt,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		recurringTask entity.RecurringTask
+		claimed       bool
+		wantCount     int
+		wantNextRunAt time.Time
+		wantErr       error
+	}{
+		{
+			name:          "should create a task for a due recurring task",
+			recurringTask: newRecurringTask(scheduledAt),
+			wantCount:     1,
+			wantNextRunAt: time.Date(2026, 10, 26, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "should create a single task for missed occurrences",
+			recurringTask: newRecurringTask(
+				scheduledAt.AddDate(0, 0, -14),
+			),
+			wantCount:     1,
+			wantNextRunAt: time.Date(2026, 10, 26, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "should not create a task before the next run",
+			recurringTask: newRecurringTask(scheduledAt.AddDate(0, 0, 7)),
+			wantCount:     0,
+			wantNextRunAt: scheduledAt.AddDate(0, 0, 7),
+		},
+		{
+			name:          "should not create a task for an occurrence claimed by another replica",
+			recurringTask: newRecurringTask(scheduledAt),
+			claimed:       true,
+			wantCount:     0,
+			wantNextRunAt: scheduledAt,
+		},
+		{
+			name: "should skip the occurrence of a recurring task assigned to a deleted user",
+			recurringTask: func() entity.RecurringTask {
+				recurringTask := newRecurringTask(scheduledAt)
+				recurringTask.AssignedToUserID = &deletedUserID
+				return recurringTask
+			}(),
+			wantCount:     0,
+			wantNextRunAt: time.Date(2026, 10, 26, 8, 0, 0, 0, time.UTC),
+			wantErr:       entity.ErrAssignToUserNotFound,
+		},
+		{
+			name: "should skip the occurrence of a recurring task whose creator is no longer a manager",
+			recurringTask: func() entity.RecurringTask {
+				recurringTask := newRecurringTask(scheduledAt)
+				recurringTask.CreatedByUserID = formerManagerUser.ID
+				return recurringTask
+			}(),
+			wantCount:     0,
+			wantNextRunAt: time.Date(2026, 10, 26, 8, 0, 0, 0, time.UTC),
+			wantErr:       entity.ErrUserNotAllowedToCreateTask,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userRepo := inmemoryrepo.NewInMemoryUserRepo()
+			userRepo.Users = append(
+				userRepo.Users,
+				managerUser,
+				technicianUser,
+				formerManagerUser,
+			)
+
+			taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+
+			recurringTaskRepo := inmemoryrepo.NewInMemoryRecurringTaskRepo()
+			recurringTaskRepo.RecurringTasks = append(
+				recurringTaskRepo.RecurringTasks,
+				tt.recurringTask,
+			)
+			if tt.claimed {
+				recurringTaskRepo.Occurrences[tt.recurringTask.ID] = []time.Time{
+					tt.recurringTask.NextRunAt,
+				}
+			}
+
+			tx := transactioner.NewNoopTransactioner()
+			r := NewRunRecurringTasks(
+				symCrypto,
+				recurringTaskRepo,
+				userRepo,
+				NewCreateTask(
+					val,
+					symCrypto,
+					taskRepo,
+					userRepo,
+					inmemoryrepo.NewInMemoryLabelRepo(),
+					inmemoryrepo.NewInMemoryProjectRepo(),
+					inmemoryrepo.NewInMemoryTaskEventRepo(),
+					tx,
+				),
+				tx,
+			)
+
+			// Running twice at the same time must not create the
+			// occurrence again, nor fail it again once skipped.
+			for i := range 2 {
+				count, err := r.Execute(context.Background(), now)
+
+				var wantErr error
+				if i == 0 {
+					wantErr = tt.wantErr
+				}
+				// The errors of the occurrences are joined into one.
+				if (err == nil) != (wantErr == nil) ||
+					(wantErr != nil && err.Error() != wantErr.Error()) {
+					t.Fatalf(
+						"RunRecurringTasks.Execute() error = %v, wantErr %v",
+						err,
+						wantErr,
+					)
+				}
+				if count > tt.wantCount {
+					t.Errorf(
+						"RunRecurringTasks.Execute() = %v, want %v",
+						count,
+						tt.wantCount,
+					)
+				}
+			}
+
+			if len(taskRepo.Tasks) != tt.wantCount {
+				t.Fatalf(
+					"RunRecurringTasks.Execute() tasks = %v, want %v",
+					len(taskRepo.Tasks),
+					tt.wantCount,
+				)
+			}
+
+			if tt.wantCount > 0 {
+				task := taskRepo.Tasks[0]
+				if task.CreatedByUserID != managerUser.ID ||
+					task.AssignedToUserID == nil ||
+					*task.AssignedToUserID != technicianUser.ID {
+					t.Errorf(
+						"RunRecurringTasks.Execute() task = %v, want it created from the template",
+						task,
+					)
+				}
+			}
+
+			nextRunAt := recurringTaskRepo.RecurringTasks[0].NextRunAt
+			if !nextRunAt.Equal(tt.wantNextRunAt) {
+				t.Errorf(
+					"RunRecurringTasks.Execute() next run = %v, want %v",
+					nextRunAt,
+					tt.wantNextRunAt,
+				)
+			}
+		})
+	}
+}
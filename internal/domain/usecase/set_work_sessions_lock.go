@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type SetWorkSessionsLock struct {
+	validator   validator.Validator
+	taskRepo    repo.TaskRepo
+	projectRepo repo.ProjectRepo
+}
+
+func NewSetWorkSessionsLock(
+	validator validator.Validator,
+	taskRepo repo.TaskRepo,
+	projectRepo repo.ProjectRepo,
+) *SetWorkSessionsLock {
+	return &SetWorkSessionsLock{
+		validator:   validator,
+		taskRepo:    taskRepo,
+		projectRepo: projectRepo,
+	}
+}
+
+type SetWorkSessionsLockParams struct {
+	TaskID   string      `json:"task_id,omitempty"   validate:"required,uuid"`
+	UserID   string      `json:"user_id,omitempty"   validate:"required,uuid"`
+	UserRole entity.Role `json:"user_role,omitempty" validate:"required,min=1,max=2"`
+	Locked   bool        `json:"locked"`
+}
+
+// Execute unlocks the work sessions of a finished task so that its
+// assignees can fix them, or locks them again. The work sessions of tasks
+// that are not finished are never locked.
+func (s *SetWorkSessionsLock) Execute(
+	ctx context.Context,
+	params SetWorkSessionsLockParams,
+) error {
+	if err := s.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return validationErr
+	}
+
+	if params.UserRole != entity.RoleManager {
+		return entity.ErrUserNotAllowedToLockWorkSessions
+	}
+
+	task, err := s.taskRepo.GetTaskByID(ctx, params.TaskID)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if task.ID == "" {
+		return entity.ErrTaskNotFound
+	}
+
+	if err := ensureTaskProjectMember(
+		ctx,
+		s.projectRepo,
+		task,
+		params.UserID,
+	); err != nil {
+		return err
+	}
+
+	if err := s.taskRepo.SetTaskWorkSessionsUnlocked(
+		ctx,
+		task.ID,
+		!params.Locked,
+	); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
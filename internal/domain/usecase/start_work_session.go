@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type StartWorkSession struct {
+	validator       validator.Validator
+	symCrypto       symcrypt.SymmetricalEncrypter
+	taskRepo        repo.TaskRepo
+	workSessionRepo repo.WorkSessionRepo
+	tx              transactioner.Transactioner
+}
+
+func NewStartWorkSession(
+	validator validator.Validator,
+	symCrypto symcrypt.SymmetricalEncrypter,
+	taskRepo repo.TaskRepo,
+	workSessionRepo repo.WorkSessionRepo,
+	tx transactioner.Transactioner,
+) *StartWorkSession {
+	return &StartWorkSession{
+		validator:       validator,
+		symCrypto:       symCrypto,
+		taskRepo:        taskRepo,
+		workSessionRepo: workSessionRepo,
+		tx:              tx,
+	}
+}
+
+type StartWorkSessionParams struct {
+	TaskID string  `json:"task_id,omitempty" validate:"required,uuid"`
+	UserID string  `json:"user_id,omitempty" validate:"required,uuid"`
+	Note   *string `json:"note,omitempty"    validate:"omitempty,max=2000"`
+}
+
+// Execute starts a timer for the assignee on the task. Technicians can
+// only run one timer at a time, across all tasks.
+func (s *StartWorkSession) Execute(
+	ctx context.Context,
+	params StartWorkSessionParams,
+) (entity.WorkSession, error) {
+	if err := s.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return entity.WorkSession{}, validationErr
+	}
+
+	task, err := getTrackableTask(ctx, s.taskRepo, params.TaskID, params.UserID)
+	if err != nil {
+		return entity.WorkSession{}, err
+	}
+
+	encryptedNote, err := encryptWorkSessionNote(s.symCrypto, params.Note)
+	if err != nil {
+		return entity.WorkSession{}, err
+	}
+
+	repoParams := repo.CreateWorkSessionParams{
+		ID:        uuid.NewString(),
+		TaskID:    task.ID,
+		UserID:    params.UserID,
+		StartedAt: time.Now().UTC().Truncate(time.Second),
+		Note:      encryptedNote,
+	}
+
+	var session entity.WorkSession
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		running, err := s.workSessionRepo.GetRunningWorkSession(
+			ctx,
+			params.UserID,
+		)
+		if err != nil {
+			return entity.NewErr(err)
+		}
+
+		if running.ID != "" {
+			return entity.ErrWorkSessionAlreadyRunning
+		}
+
+		if err := s.workSessionRepo.CreateWorkSession(
+			ctx,
+			repoParams,
+		); err != nil {
+			return entity.NewErr(err)
+		}
+
+		session, err = s.workSessionRepo.GetWorkSessionByID(
+			ctx,
+			repoParams.ID,
+		)
+		if err != nil {
+			return entity.NewErr(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return entity.WorkSession{}, entity.NewErr(err)
+	}
+
+	return decryptWorkSession(s.symCrypto, session, time.Now())
+}
@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestStartWorkSession_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	managerID := uuid.NewString()
+	technicianID := uuid.NewString()
+	busyTechnicianID := uuid.NewString()
+
+	task := entity.Task{
+		ID:              uuid.NewString(),
+		Summary:         "Loren ipsum dolor sit amet",
+		Status:          entity.TaskStatusInProgress,
+		AssigneeIDs:     []string{technicianID, busyTechnicianID},
+		CreatedByUserID: managerID,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+	doneTask := entity.Task{
+		ID:              uuid.NewString(),
+		Summary:         "Loren ipsum dolor sit amet",
+		Status:          entity.TaskStatusDone,
+		AssigneeIDs:     []string{technicianID},
+		CreatedByUserID: managerID,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+	unlockedTask := doneTask
+	unlockedTask.ID = uuid.NewString()
+	unlockedTask.WorkSessionsUnlocked = true
+
+	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+	taskRepo.Tasks = append(taskRepo.Tasks, task, doneTask, unlockedTask)
+
+	runningSession := entity.WorkSession{
+		ID:        uuid.NewString(),
+		TaskID:    task.ID,
+		UserID:    busyTechnicianID,
+		StartedAt: time.Now().Add(-time.Hour),
+	}
+
+	note := "Checking the compressor"
+
+	type args struct {
+		params StartWorkSessionParams
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantNote string
+		wantErr  error
+	}{
+		{
+			name: "should start timer if user is the assignee",
+			args: args{
+				params: StartWorkSessionParams{
+					TaskID: task.ID,
+					UserID: technicianID,
+					Note:   &note,
+				},
+			},
+			wantNote: note,
+			wantErr:  nil,
+		},
+		{
+			name: "should start timer on finished task if it was unlocked",
+			args: args{
+				params: StartWorkSessionParams{
+					TaskID: unlockedTask.ID,
+					UserID: technicianID,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should not start timer if user is not the assignee",
+			args: args{
+				params: StartWorkSessionParams{
+					TaskID: task.ID,
+					UserID: managerID,
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToTrackTime,
+		},
+		{
+			name: "should not start timer if task is finished",
+			args: args{
+				params: StartWorkSessionParams{
+					TaskID: doneTask.ID,
+					UserID: technicianID,
+				},
+			},
+			wantErr: entity.ErrWorkSessionsLocked,
+		},
+		{
+			name: "should not start timer if another one is running",
+			args: args{
+				params: StartWorkSessionParams{
+					TaskID: task.ID,
+					UserID: busyTechnicianID,
+				},
+			},
+			wantErr: entity.ErrWorkSessionAlreadyRunning,
+		},
+		{
+			name: "should not start timer if task does not exist",
+			args: args{
+				params: StartWorkSessionParams{
+					TaskID: uuid.NewString(),
+					UserID: technicianID,
+				},
+			},
+			wantErr: entity.ErrTaskNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			workSessionRepo := inmemoryrepo.NewInMemoryWorkSessionRepo(taskRepo)
+			workSessionRepo.Sessions = append(
+				workSessionRepo.Sessions,
+				runningSession,
+			)
+
+			s := NewStartWorkSession(
+				val,
+				symCrypto,
+				taskRepo,
+				workSessionRepo,
+				transactioner.NewNoopTransactioner(),
+			)
+
+			got, err := s.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"StartWorkSession.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				if len(workSessionRepo.Sessions) != 1 {
+					t.Errorf("StartWorkSession.Execute() created a work session")
+				}
+				return
+			}
+
+			if !got.IsRunning() || got.Manual {
+				t.Errorf(
+					"StartWorkSession.Execute() = %+v, want a running timer",
+					got,
+				)
+			}
+
+			if !testutil.CompareAsPtr(got.Note, tt.wantNote) {
+				t.Errorf(
+					"StartWorkSession.Execute() note = %v, want %v",
+					got.Note,
+					tt.wantNote,
+				)
+			}
+
+			stored, _ := workSessionRepo.GetWorkSessionByID(
+				context.Background(),
+				got.ID,
+			)
+			if tt.wantNote != "" && *stored.Note == tt.wantNote {
+				t.Errorf("StartWorkSession.Execute() note was not encrypted")
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type StopWorkSession struct {
+	validator       validator.Validator
+	symCrypto       symcrypt.SymmetricalEncrypter
+	taskRepo        repo.TaskRepo
+	workSessionRepo repo.WorkSessionRepo
+}
+
+func NewStopWorkSession(
+	validator validator.Validator,
+	symCrypto symcrypt.SymmetricalEncrypter,
+	taskRepo repo.TaskRepo,
+	workSessionRepo repo.WorkSessionRepo,
+) *StopWorkSession {
+	return &StopWorkSession{
+		validator:       validator,
+		symCrypto:       symCrypto,
+		taskRepo:        taskRepo,
+		workSessionRepo: workSessionRepo,
+	}
+}
+
+type StopWorkSessionParams struct {
+	TaskID string `json:"task_id,omitempty" validate:"required,uuid"`
+	UserID string `json:"user_id,omitempty" validate:"required,uuid"`
+}
+
+// Execute stops the timer the user is running on the task. Timers can be
+// stopped even after the task is finished, so that they do not run
+// forever once the work sessions are locked.
+func (s *StopWorkSession) Execute(
+	ctx context.Context,
+	params StopWorkSessionParams,
+) (entity.WorkSession, error) {
+	if err := s.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return entity.WorkSession{}, validationErr
+	}
+
+	task, err := s.taskRepo.GetTaskByID(ctx, params.TaskID)
+	if err != nil {
+		return entity.WorkSession{}, entity.NewErr(err)
+	}
+
+	if task.ID == "" {
+		return entity.WorkSession{}, entity.ErrTaskNotFound
+	}
+
+	session, err := s.workSessionRepo.GetRunningWorkSession(
+		ctx,
+		params.UserID,
+	)
+	if err != nil {
+		return entity.WorkSession{}, entity.NewErr(err)
+	}
+
+	if session.ID == "" || session.TaskID != task.ID {
+		return entity.WorkSession{}, entity.ErrNoRunningWorkSession
+	}
+
+	endedAt := time.Now().UTC().Truncate(time.Second)
+	if err := s.workSessionRepo.UpdateWorkSession(
+		ctx,
+		repo.UpdateWorkSessionParams{
+			ID:        session.ID,
+			StartedAt: session.StartedAt,
+			EndedAt:   &endedAt,
+			Note:      session.Note,
+		},
+	); err != nil {
+		return entity.WorkSession{}, entity.NewErr(err)
+	}
+
+	session.EndedAt = &endedAt
+	session.UpdatedAt = time.Now()
+
+	return decryptWorkSession(s.symCrypto, session, endedAt)
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+// ensureAssignees checks that the users exist and are technicians, and
+// returns their IDs without duplicates, keeping the first occurrence of
+// each so that the order of the assignees is preserved.
+func ensureAssignees(
+	ctx context.Context,
+	userRepo repo.UserRepo,
+	userIDs []string,
+) ([]string, error) {
+	assigneeIDs := []string{}
+	for _, userID := range userIDs {
+		if slices.Contains(assigneeIDs, userID) {
+			continue
+		}
+
+		user, err := userRepo.GetUserByID(ctx, userID)
+		if err != nil {
+			return nil, entity.NewErr(err)
+		}
+
+		if user.ID == "" {
+			return nil, entity.ErrAssignToUserNotFound
+		}
+
+		if user.Role != entity.RoleTechnician {
+			return nil, entity.ErrInvalidRoleForAssignedUser
+		}
+
+		assigneeIDs = append(assigneeIDs, userID)
+	}
+
+	return assigneeIDs, nil
+}
+
+// withAssignees returns the task with the assignees replaced, keeping
+// only the sign-offs of the ones that remain, as
+// repo.TaskRepo.SetTaskAssignees does.
+func withAssignees(task entity.Task, assigneeIDs []string) entity.Task {
+	task.AssigneeIDs = assigneeIDs
+	task.SignedOffUserIDs = slices.DeleteFunc(
+		slices.Clone(task.SignedOffUserIDs),
+		func(userID string) bool {
+			return !slices.Contains(assigneeIDs, userID)
+		},
+	)
+
+	task.AssignedToUserID = nil
+	if len(assigneeIDs) > 0 {
+		task.AssignedToUserID = &assigneeIDs[0]
+	}
+
+	return task
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+)
+
+// decryptTask decrypts the task fields stored encrypted,
+// which are the summary and the reopen reason.
+func decryptTask(
+	symCrypto symcrypt.SymmetricalEncrypter,
+	task entity.Task,
+) (entity.Task, error) {
+	decryptedSummary, err := symCrypto.Decrypt(task.Summary)
+	if err != nil {
+		return entity.Task{}, entity.NewErr(err)
+	}
+	task.Summary = decryptedSummary
+
+	if task.ReopenReason != nil {
+		decryptedReason, err := symCrypto.Decrypt(*task.ReopenReason)
+		if err != nil {
+			return entity.Task{}, entity.NewErr(err)
+		}
+		task.ReopenReason = &decryptedReason
+	}
+
+	return task, nil
+}
+
+// decryptTaskEvent decrypts the changes of the task fields
+// stored encrypted.
+func decryptTaskEvent(
+	symCrypto symcrypt.SymmetricalEncrypter,
+	event entity.TaskEvent,
+) (entity.TaskEvent, error) {
+	changes := make([]entity.TaskFieldChange, len(event.Changes))
+	copy(changes, event.Changes)
+
+	for i, change := range changes {
+		if change.Field != entity.TaskFieldSummary &&
+			change.Field != entity.TaskFieldReopenReason {
+			continue
+		}
+
+		for _, value := range []**string{&change.From, &change.To} {
+			if *value == nil {
+				continue
+			}
+
+			decrypted, err := symCrypto.Decrypt(**value)
+			if err != nil {
+				return entity.TaskEvent{}, entity.NewErr(err)
+			}
+			*value = &decrypted
+		}
+
+		changes[i] = change
+	}
+
+	event.Changes = changes
+
+	return event, nil
+}
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+// ensureBlockersFinished returns ErrTaskBlocked if any task blocking
+// the task is not finished. Deleted blockers no longer block it.
+func ensureBlockersFinished(
+	ctx context.Context,
+	taskRepo repo.TaskRepo,
+	taskDependencyRepo repo.TaskDependencyRepo,
+	taskID string,
+) error {
+	unfinished, err := hasUnfinishedBlockers(
+		ctx,
+		taskRepo,
+		taskDependencyRepo,
+		taskID,
+		"",
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if unfinished {
+		return entity.ErrTaskBlocked
+	}
+
+	return nil
+}
+
+// hasUnfinishedBlockers reports whether any task blocking the task is
+// not finished, ignoring the finished blocker with the given ID.
+func hasUnfinishedBlockers(
+	ctx context.Context,
+	taskRepo repo.TaskRepo,
+	taskDependencyRepo repo.TaskDependencyRepo,
+	taskID string,
+	finishedBlockerID string,
+) (bool, error) {
+	blockers, err := taskDependencyRepo.ListTaskBlockers(ctx, taskID)
+	if err != nil {
+		return false, entity.NewErr(err)
+	}
+
+	for _, blocker := range blockers {
+		if blocker.BlockedByTaskID == finishedBlockerID {
+			continue
+		}
+
+		task, err := taskRepo.GetTaskByID(ctx, blocker.BlockedByTaskID)
+		if err != nil {
+			return false, entity.NewErr(err)
+		}
+
+		if task.ID != "" && task.FinishedAt == nil {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// dependsOn reports whether the task is blocked by the other task,
+// directly or through any chain of blockers, walking the dependency
+// graph breadth first.
+func dependsOn(
+	ctx context.Context,
+	taskDependencyRepo repo.TaskDependencyRepo,
+	taskID string,
+	otherTaskID string,
+) (bool, error) {
+	visited := map[string]bool{taskID: true}
+	queue := []string{taskID}
+
+	for len(queue) > 0 {
+		currentID := queue[0]
+		queue = queue[1:]
+
+		blockers, err := taskDependencyRepo.ListTaskBlockers(ctx, currentID)
+		if err != nil {
+			return false, entity.NewErr(err)
+		}
+
+		for _, blocker := range blockers {
+			if blocker.BlockedByTaskID == otherTaskID {
+				return true, nil
+			}
+
+			if !visited[blocker.BlockedByTaskID] {
+				visited[blocker.BlockedByTaskID] = true
+				queue = append(queue, blocker.BlockedByTaskID)
+			}
+		}
+	}
+
+	return false, nil
+}
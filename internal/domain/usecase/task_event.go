@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+// recordTaskEvent appends an event with the changes between the two
+// versions of the task to its history. Nothing is recorded when no
+// tracked field changed.
+func recordTaskEvent(
+	ctx context.Context,
+	taskEventRepo repo.TaskEventRepo,
+	eventType entity.TaskEventType,
+	actorUserID string,
+	from, to entity.Task,
+) error {
+	changes := entity.DiffTasks(from, to)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	if err := taskEventRepo.CreateTaskEvent(ctx, repo.CreateTaskEventParams{
+		TaskID:      cmp.Or(to.ID, from.ID),
+		ActorUserID: actorUserID,
+		Type:        eventType,
+		Changes:     changes,
+	}); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/broker"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+type TransitionTask struct {
+	validator          validator.Validator
+	msgBroker          broker.MessageBroker
+	taskRepo           repo.TaskRepo
+	projectRepo        repo.ProjectRepo
+	taskEventRepo      repo.TaskEventRepo
+	checklistRepo      repo.ChecklistRepo
+	taskDependencyRepo repo.TaskDependencyRepo
+	tx                 transactioner.Transactioner
+	finishTaskUseCase  *FinishTask
+}
+
+func NewTransitionTask(
+	validator validator.Validator,
+	msgBroker broker.MessageBroker,
+	taskRepo repo.TaskRepo,
+	projectRepo repo.ProjectRepo,
+	taskEventRepo repo.TaskEventRepo,
+	checklistRepo repo.ChecklistRepo,
+	taskDependencyRepo repo.TaskDependencyRepo,
+	tx transactioner.Transactioner,
+	finishTaskUseCase *FinishTask,
+) *TransitionTask {
+	return &TransitionTask{
+		validator:          validator,
+		msgBroker:          msgBroker,
+		taskRepo:           taskRepo,
+		projectRepo:        projectRepo,
+		taskEventRepo:      taskEventRepo,
+		checklistRepo:      checklistRepo,
+		taskDependencyRepo: taskDependencyRepo,
+		tx:                 tx,
+		finishTaskUseCase:  finishTaskUseCase,
+	}
+}
+
+type TransitionTaskParams struct {
+	TaskID   string            `json:"task_id,omitempty" validate:"required,uuid"`
+	UserID   string            `json:"user_id,omitempty" validate:"required,uuid"`
+	UserRole entity.Role       `json:"role,omitempty"    validate:"required,min=1,max=2"`
+	Status   entity.TaskStatus `json:"status,omitempty"  validate:"required"`
+}
+
+// Execute moves the task to the given status. Submitting the task for
+// review goes through FinishTask, so that the finish policy, the checklist
+// and the blockers are enforced the same way, and sending it back from
+// review clears the sign-offs of its assignees.
+func (t *TransitionTask) Execute(
+	ctx context.Context,
+	params TransitionTaskParams,
+) error {
+	if err := t.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return validationErr
+	}
+
+	if !params.Status.IsValid() {
+		return entity.ErrInvalidTaskStatus
+	}
+
+	task, err := t.taskRepo.GetTaskByID(ctx, params.TaskID)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if task.ID == "" {
+		return entity.ErrTaskNotFound
+	}
+
+	if params.UserRole == entity.RoleManager {
+		if err := ensureTaskProjectMember(
+			ctx,
+			t.projectRepo,
+			task,
+			params.UserID,
+		); err != nil {
+			return err
+		}
+	}
+
+	if err := task.ValidateTransition(
+		params.Status,
+		params.UserID,
+		params.UserRole,
+	); err != nil {
+		return err
+	}
+
+	if params.Status == entity.TaskStatusInReview {
+		return t.finishTaskUseCase.Execute(ctx, FinishTaskParams{
+			TaskID:   params.TaskID,
+			UserID:   params.UserID,
+			UserRole: params.UserRole,
+		})
+	}
+
+	if params.Status == entity.TaskStatusDone {
+		if err := ensureChecklistDone(ctx, t.checklistRepo, task.ID); err != nil {
+			return err
+		}
+
+		if err := ensureBlockersFinished(
+			ctx,
+			t.taskRepo,
+			t.taskDependencyRepo,
+			task.ID,
+		); err != nil {
+			return err
+		}
+	}
+
+	previousTask := task
+
+	// Finished date is kept in sync with the done status.
+	if params.Status == entity.TaskStatusDone {
+		finishedAt := time.Now()
+		task.FinishedAt = &finishedAt
+	}
+
+	// Sending the task back from review starts the sign-offs over.
+	clearsSignOffs := task.Status == entity.TaskStatusInReview &&
+		params.Status == entity.TaskStatusInProgress
+	if clearsSignOffs {
+		task.SignedOffUserIDs = nil
+	}
+
+	task.Status = params.Status
+
+	var repoParams repo.UpdateTaskParams
+	if err = copier.Copy(&repoParams, task); err != nil {
+		return entity.NewErr(err)
+	}
+
+	err = t.tx.Do(ctx, func(ctx context.Context) error {
+		if err := t.taskRepo.UpdateTask(ctx, repoParams); err != nil {
+			return entity.NewErr(err)
+		}
+
+		if clearsSignOffs {
+			if err := t.taskRepo.ClearTaskSignOffs(ctx, task.ID); err != nil {
+				return entity.NewErr(err)
+			}
+		}
+
+		if err := recordTaskEvent(
+			ctx,
+			t.taskEventRepo,
+			entity.TaskEventStatusChanged,
+			params.UserID,
+			previousTask,
+			task,
+		); err != nil {
+			return err
+		}
+
+		task.UpdatedAt = time.Now()
+
+		taskBytes, err := json.Marshal(task)
+		if err != nil {
+			return entity.NewErr(err)
+		}
+
+		if err := publishAfterCommit(
+			ctx,
+			t.msgBroker,
+			broker.TopicTaskStatusChanged,
+			taskBytes,
+		); err != nil {
+			return entity.NewErr(err)
+		}
+
+		if task.Status != entity.TaskStatusDone {
+			return nil
+		}
+
+		if err := publishAfterCommit(
+			ctx,
+			t.msgBroker,
+			broker.TopicTaskFinished,
+			taskBytes,
+		); err != nil {
+			return entity.NewErr(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,480 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/broker/clibroker"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestTransitionTask_Execute(t *testing.T) {
+	managerID := uuid.NewString()
+	technicianID := uuid.NewString()
+	otherTechnicianID := uuid.NewString()
+
+	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+
+	newTask := func(status entity.TaskStatus) entity.Task {
+		task := entity.Task{
+			ID:               uuid.NewString(),
+			Summary:          "Loren ipsum dolor sit amet",
+			Status:           status,
+			AssignedToUserID: &technicianID,
+			CreatedByUserID:  managerID,
+			CreatedAt:        time.Now(),
+			UpdatedAt:        time.Now(),
+		}
+		taskRepo.Tasks = append(taskRepo.Tasks, task)
+		return task
+	}
+
+	openTask := newTask(entity.TaskStatusOpen)
+	inProgressTask := newTask(entity.TaskStatusInProgress)
+	otherOpenTask := newTask(entity.TaskStatusOpen)
+	inReviewTask := newTask(entity.TaskStatusInReview)
+	otherInReviewTask := newTask(entity.TaskStatusInReview)
+
+	project := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Maintenance",
+		ManagerUserID: uuid.NewString(),
+	}
+	projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+	projectRepo.Projects = append(projectRepo.Projects, project)
+
+	projectTask := newTask(entity.TaskStatusInReview)
+	taskRepo.Tasks[len(taskRepo.Tasks)-1].ProjectID = &project.ID
+
+	type fields struct {
+		validator validator.Validator
+		msgBroker *clibroker.CLIMessageBroker
+		taskRepo  *inmemoryrepo.InMemoryTaskRepo
+	}
+	type args struct {
+		params TransitionTaskParams
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		args           args
+		wantFinishedAt bool
+		wantErr        error
+	}{
+		{
+			name: "should move task to in progress if user is the assignee",
+			fields: fields{
+				validator: validator.NewValidate(),
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: TransitionTaskParams{
+					TaskID:   openTask.ID,
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+					Status:   entity.TaskStatusInProgress,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should submit task for review if user is the assignee",
+			fields: fields{
+				validator: validator.NewValidate(),
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: TransitionTaskParams{
+					TaskID:   inProgressTask.ID,
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+					Status:   entity.TaskStatusInReview,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should accept task into done if user is a manager",
+			fields: fields{
+				validator: validator.NewValidate(),
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: TransitionTaskParams{
+					TaskID:   inReviewTask.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					Status:   entity.TaskStatusDone,
+				},
+			},
+			wantFinishedAt: true,
+			wantErr:        nil,
+		},
+		{
+			name: "should not move task to in progress if user is a manager",
+			fields: fields{
+				validator: validator.NewValidate(),
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: TransitionTaskParams{
+					TaskID:   otherOpenTask.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					Status:   entity.TaskStatusInProgress,
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToTransitionTask,
+		},
+		{
+			name: "should not move task to in progress if user is not the assignee",
+			fields: fields{
+				validator: validator.NewValidate(),
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: TransitionTaskParams{
+					TaskID:   otherOpenTask.ID,
+					UserID:   otherTechnicianID,
+					UserRole: entity.RoleTechnician,
+					Status:   entity.TaskStatusInProgress,
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToTransitionTask,
+		},
+		{
+			name: "should not accept task into done if user is the assignee",
+			fields: fields{
+				validator: validator.NewValidate(),
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: TransitionTaskParams{
+					TaskID:   otherInReviewTask.ID,
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+					Status:   entity.TaskStatusDone,
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToTransitionTask,
+		},
+		{
+			name: "should not move open task straight to done",
+			fields: fields{
+				validator: validator.NewValidate(),
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: TransitionTaskParams{
+					TaskID:   otherOpenTask.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					Status:   entity.TaskStatusDone,
+				},
+			},
+			wantErr: entity.ErrInvalidTaskStatusTransition,
+		},
+		{
+			name: "should not move task to unknown status",
+			fields: fields{
+				validator: validator.NewValidate(),
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: TransitionTaskParams{
+					TaskID:   otherOpenTask.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					Status:   "archived",
+				},
+			},
+			wantErr: entity.ErrInvalidTaskStatus,
+		},
+		{
+			name: "should not move task if is a invalid task id",
+			fields: fields{
+				validator: validator.NewValidate(),
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: TransitionTaskParams{
+					TaskID:   "invalid-task-id",
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+					Status:   entity.TaskStatusInProgress,
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+		{
+			name: "should not move task if task does not exists",
+			fields: fields{
+				validator: validator.NewValidate(),
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: TransitionTaskParams{
+					TaskID:   uuid.NewString(),
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+					Status:   entity.TaskStatusInProgress,
+				},
+			},
+			wantErr: entity.ErrTaskNotFound,
+		},
+		{
+			name: "should not move task of a project the user is not a member of",
+			fields: fields{
+				validator: validator.NewValidate(),
+				msgBroker: clibroker.NewCLIMessageBroker(),
+				taskRepo:  taskRepo,
+			},
+			args: args{
+				params: TransitionTaskParams{
+					TaskID:   projectTask.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					Status:   entity.TaskStatusDone,
+				},
+			},
+			wantErr: entity.ErrUserNotProjectMember,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskEventRepo := inmemoryrepo.NewInMemoryTaskEventRepo()
+			checklistRepo := inmemoryrepo.NewInMemoryChecklistRepo()
+			taskDependencyRepo := inmemoryrepo.NewInMemoryTaskDependencyRepo()
+			tx := transactioner.NewNoopTransactioner()
+
+			tr := NewTransitionTask(
+				tt.fields.validator,
+				tt.fields.msgBroker,
+				tt.fields.taskRepo,
+				projectRepo,
+				taskEventRepo,
+				checklistRepo,
+				taskDependencyRepo,
+				tx,
+				NewFinishTask(
+					tt.fields.validator,
+					tt.fields.msgBroker,
+					tt.fields.taskRepo,
+					taskEventRepo,
+					checklistRepo,
+					taskDependencyRepo,
+					tx,
+				),
+			)
+
+			err := tr.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"TransitionTask.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			task, _ := tt.fields.taskRepo.GetTaskByID(
+				context.Background(),
+				tt.args.params.TaskID,
+			)
+
+			if task.Status != tt.args.params.Status {
+				t.Errorf(
+					"TransitionTask.Execute() task.Status = %v, want %v",
+					task.Status,
+					tt.args.params.Status,
+				)
+			}
+
+			gotFinishedAt := task.FinishedAt != nil
+			if gotFinishedAt != tt.wantFinishedAt {
+				t.Errorf(
+					"TransitionTask.Execute() task.FinishedAt = %v, want set %v",
+					task.FinishedAt,
+					tt.wantFinishedAt,
+				)
+			}
+		})
+	}
+}
+
+func TestTransitionTask_Execute_Review(t *testing.T) {
+	managerID := uuid.NewString()
+	firstTechnicianID := uuid.NewString()
+	secondTechnicianID := uuid.NewString()
+
+	newTransitionTask := func(
+		taskRepo *inmemoryrepo.InMemoryTaskRepo,
+		checklistRepo *inmemoryrepo.InMemoryChecklistRepo,
+	) *TransitionTask {
+		val := validator.NewValidate()
+		msgBroker := clibroker.NewCLIMessageBroker()
+		taskEventRepo := inmemoryrepo.NewInMemoryTaskEventRepo()
+		taskDependencyRepo := inmemoryrepo.NewInMemoryTaskDependencyRepo()
+		tx := transactioner.NewNoopTransactioner()
+
+		return NewTransitionTask(
+			val,
+			msgBroker,
+			taskRepo,
+			inmemoryrepo.NewInMemoryProjectRepo(),
+			taskEventRepo,
+			checklistRepo,
+			taskDependencyRepo,
+			tx,
+			NewFinishTask(
+				val,
+				msgBroker,
+				taskRepo,
+				taskEventRepo,
+				checklistRepo,
+				taskDependencyRepo,
+				tx,
+			),
+		)
+	}
+
+	newTask := func(
+		status entity.TaskStatus,
+		signedOffUserIDs []string,
+	) entity.Task {
+		return entity.Task{
+			ID:               uuid.NewString(),
+			Summary:          "Loren ipsum dolor sit amet",
+			Status:           status,
+			CreatedByUserID:  managerID,
+			AssigneeIDs:      []string{firstTechnicianID, secondTechnicianID},
+			SignedOffUserIDs: signedOffUserIDs,
+			FinishPolicy:     entity.TaskFinishPolicyAll,
+			CreatedAt:        time.Now(),
+			UpdatedAt:        time.Now(),
+		}
+	}
+
+	t.Run("should only sign off the task until all assignees submit it for review", func(t *testing.T) {
+		task := newTask(entity.TaskStatusInProgress, nil)
+		taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+		taskRepo.Tasks = append(taskRepo.Tasks, task)
+		tr := newTransitionTask(taskRepo, inmemoryrepo.NewInMemoryChecklistRepo())
+
+		wantStatuses := []entity.TaskStatus{
+			entity.TaskStatusInProgress,
+			entity.TaskStatusInReview,
+		}
+		for i, userID := range []string{firstTechnicianID, secondTechnicianID} {
+			if err := tr.Execute(context.Background(), TransitionTaskParams{
+				TaskID:   task.ID,
+				UserID:   userID,
+				UserRole: entity.RoleTechnician,
+				Status:   entity.TaskStatusInReview,
+			}); err != nil {
+				t.Fatalf("TransitionTask.Execute() error = %v", err)
+			}
+
+			got, _ := taskRepo.GetTaskByID(context.Background(), task.ID)
+			if got.Status != wantStatuses[i] {
+				t.Errorf(
+					"TransitionTask.Execute() task.Status = %v, want %v",
+					got.Status,
+					wantStatuses[i],
+				)
+			}
+		}
+	})
+
+	t.Run("should not submit the task for review with open checklist items", func(t *testing.T) {
+		task := newTask(entity.TaskStatusInProgress, []string{secondTechnicianID})
+		taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+		taskRepo.Tasks = append(taskRepo.Tasks, task)
+		checklistRepo := inmemoryrepo.NewInMemoryChecklistRepo()
+		checklistRepo.Items = append(checklistRepo.Items, entity.ChecklistItem{
+			ID:       uuid.NewString(),
+			TaskID:   task.ID,
+			Title:    "Check the pressure",
+			Required: true,
+		})
+		tr := newTransitionTask(taskRepo, checklistRepo)
+
+		err := tr.Execute(context.Background(), TransitionTaskParams{
+			TaskID:   task.ID,
+			UserID:   firstTechnicianID,
+			UserRole: entity.RoleTechnician,
+			Status:   entity.TaskStatusInReview,
+		})
+		if !testutil.IsSameErr(err, entity.ErrTaskHasOpenChecklistItems) {
+			t.Errorf(
+				"TransitionTask.Execute() error = %v, wantErr %v",
+				err,
+				entity.ErrTaskHasOpenChecklistItems,
+			)
+		}
+	})
+
+	t.Run("should clear the sign-offs when sending the task back from review", func(t *testing.T) {
+		task := newTask(
+			entity.TaskStatusInReview,
+			[]string{firstTechnicianID, secondTechnicianID},
+		)
+		taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+		taskRepo.Tasks = append(taskRepo.Tasks, task)
+		tr := newTransitionTask(taskRepo, inmemoryrepo.NewInMemoryChecklistRepo())
+
+		if err := tr.Execute(context.Background(), TransitionTaskParams{
+			TaskID:   task.ID,
+			UserID:   managerID,
+			UserRole: entity.RoleManager,
+			Status:   entity.TaskStatusInProgress,
+		}); err != nil {
+			t.Fatalf("TransitionTask.Execute() error = %v", err)
+		}
+
+		got, _ := taskRepo.GetTaskByID(context.Background(), task.ID)
+		if len(got.SignedOffUserIDs) != 0 {
+			t.Errorf(
+				"TransitionTask.Execute() task.SignedOffUserIDs = %v, want empty",
+				got.SignedOffUserIDs,
+			)
+		}
+
+		if err := tr.Execute(context.Background(), TransitionTaskParams{
+			TaskID:   task.ID,
+			UserID:   firstTechnicianID,
+			UserRole: entity.RoleTechnician,
+			Status:   entity.TaskStatusInReview,
+		}); err != nil {
+			t.Fatalf("TransitionTask.Execute() error = %v", err)
+		}
+
+		got, _ = taskRepo.GetTaskByID(context.Background(), task.ID)
+		if got.Status != entity.TaskStatusInProgress {
+			t.Errorf(
+				"TransitionTask.Execute() task.Status = %v, want %v",
+				got.Status,
+				entity.TaskStatusInProgress,
+			)
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/broker"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type UnblockDependentTasks struct {
+	msgBroker          broker.MessageBroker
+	taskRepo           repo.TaskRepo
+	taskDependencyRepo repo.TaskDependencyRepo
+}
+
+func NewUnblockDependentTasks(
+	msgBroker broker.MessageBroker,
+	taskRepo repo.TaskRepo,
+	taskDependencyRepo repo.TaskDependencyRepo,
+) *UnblockDependentTasks {
+	return &UnblockDependentTasks{
+		msgBroker:          msgBroker,
+		taskRepo:           taskRepo,
+		taskDependencyRepo: taskDependencyRepo,
+	}
+}
+
+// Execute publishes a task unblocked message for every unfinished task
+// blocked by the finished task that has no other unfinished blocker.
+// It handles the task finished messages, which may be published before
+// the finished task is committed, so the finished task is never
+// counted as an unfinished blocker.
+func (u *UnblockDependentTasks) Execute(
+	ctx context.Context,
+	finishedTask entity.Task,
+) error {
+	dependents, err := u.taskDependencyRepo.ListTaskDependents(
+		ctx,
+		finishedTask.ID,
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	for _, dependent := range dependents {
+		task, err := u.taskRepo.GetTaskByID(ctx, dependent.TaskID)
+		if err != nil {
+			return entity.NewErr(err)
+		}
+
+		if task.ID == "" || task.FinishedAt != nil {
+			continue
+		}
+
+		blocked, err := hasUnfinishedBlockers(
+			ctx,
+			u.taskRepo,
+			u.taskDependencyRepo,
+			task.ID,
+			finishedTask.ID,
+		)
+		if err != nil {
+			return entity.NewErr(err)
+		}
+
+		if blocked {
+			continue
+		}
+
+		taskBytes, err := json.Marshal(task)
+		if err != nil {
+			return entity.NewErr(err)
+		}
+
+		if err := u.msgBroker.Publish(
+			broker.TopicTaskUnblocked,
+			taskBytes,
+		); err != nil {
+			return entity.NewErr(err)
+		}
+	}
+
+	return nil
+}
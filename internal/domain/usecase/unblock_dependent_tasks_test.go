@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/broker"
+	"github.com/danielmesquitta/tasks-api/internal/provider/broker/clibroker"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/google/uuid"
+)
+
+func TestUnblockDependentTasks_Execute(t *testing.T) {
+	managerID := uuid.NewString()
+	finishedAt := time.Now()
+
+	newTask := func() entity.Task {
+		return entity.Task{
+			ID:              uuid.NewString(),
+			Summary:         "Loren ipsum dolor sit amet",
+			Status:          entity.TaskStatusOpen,
+			CreatedByUserID: managerID,
+			CreatedAt:       time.Now(),
+			UpdatedAt:       time.Now(),
+		}
+	}
+
+	// The finished task is still unfinished in the repo, as when the
+	// message is handled before the finish is committed.
+	finishedTask := newTask()
+	otherBlocker := newTask()
+	finishedBlocker := newTask()
+	finishedBlocker.FinishedAt = &finishedAt
+
+	unblockedTask := newTask()
+	stillBlockedTask := newTask()
+	alreadyFinishedTask := newTask()
+	alreadyFinishedTask.FinishedAt = &finishedAt
+
+	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+	taskRepo.Tasks = append(
+		taskRepo.Tasks,
+		finishedTask,
+		otherBlocker,
+		finishedBlocker,
+		unblockedTask,
+		stillBlockedTask,
+		alreadyFinishedTask,
+	)
+
+	taskDependencyRepo := inmemoryrepo.NewInMemoryTaskDependencyRepo()
+	taskDependencyRepo.Dependencies = append(
+		taskDependencyRepo.Dependencies,
+		entity.TaskDependency{
+			TaskID:          unblockedTask.ID,
+			BlockedByTaskID: finishedTask.ID,
+		},
+		entity.TaskDependency{
+			TaskID:          unblockedTask.ID,
+			BlockedByTaskID: finishedBlocker.ID,
+		},
+		entity.TaskDependency{
+			TaskID:          stillBlockedTask.ID,
+			BlockedByTaskID: finishedTask.ID,
+		},
+		entity.TaskDependency{
+			TaskID:          stillBlockedTask.ID,
+			BlockedByTaskID: otherBlocker.ID,
+		},
+		entity.TaskDependency{
+			TaskID:          alreadyFinishedTask.ID,
+			BlockedByTaskID: finishedTask.ID,
+		},
+	)
+
+	msgBroker := clibroker.NewCLIMessageBroker()
+
+	var (
+		mutex          sync.Mutex
+		unblockedIDs   []string
+		wg             sync.WaitGroup
+		unmarshalError error
+	)
+	wg.Add(1)
+	_ = msgBroker.Subscribe(broker.TopicTaskUnblocked, func(message []byte) {
+		defer wg.Done()
+
+		task := entity.Task{}
+		err := json.Unmarshal(message, &task)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		unmarshalError = err
+		unblockedIDs = append(unblockedIDs, task.ID)
+	})
+
+	u := NewUnblockDependentTasks(msgBroker, taskRepo, taskDependencyRepo)
+
+	if err := u.Execute(context.Background(), finishedTask); err != nil {
+		t.Fatalf("UnblockDependentTasks.Execute() error = %v", err)
+	}
+
+	wg.Wait()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if unmarshalError != nil {
+		t.Fatalf("UnblockDependentTasks.Execute() message error = %v", unmarshalError)
+	}
+
+	if !slices.Equal(unblockedIDs, []string{unblockedTask.ID}) {
+		t.Errorf(
+			"UnblockDependentTasks.Execute() unblocked = %v, want %v",
+			unblockedIDs,
+			[]string{unblockedTask.ID},
+		)
+	}
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type UpdateChecklistItem struct {
+	validator     validator.Validator
+	symCrypto     symcrypt.SymmetricalEncrypter
+	taskRepo      repo.TaskRepo
+	projectRepo   repo.ProjectRepo
+	checklistRepo repo.ChecklistRepo
+}
+
+func NewUpdateChecklistItem(
+	validator validator.Validator,
+	symCrypto symcrypt.SymmetricalEncrypter,
+	taskRepo repo.TaskRepo,
+	projectRepo repo.ProjectRepo,
+	checklistRepo repo.ChecklistRepo,
+) *UpdateChecklistItem {
+	return &UpdateChecklistItem{
+		validator:     validator,
+		symCrypto:     symCrypto,
+		taskRepo:      taskRepo,
+		projectRepo:   projectRepo,
+		checklistRepo: checklistRepo,
+	}
+}
+
+// UpdateChecklistItemParams only changes the fields that are set.
+type UpdateChecklistItemParams struct {
+	TaskID   string      `json:"task_id,omitempty"  validate:"required,uuid"`
+	ItemID   string      `json:"item_id,omitempty"  validate:"required,uuid"`
+	UserID   string      `json:"user_id,omitempty"  validate:"required,uuid"`
+	UserRole entity.Role `json:"role,omitempty"     validate:"required,min=1,max=2"`
+	Title    *string     `json:"title,omitempty"    validate:"omitempty,min=1,max=500"`
+	Required *bool       `json:"required,omitempty"`
+	Done     *bool       `json:"done,omitempty"`
+}
+
+func (u *UpdateChecklistItem) Execute(
+	ctx context.Context,
+	params UpdateChecklistItemParams,
+) (entity.ChecklistItem, error) {
+	if err := u.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return entity.ChecklistItem{}, validationErr
+	}
+
+	task, err := u.taskRepo.GetTaskByID(ctx, params.TaskID)
+	if err != nil {
+		return entity.ChecklistItem{}, entity.NewErr(err)
+	}
+
+	if task.ID == "" {
+		return entity.ChecklistItem{}, entity.ErrTaskNotFound
+	}
+
+	if err := ensureTaskVisible(
+		ctx,
+		u.projectRepo,
+		task,
+		params.UserID,
+		params.UserRole,
+		entity.ErrUserNotAllowedToEditChecklist,
+	); err != nil {
+		return entity.ChecklistItem{}, err
+	}
+
+	item, err := u.checklistRepo.GetChecklistItemByID(ctx, params.ItemID)
+	if err != nil {
+		return entity.ChecklistItem{}, entity.NewErr(err)
+	}
+
+	if item.ID == "" || item.TaskID != task.ID {
+		return entity.ChecklistItem{}, entity.ErrChecklistItemNotFound
+	}
+
+	if params.Title != nil {
+		encryptedTitle, err := u.symCrypto.Encrypt(*params.Title)
+		if err != nil {
+			return entity.ChecklistItem{}, entity.NewErr(err)
+		}
+		item.Title = encryptedTitle
+	}
+
+	if params.Required != nil {
+		item.Required = *params.Required
+	}
+
+	if params.Done != nil && *params.Done != item.Done {
+		item.Done = *params.Done
+		item.DoneAt = nil
+		if item.Done {
+			doneAt := time.Now()
+			item.DoneAt = &doneAt
+		}
+	}
+
+	if err := u.checklistRepo.UpdateChecklistItem(
+		ctx,
+		repo.UpdateChecklistItemParams{
+			ID:       item.ID,
+			Title:    item.Title,
+			Required: item.Required,
+			Done:     item.Done,
+			DoneAt:   item.DoneAt,
+		},
+	); err != nil {
+		return entity.ChecklistItem{}, entity.NewErr(err)
+	}
+
+	decryptedTitle, err := u.symCrypto.Decrypt(item.Title)
+	if err != nil {
+		return entity.ChecklistItem{}, entity.NewErr(err)
+	}
+	item.Title = decryptedTitle
+	item.UpdatedAt = time.Now()
+
+	return item, nil
+}
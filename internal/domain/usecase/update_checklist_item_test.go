@@ -0,0 +1,199 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestUpdateChecklistItem_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	managerID := uuid.NewString()
+	technicianID := uuid.NewString()
+
+	task := entity.Task{
+		ID:               uuid.NewString(),
+		Summary:          "Loren ipsum dolor sit amet",
+		Status:           entity.TaskStatusOpen,
+		AssignedToUserID: &technicianID,
+		CreatedByUserID:  managerID,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+	otherTask := entity.Task{
+		ID:              uuid.NewString(),
+		Summary:         "Loren ipsum dolor sit amet",
+		Status:          entity.TaskStatusOpen,
+		CreatedByUserID: managerID,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+
+	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+	taskRepo.Tasks = append(taskRepo.Tasks, task, otherTask)
+
+	project := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Maintenance",
+		ManagerUserID: uuid.NewString(),
+	}
+	projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+	projectRepo.Projects = append(projectRepo.Projects, project)
+
+	projectTask := task
+	projectTask.ID = uuid.NewString()
+	projectTask.ProjectID = &project.ID
+	taskRepo.Tasks = append(taskRepo.Tasks, projectTask)
+
+	encryptedTitle, err := symCrypto.Encrypt("Replace the filter")
+	if err != nil {
+		t.Fatalf("Error encrypting title: %v", err)
+	}
+
+	item := entity.ChecklistItem{
+		ID:       uuid.NewString(),
+		TaskID:   task.ID,
+		Position: 1,
+		Title:    encryptedTitle,
+		Required: true,
+	}
+
+	done := true
+	title := "Replace the air filter"
+
+	type args struct {
+		params UpdateChecklistItemParams
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantTitle string
+		wantDone  bool
+		wantErr   error
+	}{
+		{
+			name: "should tick item off if user is the assignee",
+			args: args{
+				params: UpdateChecklistItemParams{
+					TaskID:   task.ID,
+					ItemID:   item.ID,
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+					Done:     &done,
+				},
+			},
+			wantTitle: "Replace the filter",
+			wantDone:  true,
+			wantErr:   nil,
+		},
+		{
+			name: "should rename item if user is a manager",
+			args: args{
+				params: UpdateChecklistItemParams{
+					TaskID:   task.ID,
+					ItemID:   item.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					Title:    &title,
+				},
+			},
+			wantTitle: title,
+			wantDone:  false,
+			wantErr:   nil,
+		},
+		{
+			name: "should not update item if user is not the assignee",
+			args: args{
+				params: UpdateChecklistItemParams{
+					TaskID:   task.ID,
+					ItemID:   item.ID,
+					UserID:   uuid.NewString(),
+					UserRole: entity.RoleTechnician,
+					Done:     &done,
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToEditChecklist,
+		},
+		{
+			name: "should not update item from another task",
+			args: args{
+				params: UpdateChecklistItemParams{
+					TaskID:   otherTask.ID,
+					ItemID:   item.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					Done:     &done,
+				},
+			},
+			wantErr: entity.ErrChecklistItemNotFound,
+		},
+		{
+			name: "should not update item if user is not a project member",
+			args: args{
+				params: UpdateChecklistItemParams{
+					TaskID:   projectTask.ID,
+					ItemID:   item.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					Title:    &title,
+				},
+			},
+			wantErr: entity.ErrUserNotProjectMember,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			checklistRepo := inmemoryrepo.NewInMemoryChecklistRepo()
+			checklistRepo.Items = append(checklistRepo.Items, item)
+
+			u := NewUpdateChecklistItem(val, symCrypto, taskRepo, projectRepo, checklistRepo)
+
+			got, err := u.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"UpdateChecklistItem.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.Title != tt.wantTitle {
+				t.Errorf(
+					"UpdateChecklistItem.Execute() title = %v, want %v",
+					got.Title,
+					tt.wantTitle,
+				)
+			}
+
+			stored := checklistRepo.Items[0]
+			if stored.Done != tt.wantDone || (stored.DoneAt != nil) != tt.wantDone {
+				t.Errorf(
+					"UpdateChecklistItem.Execute() done = %v, want %v",
+					stored.Done,
+					tt.wantDone,
+				)
+			}
+
+			if !stored.Required {
+				t.Errorf("UpdateChecklistItem.Execute() required was changed")
+			}
+		})
+	}
+}
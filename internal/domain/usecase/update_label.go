@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type UpdateLabel struct {
+	validator validator.Validator
+	labelRepo repo.LabelRepo
+}
+
+func NewUpdateLabel(
+	validator validator.Validator,
+	labelRepo repo.LabelRepo,
+) *UpdateLabel {
+	return &UpdateLabel{
+		validator: validator,
+		labelRepo: labelRepo,
+	}
+}
+
+type UpdateLabelParams struct {
+	ID       string      `json:"id,omitempty"        validate:"required,uuid"`
+	UserRole entity.Role `json:"user_role,omitempty" validate:"required,min=1,max=2"`
+	Name     string      `json:"name,omitempty"      validate:"required,max=50"`
+}
+
+// Execute renames the label.
+func (u *UpdateLabel) Execute(
+	ctx context.Context,
+	params UpdateLabelParams,
+) (entity.Label, error) {
+	if params.UserRole != entity.RoleManager {
+		return entity.Label{}, entity.ErrUserNotAllowedToManageLabels
+	}
+
+	params.Name = strings.TrimSpace(params.Name)
+
+	if err := u.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return entity.Label{}, validationErr
+	}
+
+	label, err := u.labelRepo.GetLabelByID(ctx, params.ID)
+	if err != nil {
+		return entity.Label{}, entity.NewErr(err)
+	}
+
+	if label.ID == "" {
+		return entity.Label{}, entity.ErrLabelNotFound
+	}
+
+	existingLabel, err := u.labelRepo.GetLabelByName(ctx, params.Name)
+	if err != nil {
+		return entity.Label{}, entity.NewErr(err)
+	}
+
+	if existingLabel.ID != "" && existingLabel.ID != label.ID {
+		return entity.Label{}, entity.ErrLabelAlreadyExists
+	}
+
+	if err := u.labelRepo.UpdateLabel(ctx, repo.UpdateLabelParams{
+		ID:   label.ID,
+		Name: params.Name,
+	}); err != nil {
+		return entity.Label{}, entity.NewErr(err)
+	}
+
+	label.Name = params.Name
+	label.UpdatedAt = time.Now()
+
+	return label, nil
+}
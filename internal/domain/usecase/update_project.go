@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type UpdateProject struct {
+	validator   validator.Validator
+	projectRepo repo.ProjectRepo
+}
+
+func NewUpdateProject(
+	validator validator.Validator,
+	projectRepo repo.ProjectRepo,
+) *UpdateProject {
+	return &UpdateProject{
+		validator:   validator,
+		projectRepo: projectRepo,
+	}
+}
+
+type UpdateProjectParams struct {
+	ID          string      `json:"id,omitempty"          validate:"required,uuid"`
+	UserRole    entity.Role `json:"user_role,omitempty"   validate:"required,min=1,max=2"`
+	UserID      string      `json:"user_id,omitempty"     validate:"required,uuid"`
+	Name        string      `json:"name,omitempty"        validate:"required,max=100"`
+	Description string      `json:"description,omitempty" validate:"max=2500"`
+	// ManagerUserID hands the project over to another of its members,
+	// unless it is empty.
+	ManagerUserID string `json:"manager_user_id,omitempty" validate:"omitempty,uuid"`
+}
+
+// Execute updates the project, which only its project manager can do.
+func (u *UpdateProject) Execute(
+	ctx context.Context,
+	params UpdateProjectParams,
+) (entity.Project, error) {
+	if params.UserRole != entity.RoleManager {
+		return entity.Project{}, entity.ErrUserNotAllowedToManageProjects
+	}
+
+	params.Name = strings.TrimSpace(params.Name)
+
+	if err := u.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return entity.Project{}, validationErr
+	}
+
+	project, err := ensureProjectManager(
+		ctx,
+		u.projectRepo,
+		params.ID,
+		params.UserID,
+	)
+	if err != nil {
+		return entity.Project{}, err
+	}
+
+	if params.ManagerUserID != "" &&
+		params.ManagerUserID != project.ManagerUserID {
+		member, err := u.projectRepo.GetProjectMember(
+			ctx,
+			project.ID,
+			params.ManagerUserID,
+		)
+		if err != nil {
+			return entity.Project{}, entity.NewErr(err)
+		}
+
+		if member.UserID == "" {
+			return entity.Project{}, entity.ErrProjectMemberNotFound
+		}
+
+		project.ManagerUserID = params.ManagerUserID
+	}
+
+	project.Name = params.Name
+	project.Description = params.Description
+
+	if err := u.projectRepo.UpdateProject(ctx, repo.UpdateProjectParams{
+		ID:            project.ID,
+		Name:          project.Name,
+		Description:   project.Description,
+		ManagerUserID: project.ManagerUserID,
+	}); err != nil {
+		return entity.Project{}, entity.NewErr(err)
+	}
+
+	project.UpdatedAt = time.Now()
+
+	return project, nil
+}
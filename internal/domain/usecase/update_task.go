@@ -2,19 +2,25 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
 	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
 	"github.com/jinzhu/copier"
 )
 
 type UpdateTask struct {
-	validator validator.Validator
-	symCrypto symcrypt.SymmetricalEncrypter
-	taskRepo  repo.TaskRepo
-	userRepo  repo.UserRepo
+	validator     validator.Validator
+	symCrypto     symcrypt.SymmetricalEncrypter
+	taskRepo      repo.TaskRepo
+	userRepo      repo.UserRepo
+	labelRepo     repo.LabelRepo
+	projectRepo   repo.ProjectRepo
+	taskEventRepo repo.TaskEventRepo
+	tx            transactioner.Transactioner
 }
 
 func NewUpdateTask(
@@ -22,21 +28,50 @@ func NewUpdateTask(
 	symCrypto symcrypt.SymmetricalEncrypter,
 	taskRepo repo.TaskRepo,
 	userRepo repo.UserRepo,
+	labelRepo repo.LabelRepo,
+	projectRepo repo.ProjectRepo,
+	taskEventRepo repo.TaskEventRepo,
+	tx transactioner.Transactioner,
 ) *UpdateTask {
 	return &UpdateTask{
-		validator: validator,
-		symCrypto: symCrypto,
-		taskRepo:  taskRepo,
-		userRepo:  userRepo,
+		validator:     validator,
+		symCrypto:     symCrypto,
+		taskRepo:      taskRepo,
+		userRepo:      userRepo,
+		labelRepo:     labelRepo,
+		projectRepo:   projectRepo,
+		taskEventRepo: taskEventRepo,
+		tx:            tx,
 	}
 }
 
 type UpdateTaskParams struct {
-	ID               string      `json:"id,omitempty"                  validate:"required,uuid"`
-	UserID           string      `json:"user_id,omitempty"             validate:"required,uuid"`
-	UserRole         entity.Role `json:"user_role,omitempty"           validate:"required,min=1,max=2"`
-	Summary          string      `json:"summary,omitempty"             validate:"required,min=1,max=2500"`
-	AssignedToUserID *string     `json:"assigned_to_user_id,omitempty" validate:"omitempty,uuid"`
+	ID       string      `json:"id,omitempty"                  validate:"required,uuid"`
+	UserID   string      `json:"user_id,omitempty"             validate:"required,uuid"`
+	UserRole entity.Role `json:"user_role,omitempty"           validate:"required,min=1,max=2"`
+	// Summary replaces the task summary, unless it is nil.
+	Summary *string `json:"summary,omitempty" validate:"omitempty,min=1,max=2500"`
+	// Version is the version of the task the changes were made on. The
+	// update is rejected if the task was changed since.
+	Version int64 `json:"version,omitempty" validate:"required,min=1"`
+	// AssignedToUserID makes the user the only assignee of the task,
+	// unless it is nil. AssigneeIDs replaces the task assignees, unless
+	// it is nil, and are assigned after AssignedToUserID if both are set.
+	AssignedToUserID *string  `json:"assigned_to_user_id,omitempty" validate:"omitempty,uuid"`
+	AssigneeIDs      []string `json:"assignee_ids,omitempty"        validate:"omitempty,dive,uuid"`
+	// LabelIDs replaces the task labels, unless it is nil.
+	LabelIDs []string `json:"label_ids,omitempty" validate:"omitempty,dive,uuid"`
+	// DueAt replaces the task due date, unless it is nil. ClearDueAt
+	// removes it instead.
+	DueAt      *time.Time `json:"due_at,omitempty"       validate:"excluded_with=ClearDueAt"`
+	ClearDueAt bool       `json:"clear_due_at,omitempty"`
+	// Priority replaces the task priority, unless it is empty.
+	Priority entity.TaskPriority `json:"priority,omitempty" validate:"omitempty,oneof=low normal high critical"`
+	// FinishPolicy replaces the task finish policy, unless it is empty.
+	FinishPolicy entity.TaskFinishPolicy `json:"finish_policy,omitempty" validate:"omitempty,oneof=any all"`
+	// ProjectID moves the task to the project, unless it is nil. The
+	// user must be a member of it too.
+	ProjectID *string `json:"project_id,omitempty" validate:"omitempty,uuid"`
 }
 
 func (u *UpdateTask) Execute(
@@ -58,51 +93,154 @@ func (u *UpdateTask) Execute(
 		return entity.ErrTaskNotFound
 	}
 
+	updatesAssignees := params.AssignedToUserID != nil ||
+		params.AssigneeIDs != nil
+
 	switch params.UserRole {
 	case entity.RoleTechnician:
-		if params.AssignedToUserID != nil {
+		if updatesAssignees {
 			return entity.ErrUserNotAllowedToUpdateAssignedUser
 		}
 
-		if task.AssignedToUserID == nil ||
-			*task.AssignedToUserID != params.UserID {
+		if params.LabelIDs != nil {
+			return entity.ErrUserNotAllowedToUpdateTaskLabels
+		}
+
+		if params.DueAt != nil || params.ClearDueAt || params.Priority != "" ||
+			params.FinishPolicy != "" || params.ProjectID != nil {
+			return entity.ErrUserNotAllowedToUpdateTaskPlanning
+		}
+
+		if !task.IsAssignedTo(params.UserID) {
 			return entity.ErrUserNotAllowedToUpdateTask
 		}
 
 	case entity.RoleManager:
-		if params.AssignedToUserID == nil {
-			break
+		if err := ensureTaskProjectMember(
+			ctx,
+			u.projectRepo,
+			task,
+			params.UserID,
+		); err != nil {
+			return err
 		}
 
-		var assignedUser entity.User
-		assignedUser, err = u.userRepo.GetUserByID(
-			ctx,
-			*params.AssignedToUserID,
-		)
+		if params.ProjectID != nil {
+			if _, err := ensureProjectMember(
+				ctx,
+				u.projectRepo,
+				*params.ProjectID,
+				params.UserID,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	if task.Version != params.Version {
+		return entity.ErrTaskVersionMismatch
+	}
+
+	var assigneeIDs []string
+	if updatesAssignees {
+		assigneeIDs = params.AssigneeIDs
+		if params.AssignedToUserID != nil {
+			assigneeIDs = append(
+				[]string{*params.AssignedToUserID},
+				assigneeIDs...,
+			)
+		}
+
+		assigneeIDs, err = ensureAssignees(ctx, u.userRepo, assigneeIDs)
 		if err != nil {
-			return entity.NewErr(err)
+			return err
 		}
+	}
 
-		if assignedUser.ID == "" {
-			return entity.ErrUserNotFound
+	var labelIDs []string
+	if params.LabelIDs != nil {
+		labelIDs, err = ensureLabelsExist(ctx, u.labelRepo, params.LabelIDs)
+		if err != nil {
+			return err
 		}
 	}
 
-	repoParams := repo.UpdateTaskParams{}
-	if err = copier.CopyWithOption(&repoParams, params, copier.Option{
-		IgnoreEmpty: true,
-	}); err != nil {
-		return entity.NewErr(err)
+	updatedTask := task
+
+	if params.Summary != nil {
+		encryptedSummary, err := u.symCrypto.Encrypt(*params.Summary)
+		if err != nil {
+			return entity.NewErr(err)
+		}
+
+		updatedTask.Summary = encryptedSummary
 	}
 
-	encryptedSummary, err := u.symCrypto.Encrypt(params.Summary)
-	if err != nil {
+	if updatesAssignees {
+		updatedTask = withAssignees(updatedTask, assigneeIDs)
+	}
+
+	if params.DueAt != nil {
+		updatedTask.DueAt = params.DueAt
+	}
+
+	if params.ClearDueAt {
+		updatedTask.DueAt = nil
+	}
+
+	if params.Priority != "" {
+		updatedTask.Priority = params.Priority
+	}
+
+	if params.FinishPolicy != "" {
+		updatedTask.FinishPolicy = params.FinishPolicy
+	}
+
+	if params.ProjectID != nil {
+		updatedTask.ProjectID = params.ProjectID
+	}
+
+	repoParams := repo.UpdateTaskParams{}
+	if err = copier.Copy(&repoParams, updatedTask); err != nil {
 		return entity.NewErr(err)
 	}
 
-	repoParams.Summary = encryptedSummary
+	err = u.tx.Do(ctx, func(ctx context.Context) error {
+		if err := u.taskRepo.UpdateTask(ctx, repoParams); err != nil {
+			return entity.NewErr(err)
+		}
+
+		if updatesAssignees {
+			if err := u.taskRepo.SetTaskAssignees(
+				ctx,
+				task.ID,
+				assigneeIDs,
+			); err != nil {
+				return entity.NewErr(err)
+			}
+		}
+
+		if params.LabelIDs != nil {
+			if err := u.taskRepo.SetTaskLabels(
+				ctx,
+				task.ID,
+				labelIDs,
+			); err != nil {
+				return entity.NewErr(err)
+			}
+		}
+
+		return recordTaskEvent(
+			ctx,
+			u.taskEventRepo,
+			entity.TaskEventUpdated,
+			params.UserID,
+			task,
+			updatedTask,
+		)
+	})
 
-	if err := u.taskRepo.UpdateTask(ctx, repoParams); err != nil {
+	if err != nil {
 		return entity.NewErr(err)
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/danielmesquitta/tasks-api/internal/config"
 	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
 	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
 	"github.com/danielmesquitta/tasks-api/test/testutil"
@@ -40,7 +41,38 @@ func TestUpdateTask_Execute(t *testing.T) {
 		return userRepo
 	}
 
+	label := entity.Label{
+		ID:   uuid.NewString(),
+		Name: "urgent",
+	}
+	labelRepo := inmemoryrepo.NewInMemoryLabelRepo()
+	labelRepo.Labels = append(labelRepo.Labels, label)
+
+	project := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Maintenance",
+		ManagerUserID: managerUser.ID,
+	}
+	otherProject := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Installation",
+		ManagerUserID: uuid.NewString(),
+	}
+	projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+	projectRepo.Projects = append(projectRepo.Projects, project, otherProject)
+	projectRepo.Members = append(
+		projectRepo.Members,
+		entity.ProjectMember{ProjectID: project.ID, UserID: managerUser.ID},
+		entity.ProjectMember{
+			ProjectID: otherProject.ID,
+			UserID:    otherProject.ManagerUserID,
+		},
+	)
+
 	beforeUpdateSummary := "Loren ipsum dolor sit amet"
+	summary := "Loren ipsum"
+	longSummary := strings.Repeat("a", 2501)
+	dueAt := time.Date(2026, 10, 20, 18, 0, 0, 0, time.UTC)
 	newTaskRepo := func() *inmemoryrepo.InMemoryTaskRepo {
 		taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
 		task := entity.Task{
@@ -49,6 +81,7 @@ func TestUpdateTask_Execute(t *testing.T) {
 			CreatedByUserID: uuid.NewString(),
 			CreatedAt:       time.Now(),
 			UpdatedAt:       time.Now(),
+			Version:         1,
 		}
 		taskRepo.Tasks = append(
 			taskRepo.Tasks,
@@ -87,9 +120,10 @@ func TestUpdateTask_Execute(t *testing.T) {
 				args: args{
 					params: UpdateTaskParams{
 						ID:               taskRepo.Tasks[0].ID,
+						Version:          1,
 						UserID:           managerUser.ID,
 						UserRole:         entity.RoleManager,
-						Summary:          "Loren ipsum",
+						Summary:          &summary,
 						AssignedToUserID: &technicianUser.ID,
 					},
 				},
@@ -110,9 +144,10 @@ func TestUpdateTask_Execute(t *testing.T) {
 				args: args{
 					params: UpdateTaskParams{
 						ID:       taskRepo.Tasks[0].ID,
+						Version:  1,
 						UserID:   managerUser.ID,
 						UserRole: entity.RoleManager,
-						Summary:  "Loren ipsum",
+						Summary:  &summary,
 					},
 				},
 				wantErr: nil,
@@ -132,9 +167,10 @@ func TestUpdateTask_Execute(t *testing.T) {
 				args: args{
 					params: UpdateTaskParams{
 						ID:               "invalid-id",
+						Version:          1,
 						UserID:           managerUser.ID,
 						UserRole:         entity.RoleManager,
-						Summary:          "Loren ipsum",
+						Summary:          &summary,
 						AssignedToUserID: &technicianUser.ID,
 					},
 				},
@@ -155,9 +191,10 @@ func TestUpdateTask_Execute(t *testing.T) {
 				args: args{
 					params: UpdateTaskParams{
 						ID:               taskRepo.Tasks[0].ID,
+						Version:          1,
 						UserID:           "invalid-user-id",
 						UserRole:         entity.RoleManager,
-						Summary:          "Loren ipsum",
+						Summary:          &summary,
 						AssignedToUserID: &technicianUser.ID,
 					},
 				},
@@ -178,9 +215,10 @@ func TestUpdateTask_Execute(t *testing.T) {
 				args: args{
 					params: UpdateTaskParams{
 						ID:               taskRepo.Tasks[0].ID,
+						Version:          1,
 						UserID:           managerUser.ID,
 						UserRole:         0,
-						Summary:          "Loren ipsum",
+						Summary:          &summary,
 						AssignedToUserID: &technicianUser.ID,
 					},
 				},
@@ -201,9 +239,10 @@ func TestUpdateTask_Execute(t *testing.T) {
 				args: args{
 					params: UpdateTaskParams{
 						ID:               taskRepo.Tasks[0].ID,
+						Version:          1,
 						UserID:           managerUser.ID,
 						UserRole:         entity.RoleManager,
-						Summary:          strings.Repeat("a", 2501),
+						Summary:          &longSummary,
 						AssignedToUserID: &technicianUser.ID,
 					},
 				},
@@ -225,9 +264,10 @@ func TestUpdateTask_Execute(t *testing.T) {
 				args: args{
 					params: UpdateTaskParams{
 						ID:               taskRepo.Tasks[0].ID,
+						Version:          1,
 						UserID:           managerUser.ID,
 						UserRole:         entity.RoleManager,
-						Summary:          "Lorem ipsum",
+						Summary:          &summary,
 						AssignedToUserID: &invalidUserID,
 					},
 				},
@@ -248,9 +288,10 @@ func TestUpdateTask_Execute(t *testing.T) {
 				args: args{
 					params: UpdateTaskParams{
 						ID:               uuid.NewString(),
+						Version:          1,
 						UserID:           managerUser.ID,
 						UserRole:         entity.RoleManager,
-						Summary:          "Loren ipsum",
+						Summary:          &summary,
 						AssignedToUserID: &technicianUser.ID,
 					},
 				},
@@ -272,13 +313,14 @@ func TestUpdateTask_Execute(t *testing.T) {
 				args: args{
 					params: UpdateTaskParams{
 						ID:               taskRepo.Tasks[0].ID,
+						Version:          1,
 						UserID:           managerUser.ID,
 						UserRole:         entity.RoleManager,
-						Summary:          "Loren ipsum",
+						Summary:          &summary,
 						AssignedToUserID: &nonExistingUserID,
 					},
 				},
-				wantErr: entity.ErrUserNotFound,
+				wantErr: entity.ErrAssignToUserNotFound,
 			}
 		}(),
 		func() test {
@@ -295,9 +337,10 @@ func TestUpdateTask_Execute(t *testing.T) {
 				args: args{
 					params: UpdateTaskParams{
 						ID:               taskRepo.Tasks[0].ID,
+						Version:          1,
 						UserID:           technicianUser.ID,
 						UserRole:         entity.RoleTechnician,
-						Summary:          "Loren ipsum",
+						Summary:          &summary,
 						AssignedToUserID: &technicianUser.ID,
 					},
 				},
@@ -318,24 +361,343 @@ func TestUpdateTask_Execute(t *testing.T) {
 				args: args{
 					params: UpdateTaskParams{
 						ID:       taskRepo.Tasks[0].ID,
+						Version:  1,
 						UserID:   uuid.NewString(),
 						UserRole: entity.RoleTechnician,
-						Summary:  "Loren ipsum",
+						Summary:  &summary,
 					},
 				},
 				wantErr: entity.ErrUserNotAllowedToUpdateTask,
 			}
 		}(),
+		func() test {
+			taskRepo := newTaskRepo()
+			userRepo := newUserRepo()
+			return test{
+				name: "should update the task labels",
+				fields: fields{
+					validator: val,
+					symCrypto: symCrypto,
+					taskRepo:  taskRepo,
+					userRepo:  userRepo,
+				},
+				args: args{
+					params: UpdateTaskParams{
+						ID:       taskRepo.Tasks[0].ID,
+						Version:  1,
+						UserID:   managerUser.ID,
+						UserRole: entity.RoleManager,
+						Summary:  &summary,
+						LabelIDs: []string{label.ID},
+					},
+				},
+				wantErr: nil,
+			}
+		}(),
+		func() test {
+			taskRepo := newTaskRepo()
+			userRepo := newUserRepo()
+			return test{
+				name: "should not update the task labels with non-existent label",
+				fields: fields{
+					validator: val,
+					symCrypto: symCrypto,
+					taskRepo:  taskRepo,
+					userRepo:  userRepo,
+				},
+				args: args{
+					params: UpdateTaskParams{
+						ID:       taskRepo.Tasks[0].ID,
+						Version:  1,
+						UserID:   managerUser.ID,
+						UserRole: entity.RoleManager,
+						Summary:  &summary,
+						LabelIDs: []string{uuid.NewString()},
+					},
+				},
+				wantErr: entity.ErrLabelNotFound,
+			}
+		}(),
+		func() test {
+			taskRepo := newTaskRepo()
+			userRepo := newUserRepo()
+			taskRepo.Tasks[0].AssignedToUserID = &technicianUser.ID
+			return test{
+				name: "should not update the task labels if user role is not manager",
+				fields: fields{
+					validator: val,
+					symCrypto: symCrypto,
+					taskRepo:  taskRepo,
+					userRepo:  userRepo,
+				},
+				args: args{
+					params: UpdateTaskParams{
+						ID:       taskRepo.Tasks[0].ID,
+						Version:  1,
+						UserID:   technicianUser.ID,
+						UserRole: entity.RoleTechnician,
+						Summary:  &summary,
+						LabelIDs: []string{},
+					},
+				},
+				wantErr: entity.ErrUserNotAllowedToUpdateTaskLabels,
+			}
+		}(),
+		func() test {
+			taskRepo := newTaskRepo()
+			userRepo := newUserRepo()
+			return test{
+				name: "should update the task due date and priority",
+				fields: fields{
+					validator: val,
+					symCrypto: symCrypto,
+					taskRepo:  taskRepo,
+					userRepo:  userRepo,
+				},
+				args: args{
+					params: UpdateTaskParams{
+						ID:       taskRepo.Tasks[0].ID,
+						Version:  1,
+						UserID:   managerUser.ID,
+						UserRole: entity.RoleManager,
+						Summary:  &summary,
+						DueAt:    &dueAt,
+						Priority: entity.TaskPriorityCritical,
+					},
+				},
+				wantErr: nil,
+			}
+		}(),
+		func() test {
+			taskRepo := newTaskRepo()
+			userRepo := newUserRepo()
+			return test{
+				name: "should update the assigned user without changing the summary",
+				fields: fields{
+					validator: val,
+					symCrypto: symCrypto,
+					taskRepo:  taskRepo,
+					userRepo:  userRepo,
+				},
+				args: args{
+					params: UpdateTaskParams{
+						ID:               taskRepo.Tasks[0].ID,
+						Version:          1,
+						UserID:           managerUser.ID,
+						UserRole:         entity.RoleManager,
+						AssignedToUserID: &technicianUser.ID,
+					},
+				},
+				wantErr: nil,
+			}
+		}(),
+		func() test {
+			taskRepo := newTaskRepo()
+			userRepo := newUserRepo()
+			emptySummary := ""
+			return test{
+				name: "should not update a task with empty summary",
+				fields: fields{
+					validator: val,
+					symCrypto: symCrypto,
+					taskRepo:  taskRepo,
+					userRepo:  userRepo,
+				},
+				args: args{
+					params: UpdateTaskParams{
+						ID:       taskRepo.Tasks[0].ID,
+						Version:  1,
+						UserID:   managerUser.ID,
+						UserRole: entity.RoleManager,
+						Summary:  &emptySummary,
+					},
+				},
+				wantErr: entity.ErrValidation,
+			}
+		}(),
+		func() test {
+			taskRepo := newTaskRepo()
+			userRepo := newUserRepo()
+			taskRepo.Tasks[0].AssignedToUserID = &technicianUser.ID
+			return test{
+				name: "should not update the task priority if user role is not manager",
+				fields: fields{
+					validator: val,
+					symCrypto: symCrypto,
+					taskRepo:  taskRepo,
+					userRepo:  userRepo,
+				},
+				args: args{
+					params: UpdateTaskParams{
+						ID:       taskRepo.Tasks[0].ID,
+						Version:  1,
+						UserID:   technicianUser.ID,
+						UserRole: entity.RoleTechnician,
+						Summary:  &summary,
+						Priority: entity.TaskPriorityHigh,
+					},
+				},
+				wantErr: entity.ErrUserNotAllowedToUpdateTaskPlanning,
+			}
+		}(),
+		func() test {
+			taskRepo := newTaskRepo()
+			userRepo := newUserRepo()
+			return test{
+				name: "should not update a task with both due date and clear due date",
+				fields: fields{
+					validator: val,
+					symCrypto: symCrypto,
+					taskRepo:  taskRepo,
+					userRepo:  userRepo,
+				},
+				args: args{
+					params: UpdateTaskParams{
+						ID:         taskRepo.Tasks[0].ID,
+						Version:    1,
+						UserID:     managerUser.ID,
+						UserRole:   entity.RoleManager,
+						Summary:    &summary,
+						DueAt:      &dueAt,
+						ClearDueAt: true,
+					},
+				},
+				wantErr: entity.ErrValidation,
+			}
+		}(),
+		func() test {
+			taskRepo := newTaskRepo()
+			userRepo := newUserRepo()
+			return test{
+				name: "should move a task to a project the user is a member of",
+				fields: fields{
+					validator: val,
+					symCrypto: symCrypto,
+					taskRepo:  taskRepo,
+					userRepo:  userRepo,
+				},
+				args: args{
+					params: UpdateTaskParams{
+						ID:        taskRepo.Tasks[0].ID,
+						Version:   1,
+						UserID:    managerUser.ID,
+						UserRole:  entity.RoleManager,
+						Summary:   &summary,
+						ProjectID: &project.ID,
+					},
+				},
+				wantErr: nil,
+			}
+		}(),
+		func() test {
+			taskRepo := newTaskRepo()
+			userRepo := newUserRepo()
+			return test{
+				name: "should not move a task to a project the user is not a member of",
+				fields: fields{
+					validator: val,
+					symCrypto: symCrypto,
+					taskRepo:  taskRepo,
+					userRepo:  userRepo,
+				},
+				args: args{
+					params: UpdateTaskParams{
+						ID:        taskRepo.Tasks[0].ID,
+						Version:   1,
+						UserID:    managerUser.ID,
+						UserRole:  entity.RoleManager,
+						Summary:   &summary,
+						ProjectID: &otherProject.ID,
+					},
+				},
+				wantErr: entity.ErrUserNotProjectMember,
+			}
+		}(),
+		func() test {
+			taskRepo := newTaskRepo()
+			userRepo := newUserRepo()
+			taskRepo.Tasks[0].ProjectID = &otherProject.ID
+			return test{
+				name: "should not update a project task if user is not a project member",
+				fields: fields{
+					validator: val,
+					symCrypto: symCrypto,
+					taskRepo:  taskRepo,
+					userRepo:  userRepo,
+				},
+				args: args{
+					params: UpdateTaskParams{
+						ID:       taskRepo.Tasks[0].ID,
+						Version:  1,
+						UserID:   managerUser.ID,
+						UserRole: entity.RoleManager,
+						Summary:  &summary,
+					},
+				},
+				wantErr: entity.ErrUserNotProjectMember,
+			}
+		}(),
+		func() test {
+			taskRepo := newTaskRepo()
+			userRepo := newUserRepo()
+			taskRepo.Tasks[0].Version = 2
+			return test{
+				name: "should not update a task changed since it was read",
+				fields: fields{
+					validator: val,
+					symCrypto: symCrypto,
+					taskRepo:  taskRepo,
+					userRepo:  userRepo,
+				},
+				args: args{
+					params: UpdateTaskParams{
+						ID:       taskRepo.Tasks[0].ID,
+						Version:  1,
+						UserID:   managerUser.ID,
+						UserRole: entity.RoleManager,
+						Summary:  &summary,
+					},
+				},
+				wantErr: entity.ErrTaskVersionMismatch,
+			}
+		}(),
+		func() test {
+			taskRepo := newTaskRepo()
+			userRepo := newUserRepo()
+			return test{
+				name: "should not update a task without its version",
+				fields: fields{
+					validator: val,
+					symCrypto: symCrypto,
+					taskRepo:  taskRepo,
+					userRepo:  userRepo,
+				},
+				args: args{
+					params: UpdateTaskParams{
+						ID:       taskRepo.Tasks[0].ID,
+						UserID:   managerUser.ID,
+						UserRole: entity.RoleManager,
+						Summary:  &summary,
+					},
+				},
+				wantErr: entity.ErrValidation,
+			}
+		}(),
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
+			taskEventRepo := inmemoryrepo.NewInMemoryTaskEventRepo()
 			u := NewUpdateTask(
 				tt.fields.validator,
 				tt.fields.symCrypto,
 				tt.fields.taskRepo,
 				tt.fields.userRepo,
+				labelRepo,
+				projectRepo,
+				taskEventRepo,
+				transactioner.NewNoopTransactioner(),
 			)
 			err := u.Execute(context.Background(), tt.args.params)
 			if !testutil.IsSameErr(
@@ -354,6 +716,24 @@ func TestUpdateTask_Execute(t *testing.T) {
 			}
 
 			task := tt.fields.taskRepo.Tasks[0]
+
+			if task.Version != tt.args.params.Version+1 {
+				t.Errorf(
+					"UpdateTask.Execute() task.Version = %v, want %v",
+					task.Version,
+					tt.args.params.Version+1,
+				)
+			}
+
+			if len(taskEventRepo.Events) != 1 ||
+				taskEventRepo.Events[0].ActorUserID != tt.args.params.UserID ||
+				taskEventRepo.Events[0].Type != entity.TaskEventUpdated {
+				t.Errorf(
+					"UpdateTask.Execute() events = %v, want one updated event",
+					taskEventRepo.Events,
+				)
+			}
+
 			if !testutil.CompareAsPtr(
 				task.AssignedToUserID,
 				tt.args.params.AssignedToUserID,
@@ -365,12 +745,58 @@ func TestUpdateTask_Execute(t *testing.T) {
 				)
 			}
 
-			if task.Summary == beforeUpdateSummary &&
-				tt.args.params.Summary != beforeUpdateSummary {
+			if tt.args.params.LabelIDs != nil &&
+				len(tt.fields.taskRepo.LabelIDs[task.ID]) !=
+					len(tt.args.params.LabelIDs) {
+				t.Errorf(
+					"UpdateTask.Execute() label ids = %v, want %v",
+					tt.fields.taskRepo.LabelIDs[task.ID],
+					tt.args.params.LabelIDs,
+				)
+			}
+
+			if tt.args.params.DueAt != nil &&
+				!testutil.CompareAsPtr(task.DueAt, tt.args.params.DueAt) {
+				t.Errorf(
+					"UpdateTask.Execute() task.DueAt = %v, want %v",
+					task.DueAt,
+					*tt.args.params.DueAt,
+				)
+			}
+
+			if tt.args.params.ProjectID != nil &&
+				!testutil.CompareAsPtr(task.ProjectID, tt.args.params.ProjectID) {
+				t.Errorf(
+					"UpdateTask.Execute() task.ProjectID = %v, want %v",
+					task.ProjectID,
+					*tt.args.params.ProjectID,
+				)
+			}
+
+			if tt.args.params.Priority != "" &&
+				task.Priority != tt.args.params.Priority {
+				t.Errorf(
+					"UpdateTask.Execute() task.Priority = %v, want %v",
+					task.Priority,
+					tt.args.params.Priority,
+				)
+			}
+
+			if tt.args.params.Summary != nil &&
+				task.Summary == beforeUpdateSummary {
 				t.Errorf(
 					"UpdateTask.Execute() task.Summary = %v, want not %v",
 					task.Summary,
-					tt.args.params.Summary,
+					beforeUpdateSummary,
+				)
+			}
+
+			if tt.args.params.Summary == nil &&
+				task.Summary != beforeUpdateSummary {
+				t.Errorf(
+					"UpdateTask.Execute() task.Summary = %v, want %v",
+					task.Summary,
+					beforeUpdateSummary,
 				)
 			}
 		})
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type UpdateWorkSession struct {
+	validator       validator.Validator
+	symCrypto       symcrypt.SymmetricalEncrypter
+	taskRepo        repo.TaskRepo
+	workSessionRepo repo.WorkSessionRepo
+}
+
+func NewUpdateWorkSession(
+	validator validator.Validator,
+	symCrypto symcrypt.SymmetricalEncrypter,
+	taskRepo repo.TaskRepo,
+	workSessionRepo repo.WorkSessionRepo,
+) *UpdateWorkSession {
+	return &UpdateWorkSession{
+		validator:       validator,
+		symCrypto:       symCrypto,
+		taskRepo:        taskRepo,
+		workSessionRepo: workSessionRepo,
+	}
+}
+
+// UpdateWorkSessionParams only changes the fields that are set. Setting
+// EndedAt on a running session stops its timer.
+type UpdateWorkSessionParams struct {
+	TaskID    string     `json:"task_id,omitempty"    validate:"required,uuid"`
+	SessionID string     `json:"session_id,omitempty" validate:"required,uuid"`
+	UserID    string     `json:"user_id,omitempty"    validate:"required,uuid"`
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+	// Note replaces the session note when given, an empty note clears it.
+	Note *string `json:"note,omitempty" validate:"omitempty,max=2000"`
+}
+
+func (u *UpdateWorkSession) Execute(
+	ctx context.Context,
+	params UpdateWorkSessionParams,
+) (entity.WorkSession, error) {
+	if err := u.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return entity.WorkSession{}, validationErr
+	}
+
+	task, err := getTrackableTask(ctx, u.taskRepo, params.TaskID, params.UserID)
+	if err != nil {
+		return entity.WorkSession{}, err
+	}
+
+	session, err := getOwnWorkSession(
+		ctx,
+		u.workSessionRepo,
+		task,
+		params.SessionID,
+		params.UserID,
+	)
+	if err != nil {
+		return entity.WorkSession{}, err
+	}
+
+	if params.StartedAt != nil {
+		session.StartedAt = params.StartedAt.UTC().Truncate(time.Second)
+	}
+
+	if params.EndedAt != nil {
+		endedAt := params.EndedAt.UTC().Truncate(time.Second)
+		session.EndedAt = &endedAt
+	}
+
+	if err := validateWorkSessionPeriod(
+		session.StartedAt,
+		session.EndedAt,
+		time.Now(),
+	); err != nil {
+		return entity.WorkSession{}, err
+	}
+
+	if params.Note != nil {
+		session.Note, err = encryptWorkSessionNote(u.symCrypto, params.Note)
+		if err != nil {
+			return entity.WorkSession{}, err
+		}
+	}
+
+	if err := u.workSessionRepo.UpdateWorkSession(
+		ctx,
+		repo.UpdateWorkSessionParams{
+			ID:        session.ID,
+			StartedAt: session.StartedAt,
+			EndedAt:   session.EndedAt,
+			Note:      session.Note,
+		},
+	); err != nil {
+		return entity.WorkSession{}, entity.NewErr(err)
+	}
+
+	session.UpdatedAt = time.Now()
+
+	return decryptWorkSession(u.symCrypto, session, time.Now())
+}
@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+func TestUpdateWorkSession_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	managerID := uuid.NewString()
+	technicianID := uuid.NewString()
+	otherTechnicianID := uuid.NewString()
+
+	newTask := func(status entity.TaskStatus, unlocked bool) entity.Task {
+		return entity.Task{
+			ID:                   uuid.NewString(),
+			Summary:              "Loren ipsum dolor sit amet",
+			Status:               status,
+			AssigneeIDs:          []string{technicianID, otherTechnicianID},
+			CreatedByUserID:      managerID,
+			WorkSessionsUnlocked: unlocked,
+			CreatedAt:            time.Now(),
+			UpdatedAt:            time.Now(),
+		}
+	}
+	task := newTask(entity.TaskStatusInProgress, false)
+	doneTask := newTask(entity.TaskStatusDone, false)
+	unlockedTask := newTask(entity.TaskStatusDone, true)
+
+	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+	taskRepo.Tasks = append(taskRepo.Tasks, task, doneTask, unlockedTask)
+
+	startedAt := time.Now().Add(-3 * time.Hour).UTC().Truncate(time.Second)
+	endedAt := startedAt.Add(time.Hour)
+	newSession := func(taskID string) entity.WorkSession {
+		return entity.WorkSession{
+			ID:        uuid.NewString(),
+			TaskID:    taskID,
+			UserID:    technicianID,
+			StartedAt: startedAt,
+			EndedAt:   &endedAt,
+			Manual:    true,
+		}
+	}
+	session := newSession(task.ID)
+	doneSession := newSession(doneTask.ID)
+	unlockedSession := newSession(unlockedTask.ID)
+
+	fixedEndedAt := startedAt.Add(2 * time.Hour)
+	invalidEndedAt := startedAt.Add(-time.Hour)
+
+	type args struct {
+		params UpdateWorkSessionParams
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantDuration int64
+		wantErr      error
+	}{
+		{
+			name: "should update work session if user logged it",
+			args: args{
+				params: UpdateWorkSessionParams{
+					TaskID:    task.ID,
+					SessionID: session.ID,
+					UserID:    technicianID,
+					EndedAt:   &fixedEndedAt,
+				},
+			},
+			wantDuration: 2 * 60 * 60,
+			wantErr:      nil,
+		},
+		{
+			name: "should update work session of finished task if unlocked",
+			args: args{
+				params: UpdateWorkSessionParams{
+					TaskID:    unlockedTask.ID,
+					SessionID: unlockedSession.ID,
+					UserID:    technicianID,
+					EndedAt:   &fixedEndedAt,
+				},
+			},
+			wantDuration: 2 * 60 * 60,
+			wantErr:      nil,
+		},
+		{
+			name: "should not update work session of finished task",
+			args: args{
+				params: UpdateWorkSessionParams{
+					TaskID:    doneTask.ID,
+					SessionID: doneSession.ID,
+					UserID:    technicianID,
+					EndedAt:   &fixedEndedAt,
+				},
+			},
+			wantErr: entity.ErrWorkSessionsLocked,
+		},
+		{
+			name: "should not update work session logged by another assignee",
+			args: args{
+				params: UpdateWorkSessionParams{
+					TaskID:    task.ID,
+					SessionID: session.ID,
+					UserID:    otherTechnicianID,
+					EndedAt:   &fixedEndedAt,
+				},
+			},
+			wantErr: entity.ErrUserNotWorkSessionOwner,
+		},
+		{
+			name: "should not update work session of another task",
+			args: args{
+				params: UpdateWorkSessionParams{
+					TaskID:    task.ID,
+					SessionID: unlockedSession.ID,
+					UserID:    technicianID,
+					EndedAt:   &fixedEndedAt,
+				},
+			},
+			wantErr: entity.ErrWorkSessionNotFound,
+		},
+		{
+			name: "should not end work session before it starts",
+			args: args{
+				params: UpdateWorkSessionParams{
+					TaskID:    task.ID,
+					SessionID: session.ID,
+					UserID:    technicianID,
+					EndedAt:   &invalidEndedAt,
+				},
+			},
+			wantErr: entity.ErrInvalidWorkSessionPeriod,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			workSessionRepo := inmemoryrepo.NewInMemoryWorkSessionRepo(taskRepo)
+			workSessionRepo.Sessions = append(
+				workSessionRepo.Sessions,
+				session,
+				doneSession,
+				unlockedSession,
+			)
+
+			u := NewUpdateWorkSession(val, symCrypto, taskRepo, workSessionRepo)
+
+			got, err := u.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"UpdateWorkSession.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.DurationSeconds != tt.wantDuration {
+				t.Errorf(
+					"UpdateWorkSession.Execute() duration = %v, want %v",
+					got.DurationSeconds,
+					tt.wantDuration,
+				)
+			}
+
+			stored, _ := workSessionRepo.GetWorkSessionByID(
+				context.Background(),
+				tt.args.params.SessionID,
+			)
+			if !stored.EndedAt.Equal(*tt.args.params.EndedAt) {
+				t.Errorf(
+					"UpdateWorkSession.Execute() ended at = %v, want %v",
+					stored.EndedAt,
+					tt.args.params.EndedAt,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"slices"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/blobstore"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+// MaxAttachmentSize is the maximum size in bytes of an attachment.
+const MaxAttachmentSize = 10 << 20
+
+// allowedAttachmentTypes lists the content types accepted for
+// attachments, as detected from the file content.
+var allowedAttachmentTypes = []string{
+	"image/jpeg",
+	"image/png",
+	"image/gif",
+	"image/webp",
+	"application/pdf",
+}
+
+type UploadAttachment struct {
+	validator      validator.Validator
+	symCrypto      symcrypt.SymmetricalEncrypter
+	blobStore      blobstore.BlobStore
+	taskRepo       repo.TaskRepo
+	projectRepo    repo.ProjectRepo
+	attachmentRepo repo.AttachmentRepo
+}
+
+func NewUploadAttachment(
+	validator validator.Validator,
+	symCrypto symcrypt.SymmetricalEncrypter,
+	blobStore blobstore.BlobStore,
+	taskRepo repo.TaskRepo,
+	projectRepo repo.ProjectRepo,
+	attachmentRepo repo.AttachmentRepo,
+) *UploadAttachment {
+	return &UploadAttachment{
+		validator:      validator,
+		symCrypto:      symCrypto,
+		blobStore:      blobStore,
+		taskRepo:       taskRepo,
+		projectRepo:    projectRepo,
+		attachmentRepo: attachmentRepo,
+	}
+}
+
+type UploadAttachmentParams struct {
+	TaskID   string      `json:"task_id,omitempty"   validate:"required,uuid"`
+	UserID   string      `json:"user_id,omitempty"   validate:"required,uuid"`
+	UserRole entity.Role `json:"user_role,omitempty" validate:"required,min=1,max=2"`
+	FileName string      `json:"file_name,omitempty" validate:"required,max=255"`
+	Content  io.Reader   `json:"-"                   validate:"required"`
+}
+
+// Execute stores the encrypted file content in the blob store and
+// returns the attachment metadata. The content type is detected from
+// the content itself, the client provided one is not trusted.
+func (u *UploadAttachment) Execute(
+	ctx context.Context,
+	params UploadAttachmentParams,
+) (entity.Attachment, error) {
+	if err := u.validator.Validate(params); err != nil {
+		validationErr := entity.ErrValidation
+		validationErr.Message = err.Error()
+		return entity.Attachment{}, validationErr
+	}
+
+	task, err := u.taskRepo.GetTaskByID(ctx, params.TaskID)
+	if err != nil {
+		return entity.Attachment{}, entity.NewErr(err)
+	}
+
+	if task.ID == "" {
+		return entity.Attachment{}, entity.ErrTaskNotFound
+	}
+
+	if err := ensureTaskVisible(
+		ctx,
+		u.projectRepo,
+		task,
+		params.UserID,
+		params.UserRole,
+		entity.ErrUserNotAllowedToAttachFile,
+	); err != nil {
+		return entity.Attachment{}, err
+	}
+
+	content, err := io.ReadAll(
+		io.LimitReader(params.Content, MaxAttachmentSize+1),
+	)
+	if err != nil {
+		return entity.Attachment{}, entity.NewErr(err)
+	}
+
+	if len(content) > MaxAttachmentSize {
+		return entity.Attachment{}, entity.ErrAttachmentTooLarge
+	}
+
+	contentType := http.DetectContentType(content)
+	if !slices.Contains(allowedAttachmentTypes, contentType) {
+		return entity.Attachment{}, entity.ErrAttachmentTypeNotAllowed
+	}
+
+	encryptedContent, err := u.symCrypto.EncryptBytes(content)
+	if err != nil {
+		return entity.Attachment{}, entity.NewErr(err)
+	}
+
+	repoParams := repo.CreateAttachmentParams{
+		ID:          uuid.NewString(),
+		TaskID:      task.ID,
+		UserID:      params.UserID,
+		FileName:    filepath.Base(params.FileName),
+		ContentType: contentType,
+		Size:        int64(len(content)),
+	}
+
+	key := attachmentBlobKey(repoParams.TaskID, repoParams.ID)
+
+	// The blob is stored first, so the metadata never points to a
+	// missing file. If saving the metadata fails, the blob is removed.
+	if err := u.blobStore.Put(
+		ctx,
+		key,
+		bytes.NewReader(encryptedContent),
+	); err != nil {
+		return entity.Attachment{}, entity.NewErr(err)
+	}
+
+	if err := u.attachmentRepo.CreateAttachment(ctx, repoParams); err != nil {
+		_ = u.blobStore.Delete(ctx, key)
+		return entity.Attachment{}, entity.NewErr(err)
+	}
+
+	attachment, err := u.attachmentRepo.GetAttachmentByID(ctx, repoParams.ID)
+	if err != nil {
+		return entity.Attachment{}, entity.NewErr(err)
+	}
+
+	return attachment, nil
+}
+
+// attachmentBlobKey returns the blob store key of the attachment
+// content, grouping the attachments of a task together.
+func attachmentBlobKey(taskID, attachmentID string) string {
+	return "attachments/" + taskID + "/" + attachmentID
+}
@@ -0,0 +1,245 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/validator"
+	"github.com/danielmesquitta/tasks-api/internal/provider/blobstore/localblobstore"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/inmemoryrepo"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+// pngContent is the content of a 1x1 transparent PNG image.
+var pngContent = []byte{
+	0x89, 0x50, 0x4e, 0x47, 0x0d, 0x0a, 0x1a, 0x0a, 0x00, 0x00, 0x00, 0x0d,
+	0x49, 0x48, 0x44, 0x52, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01,
+	0x08, 0x06, 0x00, 0x00, 0x00, 0x1f, 0x15, 0xc4, 0x89, 0x00, 0x00, 0x00,
+	0x0d, 0x49, 0x44, 0x41, 0x54, 0x78, 0x9c, 0x63, 0x00, 0x01, 0x00, 0x00,
+	0x05, 0x00, 0x01, 0x0d, 0x0a, 0x2d, 0xb4, 0x00, 0x00, 0x00, 0x00, 0x49,
+	0x45, 0x4e, 0x44, 0xae, 0x42, 0x60, 0x82,
+}
+
+func TestUploadAttachment_Execute(t *testing.T) {
+	val := validator.NewValidate()
+	env := config.LoadEnv(val)
+	symCrypto := symcrypt.NewAESCrypto(env)
+
+	managerID := uuid.NewString()
+	technicianID := uuid.NewString()
+
+	task := entity.Task{
+		ID:               uuid.NewString(),
+		Summary:          "Loren ipsum dolor sit amet",
+		Status:           entity.TaskStatusOpen,
+		AssignedToUserID: &technicianID,
+		CreatedByUserID:  managerID,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+
+	taskRepo := inmemoryrepo.NewInMemoryTaskRepo()
+	taskRepo.Tasks = append(taskRepo.Tasks, task)
+
+	project := entity.Project{
+		ID:            uuid.NewString(),
+		Name:          "Maintenance",
+		ManagerUserID: uuid.NewString(),
+	}
+	projectRepo := inmemoryrepo.NewInMemoryProjectRepo()
+	projectRepo.Projects = append(projectRepo.Projects, project)
+
+	projectTask := task
+	projectTask.ID = uuid.NewString()
+	projectTask.ProjectID = &project.ID
+	taskRepo.Tasks = append(taskRepo.Tasks, projectTask)
+
+	type args struct {
+		params UploadAttachmentParams
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "should upload attachment if user is the assignee",
+			args: args{
+				params: UploadAttachmentParams{
+					TaskID:   task.ID,
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+					FileName: "photo.png",
+					Content:  bytes.NewReader(pngContent),
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should upload attachment if user is a manager",
+			args: args{
+				params: UploadAttachmentParams{
+					TaskID:   task.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					FileName: "../../photo.png",
+					Content:  bytes.NewReader(pngContent),
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should not upload attachment if user is not the assignee",
+			args: args{
+				params: UploadAttachmentParams{
+					TaskID:   task.ID,
+					UserID:   uuid.NewString(),
+					UserRole: entity.RoleTechnician,
+					FileName: "photo.png",
+					Content:  bytes.NewReader(pngContent),
+				},
+			},
+			wantErr: entity.ErrUserNotAllowedToAttachFile,
+		},
+		{
+			name: "should not upload attachment if type is not allowed",
+			args: args{
+				params: UploadAttachmentParams{
+					TaskID:   task.ID,
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+					FileName: "photo.png",
+					Content:  strings.NewReader("<html><body></body></html>"),
+				},
+			},
+			wantErr: entity.ErrAttachmentTypeNotAllowed,
+		},
+		{
+			name: "should not upload attachment if it is too large",
+			args: args{
+				params: UploadAttachmentParams{
+					TaskID:   task.ID,
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+					FileName: "photo.png",
+					Content: io.MultiReader(
+						bytes.NewReader(pngContent),
+						bytes.NewReader(make([]byte, MaxAttachmentSize)),
+					),
+				},
+			},
+			wantErr: entity.ErrAttachmentTooLarge,
+		},
+		{
+			name: "should not upload attachment without content",
+			args: args{
+				params: UploadAttachmentParams{
+					TaskID:   task.ID,
+					UserID:   technicianID,
+					UserRole: entity.RoleTechnician,
+					FileName: "photo.png",
+				},
+			},
+			wantErr: entity.ErrValidation,
+		},
+		{
+			name: "should not upload attachment if task does not exists",
+			args: args{
+				params: UploadAttachmentParams{
+					TaskID:   uuid.NewString(),
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					FileName: "photo.png",
+					Content:  bytes.NewReader(pngContent),
+				},
+			},
+			wantErr: entity.ErrTaskNotFound,
+		},
+		{
+			name: "should not upload attachment if user is not a project member",
+			args: args{
+				params: UploadAttachmentParams{
+					TaskID:   projectTask.ID,
+					UserID:   managerID,
+					UserRole: entity.RoleManager,
+					FileName: "../../photo.png",
+					Content:  bytes.NewReader(pngContent),
+				},
+			},
+			wantErr: entity.ErrUserNotProjectMember,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			blobStore := localblobstore.NewLocalBlobStore(
+				&config.Env{BlobStorageDir: t.TempDir()},
+			)
+			attachmentRepo := inmemoryrepo.NewInMemoryAttachmentRepo()
+			u := NewUploadAttachment(
+				val,
+				symCrypto,
+				blobStore,
+				taskRepo,
+				projectRepo,
+				attachmentRepo,
+			)
+
+			attachment, err := u.Execute(context.Background(), tt.args.params)
+			if !testutil.IsSameErr(err, tt.wantErr) {
+				t.Errorf(
+					"UploadAttachment.Execute() error = %v, wantErr %v",
+					err,
+					tt.wantErr,
+				)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if attachment.FileName != "photo.png" {
+				t.Errorf(
+					"UploadAttachment.Execute() attachment.FileName = %v, want %v",
+					attachment.FileName,
+					"photo.png",
+				)
+			}
+
+			if attachment.ContentType != "image/png" {
+				t.Errorf(
+					"UploadAttachment.Execute() attachment.ContentType = %v, want %v",
+					attachment.ContentType,
+					"image/png",
+				)
+			}
+
+			blob, err := blobStore.Get(
+				context.Background(),
+				attachmentBlobKey(attachment.TaskID, attachment.ID),
+			)
+			if err != nil {
+				t.Fatalf("UploadAttachment.Execute() blob error = %v", err)
+			}
+			defer blob.Close()
+
+			storedContent, err := io.ReadAll(blob)
+			if err != nil {
+				t.Fatalf("UploadAttachment.Execute() blob error = %v", err)
+			}
+
+			if bytes.Contains(storedContent, pngContent) {
+				t.Errorf("UploadAttachment.Execute() content was not encrypted")
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/symcrypt"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+// getTrackableTask returns the task, or ErrTaskNotFound if it does not
+// exist, ErrUserNotAllowedToTrackTime if the user is not one of its
+// assignees and ErrWorkSessionsLocked if its work sessions are locked.
+func getTrackableTask(
+	ctx context.Context,
+	taskRepo repo.TaskRepo,
+	taskID string,
+	userID string,
+) (entity.Task, error) {
+	task, err := taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return entity.Task{}, entity.NewErr(err)
+	}
+
+	if task.ID == "" {
+		return entity.Task{}, entity.ErrTaskNotFound
+	}
+
+	if !task.IsAssignedTo(userID) {
+		return entity.Task{}, entity.ErrUserNotAllowedToTrackTime
+	}
+
+	if task.AreWorkSessionsLocked() {
+		return entity.Task{}, entity.ErrWorkSessionsLocked
+	}
+
+	return task, nil
+}
+
+// getOwnWorkSession returns the work session of the task, or
+// ErrWorkSessionNotFound if it does not exist and
+// ErrUserNotWorkSessionOwner if the user did not log it.
+func getOwnWorkSession(
+	ctx context.Context,
+	workSessionRepo repo.WorkSessionRepo,
+	task entity.Task,
+	sessionID string,
+	userID string,
+) (entity.WorkSession, error) {
+	session, err := workSessionRepo.GetWorkSessionByID(ctx, sessionID)
+	if err != nil {
+		return entity.WorkSession{}, entity.NewErr(err)
+	}
+
+	if session.ID == "" || session.TaskID != task.ID {
+		return entity.WorkSession{}, entity.ErrWorkSessionNotFound
+	}
+
+	if session.UserID != userID {
+		return entity.WorkSession{}, entity.ErrUserNotWorkSessionOwner
+	}
+
+	return session, nil
+}
+
+// validateWorkSessionPeriod returns ErrInvalidWorkSessionPeriod unless
+// the session ends after it starts and neither is in the future. Running
+// sessions only need to have started.
+func validateWorkSessionPeriod(
+	startedAt time.Time,
+	endedAt *time.Time,
+	now time.Time,
+) error {
+	if startedAt.After(now) {
+		return entity.ErrInvalidWorkSessionPeriod
+	}
+
+	if endedAt != nil && (!endedAt.After(startedAt) || endedAt.After(now)) {
+		return entity.ErrInvalidWorkSessionPeriod
+	}
+
+	return nil
+}
+
+// decryptWorkSession decrypts the session note and fills its duration up
+// to now.
+func decryptWorkSession(
+	symCrypto symcrypt.SymmetricalEncrypter,
+	session entity.WorkSession,
+	now time.Time,
+) (entity.WorkSession, error) {
+	if session.Note != nil {
+		decryptedNote, err := symCrypto.Decrypt(*session.Note)
+		if err != nil {
+			return entity.WorkSession{}, entity.NewErr(err)
+		}
+		session.Note = &decryptedNote
+	}
+
+	session.DurationSeconds = int64(session.Duration(now).Seconds())
+
+	return session, nil
+}
+
+// encryptWorkSessionNote returns the encrypted note, or nil if there is none.
+func encryptWorkSessionNote(
+	symCrypto symcrypt.SymmetricalEncrypter,
+	note *string,
+) (*string, error) {
+	if note == nil || *note == "" {
+		return nil, nil
+	}
+
+	encryptedNote, err := symCrypto.Encrypt(*note)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return &encryptedNote, nil
+}
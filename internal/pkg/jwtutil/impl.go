@@ -53,6 +53,11 @@ func (j *JWT) ValidateAccessToken(accessToken string) (*UserClaims, error) {
 		return nil, entity.NewErr("token is expired")
 	}
 
+	// Tokens issued before organizations existed can not be scoped to one.
+	if userClaims.OrganizationID == "" {
+		return nil, entity.NewErr("token has no organization")
+	}
+
 	return userClaims, nil
 }
 
@@ -16,5 +16,8 @@ type JWTManager interface {
 
 type UserClaims struct {
 	Role entity.Role `json:"role,omitempty"`
+	// OrganizationID is the organization the user belongs to, which every
+	// request made with the token acts on.
+	OrganizationID string `json:"organization_id,omitempty"`
 	jwt.RegisteredClaims
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/rand"
 	"encoding/base64"
 	"fmt"
 
@@ -50,19 +51,16 @@ func (c *AESCrypto) Decrypt(
 func (c *AESCrypto) Encrypt(
 	plaintext string,
 ) (encrypted string, err error) {
-	var plainTextBlock []byte
 	length := len(plaintext)
 
-	if length%16 != 0 {
-		extendBlock := 16 - (length % 16)
-		plainTextBlock = make([]byte, length+extendBlock)
-		copy(
-			plainTextBlock[length:],
-			bytes.Repeat([]byte{uint8(extendBlock)}, extendBlock),
-		)
-	} else {
-		plainTextBlock = make([]byte, length)
-	}
+	// A full block of padding is added when the text length is a
+	// multiple of the block size, so it can always be unpadded.
+	extendBlock := 16 - (length % 16)
+	plainTextBlock := make([]byte, length+extendBlock)
+	copy(
+		plainTextBlock[length:],
+		bytes.Repeat([]byte{uint8(extendBlock)}, extendBlock),
+	)
 
 	copy(plainTextBlock, plaintext)
 	block, err := aes.NewCipher([]byte(c.env.CipherSecretKey))
@@ -79,6 +77,58 @@ func (c *AESCrypto) Encrypt(
 	return str, nil
 }
 
+// EncryptBytes encrypts given data in AES 256 GCM, prefixing the
+// result with a random nonce. It is meant for binary data, such as
+// files, for which the fixed IV used for texts is not suitable.
+func (c *AESCrypto) EncryptBytes(
+	plaintext []byte,
+) (encrypted []byte, err error) {
+	gcm, err := c.newGCM()
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// DecryptBytes decrypts data encrypted by EncryptBytes.
+func (c *AESCrypto) DecryptBytes(
+	encrypted []byte,
+) (plaintext []byte, err error) {
+	gcm, err := c.newGCM()
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	nonceSize := gcm.NonceSize()
+	if len(encrypted) < nonceSize {
+		return nil, fmt.Errorf("encrypted data is too short")
+	}
+
+	nonce, ciphertext := encrypted[:nonceSize], encrypted[nonceSize:]
+
+	plaintext, err = gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return plaintext, nil
+}
+
+func (c *AESCrypto) newGCM() (cipher.AEAD, error) {
+	block, err := aes.NewCipher([]byte(c.env.CipherSecretKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
 // pkcs5UnPadding  pads a certain blob of data with
 // necessary data to be used in AES block cipher
 func pkcs5UnPadding(src []byte) []byte {
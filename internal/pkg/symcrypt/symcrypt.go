@@ -3,4 +3,6 @@ package symcrypt
 type SymmetricalEncrypter interface {
 	Encrypt(plaintext string) (encrypted string, err error)
 	Decrypt(encrypted string) (plaintext string, err error)
+	EncryptBytes(plaintext []byte) (encrypted []byte, err error)
+	DecryptBytes(encrypted []byte) (plaintext []byte, err error)
 }
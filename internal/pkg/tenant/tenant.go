@@ -0,0 +1,24 @@
+// Package tenant carries the organization a request acts on through the
+// context. The repositories scope every query to it, so that the data of
+// other organizations can not be reached even if a use case misses a
+// check.
+package tenant
+
+import "context"
+
+type organizationIDKey struct{}
+
+// WithOrganizationID returns a copy of ctx that acts on the organization.
+func WithOrganizationID(
+	ctx context.Context,
+	organizationID string,
+) context.Context {
+	return context.WithValue(ctx, organizationIDKey{}, organizationID)
+}
+
+// OrganizationID returns the organization ctx acts on, and false if it
+// does not carry one.
+func OrganizationID(ctx context.Context) (string, bool) {
+	organizationID, ok := ctx.Value(organizationIDKey{}).(string)
+	return organizationID, ok && organizationID != ""
+}
@@ -0,0 +1,44 @@
+package transactioner
+
+import (
+	"context"
+	"log"
+)
+
+type afterCommitKey struct{}
+
+// afterCommitHooks holds the functions to run once the outermost
+// transaction commits.
+type afterCommitHooks struct {
+	fns []func() error
+}
+
+// AfterCommit runs fn once the transaction carried by ctx commits, and
+// discards it if the transaction is rolled back. Without a transaction
+// in ctx, fn runs right away and its error is returned.
+func AfterCommit(ctx context.Context, fn func() error) error {
+	hooks, ok := ctx.Value(afterCommitKey{}).(*afterCommitHooks)
+	if !ok {
+		return fn()
+	}
+
+	hooks.fns = append(hooks.fns, fn)
+	return nil
+}
+
+func withAfterCommitHooks(
+	ctx context.Context,
+) (context.Context, *afterCommitHooks) {
+	hooks := &afterCommitHooks{}
+	return context.WithValue(ctx, afterCommitKey{}, hooks), hooks
+}
+
+// run calls the hooks in the order they were added. The transaction can
+// no longer be undone, so errors are only logged.
+func (h *afterCommitHooks) run() {
+	for _, fn := range h.fns {
+		if err := fn(); err != nil {
+			log.Println("error running after commit hook:", err)
+		}
+	}
+}
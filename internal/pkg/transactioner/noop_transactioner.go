@@ -0,0 +1,34 @@
+package transactioner
+
+import "context"
+
+// NoopTransactioner runs the given function without opening a transaction.
+// It is meant to be used along with the in memory repositories.
+type NoopTransactioner struct{}
+
+func NewNoopTransactioner() *NoopTransactioner {
+	return &NoopTransactioner{}
+}
+
+// Do runs fn and then the functions given to AfterCommit, unless fn
+// fails, mimicking a commit.
+func (tm *NoopTransactioner) Do(
+	ctx context.Context,
+	fn func(context.Context) error,
+) error {
+	if _, ok := ctx.Value(afterCommitKey{}).(*afterCommitHooks); ok {
+		return fn(ctx)
+	}
+
+	ctx, hooks := withAfterCommitHooks(ctx)
+
+	if err := fn(ctx); err != nil {
+		return err
+	}
+
+	hooks.run()
+
+	return nil
+}
+
+var _ Transactioner = (*NoopTransactioner)(nil)
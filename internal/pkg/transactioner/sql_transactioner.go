@@ -15,10 +15,18 @@ func NewSQLTransactioner(db *sql.DB) *SQLTransactioner {
 	}
 }
 
+// Do runs fn inside a transaction. When ctx already carries a
+// transaction, fn joins it instead of opening a new one, so use cases
+// can be composed under a single commit. Functions given to AfterCommit
+// run once the outermost transaction commits.
 func (tm *SQLTransactioner) Do(
 	ctx context.Context,
 	fn func(context.Context) error,
 ) error {
+	if _, ok := ctx.Value(CtxTxKey).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
 	tx, err := tm.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
@@ -26,10 +34,17 @@ func (tm *SQLTransactioner) Do(
 	defer func() { _ = tx.Rollback() }()
 
 	ctx = context.WithValue(ctx, CtxTxKey, tx)
+	ctx, hooks := withAfterCommitHooks(ctx)
 
 	if err := fn(ctx); err != nil {
 		return err
 	}
 
-	return tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	hooks.run()
+
+	return nil
 }
@@ -0,0 +1,114 @@
+package localblobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/blobstore"
+)
+
+// LocalBlobStore stores objects as files under a root directory.
+type LocalBlobStore struct {
+	rootDir string
+}
+
+func NewLocalBlobStore(env *config.Env) *LocalBlobStore {
+	return &LocalBlobStore{
+		rootDir: env.BlobStorageDir,
+	}
+}
+
+func (s *LocalBlobStore) Put(
+	_ context.Context,
+	key string,
+	content io.Reader,
+) error {
+	path, err := s.path(key)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return entity.NewErr(err)
+	}
+
+	// Write to a temporary file first, so a failed write never leaves
+	// a partial object behind.
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return entity.NewErr(err)
+	}
+	defer func() { _ = os.Remove(tmpFile.Name()) }()
+
+	if _, err := io.Copy(tmpFile, content); err != nil {
+		_ = tmpFile.Close()
+		return entity.NewErr(err)
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		return entity.NewErr(err)
+	}
+
+	if err := os.Rename(tmpFile.Name(), path); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (s *LocalBlobStore) Get(
+	_ context.Context,
+	key string,
+) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, blobstore.ErrBlobNotFound
+	}
+
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return file, nil
+}
+
+func (s *LocalBlobStore) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+// path returns the file path of the key, making sure it does not
+// point outside of the root directory.
+func (s *LocalBlobStore) path(key string) (string, error) {
+	path := filepath.Join(s.rootDir, filepath.FromSlash(key))
+
+	rel, err := filepath.Rel(s.rootDir, path)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+
+	return path, nil
+}
+
+var _ blobstore.BlobStore = (*LocalBlobStore)(nil)
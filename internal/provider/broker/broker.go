@@ -3,7 +3,12 @@ package broker
 type Topic string
 
 const (
-	TopicTaskFinished Topic = "task.finished"
+	TopicTaskFinished      Topic = "task.finished"
+	TopicTaskStatusChanged Topic = "task.status_changed"
+	TopicTaskReopened      Topic = "task.reopened"
+	TopicTaskCommented     Topic = "task.commented"
+	TopicTaskUnblocked     Topic = "task.unblocked"
+	TopicTaskOverdue       Topic = "task.overdue"
 )
 
 type Handler func(message []byte)
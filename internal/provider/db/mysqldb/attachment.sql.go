@@ -0,0 +1,128 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: attachment.sql
+
+package mysqldb
+
+import (
+	"context"
+)
+
+const createAttachment = `-- name: CreateAttachment :exec
+INSERT INTO task_attachments (
+    id,
+    task_id,
+    user_id,
+    file_name,
+    content_type,
+    size
+  )
+SELECT ?,
+  tasks.id,
+  ?,
+  ?,
+  ?,
+  ?
+FROM tasks
+WHERE tasks.id = ?
+  AND tasks.organization_id = ?
+`
+
+type CreateAttachmentParams struct {
+	ID             string
+	UserID         string
+	FileName       string
+	ContentType    string
+	Size           int64
+	TaskID         string
+	OrganizationID string
+}
+
+func (q *Queries) CreateAttachment(ctx context.Context, arg CreateAttachmentParams) error {
+	_, err := q.db.ExecContext(ctx, createAttachment,
+		arg.ID,
+		arg.UserID,
+		arg.FileName,
+		arg.ContentType,
+		arg.Size,
+		arg.TaskID,
+		arg.OrganizationID,
+	)
+	return err
+}
+
+const getAttachmentByID = `-- name: GetAttachmentByID :one
+SELECT task_attachments.id, task_attachments.task_id, task_attachments.user_id, task_attachments.file_name, task_attachments.content_type, task_attachments.size, task_attachments.created_at
+FROM task_attachments
+  JOIN tasks ON tasks.id = task_attachments.task_id
+WHERE task_attachments.id = ?
+  AND tasks.organization_id = ?
+LIMIT 1
+`
+
+type GetAttachmentByIDParams struct {
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) GetAttachmentByID(ctx context.Context, arg GetAttachmentByIDParams) (TaskAttachment, error) {
+	row := q.db.QueryRowContext(ctx, getAttachmentByID, arg.ID, arg.OrganizationID)
+	var i TaskAttachment
+	err := row.Scan(
+		&i.ID,
+		&i.TaskID,
+		&i.UserID,
+		&i.FileName,
+		&i.ContentType,
+		&i.Size,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAttachmentsByTaskID = `-- name: ListAttachmentsByTaskID :many
+SELECT task_attachments.id, task_attachments.task_id, task_attachments.user_id, task_attachments.file_name, task_attachments.content_type, task_attachments.size, task_attachments.created_at
+FROM task_attachments
+  JOIN tasks ON tasks.id = task_attachments.task_id
+WHERE task_attachments.task_id = ?
+  AND tasks.organization_id = ?
+ORDER BY task_attachments.created_at,
+  task_attachments.id
+`
+
+type ListAttachmentsByTaskIDParams struct {
+	TaskID         string
+	OrganizationID string
+}
+
+func (q *Queries) ListAttachmentsByTaskID(ctx context.Context, arg ListAttachmentsByTaskIDParams) ([]TaskAttachment, error) {
+	rows, err := q.db.QueryContext(ctx, listAttachmentsByTaskID, arg.TaskID, arg.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskAttachment
+	for rows.Next() {
+		var i TaskAttachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.TaskID,
+			&i.UserID,
+			&i.FileName,
+			&i.ContentType,
+			&i.Size,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
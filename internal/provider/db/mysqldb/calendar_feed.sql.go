@@ -0,0 +1,73 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: calendar_feed.sql
+
+package mysqldb
+
+import (
+	"context"
+)
+
+const deleteCalendarFeedToken = `-- name: DeleteCalendarFeedToken :exec
+DELETE FROM calendar_feed_tokens
+WHERE user_id = ?
+  AND user_id IN (
+    SELECT id
+    FROM users
+    WHERE organization_id = ?
+  )
+`
+
+type DeleteCalendarFeedTokenParams struct {
+	UserID         string
+	OrganizationID string
+}
+
+func (q *Queries) DeleteCalendarFeedToken(ctx context.Context, arg DeleteCalendarFeedTokenParams) error {
+	_, err := q.db.ExecContext(ctx, deleteCalendarFeedToken, arg.UserID, arg.OrganizationID)
+	return err
+}
+
+const getCalendarFeedTokenByHash = `-- name: GetCalendarFeedTokenByHash :one
+SELECT calendar_feed_tokens.user_id, calendar_feed_tokens.token_hash, calendar_feed_tokens.created_at
+FROM calendar_feed_tokens
+  JOIN users ON users.id = calendar_feed_tokens.user_id
+WHERE calendar_feed_tokens.token_hash = ?
+  AND users.organization_id = ?
+LIMIT 1
+`
+
+type GetCalendarFeedTokenByHashParams struct {
+	TokenHash      string
+	OrganizationID string
+}
+
+func (q *Queries) GetCalendarFeedTokenByHash(ctx context.Context, arg GetCalendarFeedTokenByHashParams) (CalendarFeedToken, error) {
+	row := q.db.QueryRowContext(ctx, getCalendarFeedTokenByHash, arg.TokenHash, arg.OrganizationID)
+	var i CalendarFeedToken
+	err := row.Scan(&i.UserID, &i.TokenHash, &i.CreatedAt)
+	return i, err
+}
+
+const upsertCalendarFeedToken = `-- name: UpsertCalendarFeedToken :exec
+INSERT INTO calendar_feed_tokens (user_id, token_hash)
+SELECT users.id,
+  ?
+FROM users
+WHERE users.id = ?
+  AND users.organization_id = ? ON DUPLICATE KEY
+UPDATE token_hash = VALUES(token_hash),
+  created_at = CURRENT_TIMESTAMP
+`
+
+type UpsertCalendarFeedTokenParams struct {
+	TokenHash      string
+	UserID         string
+	OrganizationID string
+}
+
+func (q *Queries) UpsertCalendarFeedToken(ctx context.Context, arg UpsertCalendarFeedTokenParams) error {
+	_, err := q.db.ExecContext(ctx, upsertCalendarFeedToken, arg.TokenHash, arg.UserID, arg.OrganizationID)
+	return err
+}
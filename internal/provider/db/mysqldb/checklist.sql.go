@@ -0,0 +1,235 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: checklist.sql
+
+package mysqldb
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+)
+
+const countChecklistItemsByTaskIDs = `-- name: CountChecklistItemsByTaskIDs :many
+SELECT task_checklist_items.task_id,
+  COUNT(*) AS total,
+  CAST(COALESCE(SUM(task_checklist_items.done), 0) AS SIGNED) AS done
+FROM task_checklist_items
+  JOIN tasks ON tasks.id = task_checklist_items.task_id
+WHERE task_checklist_items.task_id IN (/*SLICE:task_ids*/?)
+  AND tasks.organization_id = ?
+GROUP BY task_checklist_items.task_id
+`
+
+type CountChecklistItemsByTaskIDsParams struct {
+	TaskIds        []string
+	OrganizationID string
+}
+
+type CountChecklistItemsByTaskIDsRow struct {
+	TaskID string
+	Total  int64
+	Done   int64
+}
+
+func (q *Queries) CountChecklistItemsByTaskIDs(ctx context.Context, arg CountChecklistItemsByTaskIDsParams) ([]CountChecklistItemsByTaskIDsRow, error) {
+	query := countChecklistItemsByTaskIDs
+	var queryParams []interface{}
+	if len(arg.TaskIds) > 0 {
+		for _, v := range arg.TaskIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:task_ids*/?", strings.Repeat(",?", len(arg.TaskIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:task_ids*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.OrganizationID)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountChecklistItemsByTaskIDsRow
+	for rows.Next() {
+		var i CountChecklistItemsByTaskIDsRow
+		if err := rows.Scan(&i.TaskID, &i.Total, &i.Done); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createChecklistItem = `-- name: CreateChecklistItem :exec
+INSERT INTO task_checklist_items (id, task_id, position, title, required)
+SELECT ?,
+  tasks.id,
+  ?,
+  ?,
+  ?
+FROM tasks
+WHERE tasks.id = ?
+  AND tasks.organization_id = ?
+`
+
+type CreateChecklistItemParams struct {
+	ID             string
+	Position       int32
+	Title          string
+	Required       bool
+	TaskID         string
+	OrganizationID string
+}
+
+func (q *Queries) CreateChecklistItem(ctx context.Context, arg CreateChecklistItemParams) error {
+	_, err := q.db.ExecContext(ctx, createChecklistItem,
+		arg.ID,
+		arg.Position,
+		arg.Title,
+		arg.Required,
+		arg.TaskID,
+		arg.OrganizationID,
+	)
+	return err
+}
+
+const deleteChecklistItem = `-- name: DeleteChecklistItem :exec
+DELETE FROM task_checklist_items
+WHERE task_checklist_items.id = ?
+  AND task_checklist_items.task_id IN (
+    SELECT tasks.id
+    FROM tasks
+    WHERE tasks.organization_id = ?
+  )
+`
+
+type DeleteChecklistItemParams struct {
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) DeleteChecklistItem(ctx context.Context, arg DeleteChecklistItemParams) error {
+	_, err := q.db.ExecContext(ctx, deleteChecklistItem, arg.ID, arg.OrganizationID)
+	return err
+}
+
+const getChecklistItemByID = `-- name: GetChecklistItemByID :one
+SELECT task_checklist_items.id, task_checklist_items.task_id, task_checklist_items.position, task_checklist_items.title, task_checklist_items.required, task_checklist_items.done, task_checklist_items.done_at, task_checklist_items.created_at, task_checklist_items.updated_at
+FROM task_checklist_items
+  JOIN tasks ON tasks.id = task_checklist_items.task_id
+WHERE task_checklist_items.id = ?
+  AND tasks.organization_id = ?
+LIMIT 1
+`
+
+type GetChecklistItemByIDParams struct {
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) GetChecklistItemByID(ctx context.Context, arg GetChecklistItemByIDParams) (TaskChecklistItem, error) {
+	row := q.db.QueryRowContext(ctx, getChecklistItemByID, arg.ID, arg.OrganizationID)
+	var i TaskChecklistItem
+	err := row.Scan(
+		&i.ID,
+		&i.TaskID,
+		&i.Position,
+		&i.Title,
+		&i.Required,
+		&i.Done,
+		&i.DoneAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listChecklistItemsByTaskID = `-- name: ListChecklistItemsByTaskID :many
+SELECT task_checklist_items.id, task_checklist_items.task_id, task_checklist_items.position, task_checklist_items.title, task_checklist_items.required, task_checklist_items.done, task_checklist_items.done_at, task_checklist_items.created_at, task_checklist_items.updated_at
+FROM task_checklist_items
+  JOIN tasks ON tasks.id = task_checklist_items.task_id
+WHERE task_checklist_items.task_id = ?
+  AND tasks.organization_id = ?
+ORDER BY task_checklist_items.position,
+  task_checklist_items.id
+`
+
+type ListChecklistItemsByTaskIDParams struct {
+	TaskID         string
+	OrganizationID string
+}
+
+func (q *Queries) ListChecklistItemsByTaskID(ctx context.Context, arg ListChecklistItemsByTaskIDParams) ([]TaskChecklistItem, error) {
+	rows, err := q.db.QueryContext(ctx, listChecklistItemsByTaskID, arg.TaskID, arg.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskChecklistItem
+	for rows.Next() {
+		var i TaskChecklistItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.TaskID,
+			&i.Position,
+			&i.Title,
+			&i.Required,
+			&i.Done,
+			&i.DoneAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateChecklistItem = `-- name: UpdateChecklistItem :exec
+UPDATE task_checklist_items
+SET title = ?,
+  required = ?,
+  done = ?,
+  done_at = ?
+WHERE task_checklist_items.id = ?
+  AND task_checklist_items.task_id IN (
+    SELECT tasks.id
+    FROM tasks
+    WHERE tasks.organization_id = ?
+  )
+`
+
+type UpdateChecklistItemParams struct {
+	Title          string
+	Required       bool
+	Done           bool
+	DoneAt         sql.NullTime
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) UpdateChecklistItem(ctx context.Context, arg UpdateChecklistItemParams) error {
+	_, err := q.db.ExecContext(ctx, updateChecklistItem,
+		arg.Title,
+		arg.Required,
+		arg.Done,
+		arg.DoneAt,
+		arg.ID,
+		arg.OrganizationID,
+	)
+	return err
+}
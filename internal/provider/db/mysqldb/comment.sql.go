@@ -0,0 +1,85 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: comment.sql
+
+package mysqldb
+
+import (
+	"context"
+)
+
+const createComment = `-- name: CreateComment :exec
+INSERT INTO task_comments (id, task_id, user_id, body)
+SELECT ?,
+  tasks.id,
+  ?,
+  ?
+FROM tasks
+WHERE tasks.id = ?
+  AND tasks.organization_id = ?
+`
+
+type CreateCommentParams struct {
+	ID             string
+	UserID         string
+	Body           string
+	TaskID         string
+	OrganizationID string
+}
+
+func (q *Queries) CreateComment(ctx context.Context, arg CreateCommentParams) error {
+	_, err := q.db.ExecContext(ctx, createComment,
+		arg.ID,
+		arg.UserID,
+		arg.Body,
+		arg.TaskID,
+		arg.OrganizationID,
+	)
+	return err
+}
+
+const listCommentsByTaskID = `-- name: ListCommentsByTaskID :many
+SELECT task_comments.id, task_comments.task_id, task_comments.user_id, task_comments.body, task_comments.created_at, task_comments.updated_at
+FROM task_comments
+  JOIN tasks ON tasks.id = task_comments.task_id
+WHERE task_comments.task_id = ?
+  AND tasks.organization_id = ?
+ORDER BY task_comments.created_at,
+  task_comments.id
+`
+
+type ListCommentsByTaskIDParams struct {
+	TaskID         string
+	OrganizationID string
+}
+
+func (q *Queries) ListCommentsByTaskID(ctx context.Context, arg ListCommentsByTaskIDParams) ([]TaskComment, error) {
+	rows, err := q.db.QueryContext(ctx, listCommentsByTaskID, arg.TaskID, arg.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskComment
+	for rows.Next() {
+		var i TaskComment
+		if err := rows.Scan(
+			&i.ID,
+			&i.TaskID,
+			&i.UserID,
+			&i.Body,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,148 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: label.sql
+
+package mysqldb
+
+import (
+	"context"
+)
+
+const createLabel = `-- name: CreateLabel :exec
+INSERT INTO labels (id, organization_id, name)
+VALUES (?, ?, ?)
+`
+
+type CreateLabelParams struct {
+	ID             string
+	OrganizationID string
+	Name           string
+}
+
+func (q *Queries) CreateLabel(ctx context.Context, arg CreateLabelParams) error {
+	_, err := q.db.ExecContext(ctx, createLabel, arg.ID, arg.OrganizationID, arg.Name)
+	return err
+}
+
+const deleteLabel = `-- name: DeleteLabel :exec
+DELETE FROM labels
+WHERE id = ?
+  AND organization_id = ?
+`
+
+type DeleteLabelParams struct {
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) DeleteLabel(ctx context.Context, arg DeleteLabelParams) error {
+	_, err := q.db.ExecContext(ctx, deleteLabel, arg.ID, arg.OrganizationID)
+	return err
+}
+
+const getLabelByID = `-- name: GetLabelByID :one
+SELECT id, name, created_at, updated_at, organization_id
+FROM labels
+WHERE id = ?
+  AND organization_id = ?
+LIMIT 1
+`
+
+type GetLabelByIDParams struct {
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) GetLabelByID(ctx context.Context, arg GetLabelByIDParams) (Label, error) {
+	row := q.db.QueryRowContext(ctx, getLabelByID, arg.ID, arg.OrganizationID)
+	var i Label
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const getLabelByName = `-- name: GetLabelByName :one
+SELECT id, name, created_at, updated_at, organization_id
+FROM labels
+WHERE name = ?
+  AND organization_id = ?
+LIMIT 1
+`
+
+type GetLabelByNameParams struct {
+	Name           string
+	OrganizationID string
+}
+
+func (q *Queries) GetLabelByName(ctx context.Context, arg GetLabelByNameParams) (Label, error) {
+	row := q.db.QueryRowContext(ctx, getLabelByName, arg.Name, arg.OrganizationID)
+	var i Label
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const listLabels = `-- name: ListLabels :many
+SELECT id, name, created_at, updated_at, organization_id
+FROM labels
+WHERE organization_id = ?
+ORDER BY name
+`
+
+func (q *Queries) ListLabels(ctx context.Context, organizationID string) ([]Label, error) {
+	rows, err := q.db.QueryContext(ctx, listLabels, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Label
+	for rows.Next() {
+		var i Label
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.OrganizationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateLabel = `-- name: UpdateLabel :exec
+UPDATE labels
+SET name = ?
+WHERE id = ?
+  AND organization_id = ?
+`
+
+type UpdateLabelParams struct {
+	Name           string
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) UpdateLabel(ctx context.Context, arg UpdateLabelParams) error {
+	_, err := q.db.ExecContext(ctx, updateLabel, arg.Name, arg.ID, arg.OrganizationID)
+	return err
+}
@@ -6,25 +6,163 @@ package mysqldb
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 )
 
-type Task struct {
+type CalendarFeedToken struct {
+	UserID    string
+	TokenHash string
+	CreatedAt time.Time
+}
+
+type Label struct {
+	ID             string
+	Name           string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	OrganizationID string
+}
+
+type Organization struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Project struct {
+	ID             string
+	Name           string
+	Description    string
+	ManagerUserID  string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	OrganizationID string
+}
+
+type ProjectMember struct {
+	ProjectID string
+	UserID    string
+	CreatedAt time.Time
+}
+
+type RecurringTask struct {
 	ID               string
 	Summary          string
+	Schedule         string
 	AssignedToUserID sql.NullString
 	CreatedByUserID  string
-	FinishedAt       sql.NullTime
+	NextRunAt        time.Time
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
+	OrganizationID   string
 }
 
-type User struct {
+type RecurringTaskOccurrence struct {
+	RecurringTaskID string
+	ScheduledAt     time.Time
+	CreatedAt       time.Time
+}
+
+type Task struct {
+	ID                   string
+	Summary              string
+	CreatedByUserID      string
+	FinishedAt           sql.NullTime
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	Status               string
+	ReopenReason         sql.NullString
+	ReopenedAt           sql.NullTime
+	DeletedAt            sql.NullTime
+	DueAt                sql.NullTime
+	Priority             string
+	OverdueNotifiedAt    sql.NullTime
+	FinishPolicy         string
+	ProjectID            sql.NullString
+	OrganizationID       string
+	WorkSessionsUnlocked bool
+	Version              int64
+}
+
+type TaskAssignee struct {
+	TaskID      string
+	UserID      string
+	Position    uint16
+	SignedOffAt sql.NullTime
+}
+
+type TaskAttachment struct {
+	ID          string
+	TaskID      string
+	UserID      string
+	FileName    string
+	ContentType string
+	Size        int64
+	CreatedAt   time.Time
+}
+
+type TaskChecklistItem struct {
 	ID        string
-	Role      uint8
-	Name      string
-	Email     string
-	Password  string
+	TaskID    string
+	Position  int32
+	Title     string
+	Required  bool
+	Done      bool
+	DoneAt    sql.NullTime
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type TaskComment struct {
+	ID        string
+	TaskID    string
+	UserID    string
+	Body      string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+type TaskDependency struct {
+	TaskID          string
+	BlockedByTaskID string
+	CreatedAt       time.Time
+}
+
+type TaskEvent struct {
+	ID          string
+	TaskID      string
+	ActorUserID string
+	Type        string
+	Changes     json.RawMessage
+	CreatedAt   time.Time
+}
+
+type TaskLabel struct {
+	TaskID  string
+	LabelID string
+}
+
+type TaskWorkSession struct {
+	ID        string
+	TaskID    string
+	UserID    string
+	StartedAt time.Time
+	EndedAt   sql.NullTime
+	Note      sql.NullString
+	Manual    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type User struct {
+	ID             string
+	Role           uint8
+	Name           string
+	Email          string
+	Password       string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	OrganizationID string
+}
@@ -0,0 +1,122 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: organization.sql
+
+package mysqldb
+
+import (
+	"context"
+)
+
+const createOrganization = `-- name: CreateOrganization :exec
+INSERT INTO organizations (id, name)
+VALUES (?, ?)
+`
+
+type CreateOrganizationParams struct {
+	ID   string
+	Name string
+}
+
+func (q *Queries) CreateOrganization(ctx context.Context, arg CreateOrganizationParams) error {
+	_, err := q.db.ExecContext(ctx, createOrganization, arg.ID, arg.Name)
+	return err
+}
+
+const getOrganizationByID = `-- name: GetOrganizationByID :one
+SELECT id, name, created_at, updated_at
+FROM organizations
+WHERE id = ?
+LIMIT 1
+`
+
+func (q *Queries) GetOrganizationByID(ctx context.Context, id string) (Organization, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationByID, id)
+	var i Organization
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getOrganizationIDByCalendarFeedTokenHash = `-- name: GetOrganizationIDByCalendarFeedTokenHash :one
+SELECT users.organization_id
+FROM calendar_feed_tokens
+  JOIN users ON users.id = calendar_feed_tokens.user_id
+WHERE calendar_feed_tokens.token_hash = ?
+LIMIT 1
+`
+
+func (q *Queries) GetOrganizationIDByCalendarFeedTokenHash(ctx context.Context, tokenHash string) (string, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationIDByCalendarFeedTokenHash, tokenHash)
+	var organization_id string
+	err := row.Scan(&organization_id)
+	return organization_id, err
+}
+
+const getOrganizationIDByUserEmail = `-- name: GetOrganizationIDByUserEmail :one
+SELECT organization_id
+FROM users
+WHERE email = ?
+LIMIT 1
+`
+
+func (q *Queries) GetOrganizationIDByUserEmail(ctx context.Context, email string) (string, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationIDByUserEmail, email)
+	var organization_id string
+	err := row.Scan(&organization_id)
+	return organization_id, err
+}
+
+const getOrganizationIDByUserID = `-- name: GetOrganizationIDByUserID :one
+SELECT organization_id
+FROM users
+WHERE id = ?
+LIMIT 1
+`
+
+func (q *Queries) GetOrganizationIDByUserID(ctx context.Context, id string) (string, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationIDByUserID, id)
+	var organization_id string
+	err := row.Scan(&organization_id)
+	return organization_id, err
+}
+
+const listOrganizations = `-- name: ListOrganizations :many
+SELECT id, name, created_at, updated_at
+FROM organizations
+ORDER BY name,
+  id
+`
+
+func (q *Queries) ListOrganizations(ctx context.Context) ([]Organization, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizations)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Organization
+	for rows.Next() {
+		var i Organization
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
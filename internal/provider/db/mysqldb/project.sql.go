@@ -0,0 +1,285 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: project.sql
+
+package mysqldb
+
+import (
+	"context"
+	"database/sql"
+)
+
+const addProjectMember = `-- name: AddProjectMember :exec
+INSERT IGNORE INTO project_members (project_id, user_id)
+SELECT projects.id,
+  users.id
+FROM projects
+  JOIN users ON users.organization_id = projects.organization_id
+WHERE projects.id = ?
+  AND users.id = ?
+  AND projects.organization_id = ?
+`
+
+type AddProjectMemberParams struct {
+	ProjectID      string
+	UserID         string
+	OrganizationID string
+}
+
+func (q *Queries) AddProjectMember(ctx context.Context, arg AddProjectMemberParams) error {
+	_, err := q.db.ExecContext(ctx, addProjectMember, arg.ProjectID, arg.UserID, arg.OrganizationID)
+	return err
+}
+
+const createProject = `-- name: CreateProject :exec
+INSERT INTO projects (
+    id,
+    organization_id,
+    name,
+    description,
+    manager_user_id
+  )
+VALUES (?, ?, ?, ?, ?)
+`
+
+type CreateProjectParams struct {
+	ID             string
+	OrganizationID string
+	Name           string
+	Description    string
+	ManagerUserID  string
+}
+
+func (q *Queries) CreateProject(ctx context.Context, arg CreateProjectParams) error {
+	_, err := q.db.ExecContext(ctx, createProject,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Name,
+		arg.Description,
+		arg.ManagerUserID,
+	)
+	return err
+}
+
+const deleteProject = `-- name: DeleteProject :exec
+DELETE FROM projects
+WHERE id = ?
+  AND organization_id = ?
+`
+
+type DeleteProjectParams struct {
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) DeleteProject(ctx context.Context, arg DeleteProjectParams) error {
+	_, err := q.db.ExecContext(ctx, deleteProject, arg.ID, arg.OrganizationID)
+	return err
+}
+
+const getProjectByID = `-- name: GetProjectByID :one
+SELECT id, name, description, manager_user_id, created_at, updated_at, organization_id
+FROM projects
+WHERE id = ?
+  AND organization_id = ?
+LIMIT 1
+`
+
+type GetProjectByIDParams struct {
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) GetProjectByID(ctx context.Context, arg GetProjectByIDParams) (Project, error) {
+	row := q.db.QueryRowContext(ctx, getProjectByID, arg.ID, arg.OrganizationID)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.ManagerUserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const getProjectMember = `-- name: GetProjectMember :one
+SELECT project_members.project_id, project_members.user_id, project_members.created_at
+FROM project_members
+  JOIN projects ON projects.id = project_members.project_id
+WHERE project_members.project_id = ?
+  AND project_members.user_id = ?
+  AND projects.organization_id = ?
+LIMIT 1
+`
+
+type GetProjectMemberParams struct {
+	ProjectID      string
+	UserID         string
+	OrganizationID string
+}
+
+func (q *Queries) GetProjectMember(ctx context.Context, arg GetProjectMemberParams) (ProjectMember, error) {
+	row := q.db.QueryRowContext(ctx, getProjectMember, arg.ProjectID, arg.UserID, arg.OrganizationID)
+	var i ProjectMember
+	err := row.Scan(&i.ProjectID, &i.UserID, &i.CreatedAt)
+	return i, err
+}
+
+const listProjectMembers = `-- name: ListProjectMembers :many
+SELECT project_members.project_id, project_members.user_id, project_members.created_at
+FROM project_members
+  JOIN projects ON projects.id = project_members.project_id
+WHERE project_members.project_id = ?
+  AND projects.organization_id = ?
+ORDER BY project_members.created_at,
+  project_members.user_id
+`
+
+type ListProjectMembersParams struct {
+	ProjectID      string
+	OrganizationID string
+}
+
+func (q *Queries) ListProjectMembers(ctx context.Context, arg ListProjectMembersParams) ([]ProjectMember, error) {
+	rows, err := q.db.QueryContext(ctx, listProjectMembers, arg.ProjectID, arg.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ProjectMember
+	for rows.Next() {
+		var i ProjectMember
+		if err := rows.Scan(&i.ProjectID, &i.UserID, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProjectsByMemberUserID = `-- name: ListProjectsByMemberUserID :many
+SELECT projects.id, projects.name, projects.description, projects.manager_user_id, projects.created_at, projects.updated_at, projects.organization_id
+FROM projects
+  JOIN project_members ON project_members.project_id = projects.id
+WHERE project_members.user_id = ?
+  AND projects.organization_id = ?
+ORDER BY projects.name,
+  projects.id
+`
+
+type ListProjectsByMemberUserIDParams struct {
+	UserID         string
+	OrganizationID string
+}
+
+func (q *Queries) ListProjectsByMemberUserID(ctx context.Context, arg ListProjectsByMemberUserIDParams) ([]Project, error) {
+	rows, err := q.db.QueryContext(ctx, listProjectsByMemberUserID, arg.UserID, arg.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Project
+	for rows.Next() {
+		var i Project
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.ManagerUserID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.OrganizationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const projectHasTasks = `-- name: ProjectHasTasks :one
+SELECT EXISTS (
+    SELECT 1
+    FROM tasks
+    WHERE project_id = ?
+      AND organization_id = ?
+  )
+`
+
+type ProjectHasTasksParams struct {
+	ProjectID      sql.NullString
+	OrganizationID string
+}
+
+func (q *Queries) ProjectHasTasks(ctx context.Context, arg ProjectHasTasksParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, projectHasTasks, arg.ProjectID, arg.OrganizationID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const removeProjectMember = `-- name: RemoveProjectMember :exec
+DELETE FROM project_members
+WHERE project_id = ?
+  AND user_id = ?
+  AND project_id IN (
+    SELECT id
+    FROM projects
+    WHERE organization_id = ?
+  )
+`
+
+type RemoveProjectMemberParams struct {
+	ProjectID      string
+	UserID         string
+	OrganizationID string
+}
+
+func (q *Queries) RemoveProjectMember(ctx context.Context, arg RemoveProjectMemberParams) error {
+	_, err := q.db.ExecContext(ctx, removeProjectMember, arg.ProjectID, arg.UserID, arg.OrganizationID)
+	return err
+}
+
+const updateProject = `-- name: UpdateProject :exec
+UPDATE projects
+SET name = ?,
+  description = ?,
+  manager_user_id = ?
+WHERE id = ?
+  AND organization_id = ?
+`
+
+type UpdateProjectParams struct {
+	Name           string
+	Description    string
+	ManagerUserID  string
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) UpdateProject(ctx context.Context, arg UpdateProjectParams) error {
+	_, err := q.db.ExecContext(ctx, updateProject,
+		arg.Name,
+		arg.Description,
+		arg.ManagerUserID,
+		arg.ID,
+		arg.OrganizationID,
+	)
+	return err
+}
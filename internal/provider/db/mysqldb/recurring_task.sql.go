@@ -0,0 +1,223 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: recurring_task.sql
+
+package mysqldb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const claimRecurringTaskOccurrence = `-- name: ClaimRecurringTaskOccurrence :execrows
+INSERT IGNORE INTO recurring_task_occurrences (recurring_task_id, scheduled_at)
+SELECT recurring_tasks.id,
+  ?
+FROM recurring_tasks
+WHERE recurring_tasks.id = ?
+  AND recurring_tasks.organization_id = ?
+`
+
+type ClaimRecurringTaskOccurrenceParams struct {
+	ScheduledAt     time.Time
+	RecurringTaskID string
+	OrganizationID  string
+}
+
+func (q *Queries) ClaimRecurringTaskOccurrence(ctx context.Context, arg ClaimRecurringTaskOccurrenceParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, claimRecurringTaskOccurrence, arg.ScheduledAt, arg.RecurringTaskID, arg.OrganizationID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createRecurringTask = `-- name: CreateRecurringTask :exec
+INSERT INTO recurring_tasks (
+    id,
+    organization_id,
+    summary,
+    schedule,
+    assigned_to_user_id,
+    created_by_user_id,
+    next_run_at
+  )
+VALUES (?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateRecurringTaskParams struct {
+	ID               string
+	OrganizationID   string
+	Summary          string
+	Schedule         string
+	AssignedToUserID sql.NullString
+	CreatedByUserID  string
+	NextRunAt        time.Time
+}
+
+func (q *Queries) CreateRecurringTask(ctx context.Context, arg CreateRecurringTaskParams) error {
+	_, err := q.db.ExecContext(ctx, createRecurringTask,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Summary,
+		arg.Schedule,
+		arg.AssignedToUserID,
+		arg.CreatedByUserID,
+		arg.NextRunAt,
+	)
+	return err
+}
+
+const deleteRecurringTask = `-- name: DeleteRecurringTask :exec
+DELETE FROM recurring_tasks
+WHERE id = ?
+  AND organization_id = ?
+`
+
+type DeleteRecurringTaskParams struct {
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) DeleteRecurringTask(ctx context.Context, arg DeleteRecurringTaskParams) error {
+	_, err := q.db.ExecContext(ctx, deleteRecurringTask, arg.ID, arg.OrganizationID)
+	return err
+}
+
+const getRecurringTaskByID = `-- name: GetRecurringTaskByID :one
+SELECT id, summary, schedule, assigned_to_user_id, created_by_user_id, next_run_at, created_at, updated_at, organization_id
+FROM recurring_tasks
+WHERE id = ?
+  AND organization_id = ?
+LIMIT 1
+`
+
+type GetRecurringTaskByIDParams struct {
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) GetRecurringTaskByID(ctx context.Context, arg GetRecurringTaskByIDParams) (RecurringTask, error) {
+	row := q.db.QueryRowContext(ctx, getRecurringTaskByID, arg.ID, arg.OrganizationID)
+	var i RecurringTask
+	err := row.Scan(
+		&i.ID,
+		&i.Summary,
+		&i.Schedule,
+		&i.AssignedToUserID,
+		&i.CreatedByUserID,
+		&i.NextRunAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const listDueRecurringTasks = `-- name: ListDueRecurringTasks :many
+SELECT id, summary, schedule, assigned_to_user_id, created_by_user_id, next_run_at, created_at, updated_at, organization_id
+FROM recurring_tasks
+WHERE next_run_at <= ?
+  AND organization_id = ?
+ORDER BY next_run_at,
+  id
+`
+
+type ListDueRecurringTasksParams struct {
+	NextRunAt      time.Time
+	OrganizationID string
+}
+
+func (q *Queries) ListDueRecurringTasks(ctx context.Context, arg ListDueRecurringTasksParams) ([]RecurringTask, error) {
+	rows, err := q.db.QueryContext(ctx, listDueRecurringTasks, arg.NextRunAt, arg.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RecurringTask
+	for rows.Next() {
+		var i RecurringTask
+		if err := rows.Scan(
+			&i.ID,
+			&i.Summary,
+			&i.Schedule,
+			&i.AssignedToUserID,
+			&i.CreatedByUserID,
+			&i.NextRunAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.OrganizationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecurringTasks = `-- name: ListRecurringTasks :many
+SELECT id, summary, schedule, assigned_to_user_id, created_by_user_id, next_run_at, created_at, updated_at, organization_id
+FROM recurring_tasks
+WHERE organization_id = ?
+ORDER BY created_at,
+  id
+`
+
+func (q *Queries) ListRecurringTasks(ctx context.Context, organizationID string) ([]RecurringTask, error) {
+	rows, err := q.db.QueryContext(ctx, listRecurringTasks, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RecurringTask
+	for rows.Next() {
+		var i RecurringTask
+		if err := rows.Scan(
+			&i.ID,
+			&i.Summary,
+			&i.Schedule,
+			&i.AssignedToUserID,
+			&i.CreatedByUserID,
+			&i.NextRunAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.OrganizationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateRecurringTaskNextRunAt = `-- name: UpdateRecurringTaskNextRunAt :exec
+UPDATE recurring_tasks
+SET next_run_at = ?
+WHERE id = ?
+  AND organization_id = ?
+`
+
+type UpdateRecurringTaskNextRunAtParams struct {
+	NextRunAt      time.Time
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) UpdateRecurringTaskNextRunAt(ctx context.Context, arg UpdateRecurringTaskNextRunAtParams) error {
+	_, err := q.db.ExecContext(ctx, updateRecurringTaskNextRunAt, arg.NextRunAt, arg.ID, arg.OrganizationID)
+	return err
+}
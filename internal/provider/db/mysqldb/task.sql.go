@@ -8,63 +8,253 @@ package mysqldb
 import (
 	"context"
 	"database/sql"
+	"strings"
 )
 
+const clearTaskSignOffs = `-- name: ClearTaskSignOffs :exec
+UPDATE task_assignees
+SET signed_off_at = NULL
+WHERE task_id = ?
+  AND task_id IN (
+    SELECT id
+    FROM tasks
+    WHERE organization_id = ?
+  )
+`
+
+type ClearTaskSignOffsParams struct {
+	TaskID         string
+	OrganizationID string
+}
+
+func (q *Queries) ClearTaskSignOffs(ctx context.Context, arg ClearTaskSignOffsParams) error {
+	_, err := q.db.ExecContext(ctx, clearTaskSignOffs, arg.TaskID, arg.OrganizationID)
+	return err
+}
+
 const createTask = `-- name: CreateTask :exec
-INSERT INTO tasks (summary, created_by_user_id, assigned_to_user_id)
-VALUES (?, ?, ?)
+INSERT INTO tasks (
+    id,
+    organization_id,
+    summary,
+    created_by_user_id,
+    due_at,
+    priority,
+    finish_policy,
+    project_id
+  )
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateTaskParams struct {
-	Summary          string
-	CreatedByUserID  string
-	AssignedToUserID sql.NullString
+	ID              string
+	OrganizationID  string
+	Summary         string
+	CreatedByUserID string
+	DueAt           sql.NullTime
+	Priority        string
+	FinishPolicy    string
+	ProjectID       sql.NullString
 }
 
 func (q *Queries) CreateTask(ctx context.Context, arg CreateTaskParams) error {
-	_, err := q.db.ExecContext(ctx, createTask, arg.Summary, arg.CreatedByUserID, arg.AssignedToUserID)
+	_, err := q.db.ExecContext(ctx, createTask,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Summary,
+		arg.CreatedByUserID,
+		arg.DueAt,
+		arg.Priority,
+		arg.FinishPolicy,
+		arg.ProjectID,
+	)
+	return err
+}
+
+const createTaskLabel = `-- name: CreateTaskLabel :exec
+INSERT INTO task_labels (task_id, label_id)
+SELECT tasks.id,
+  labels.id
+FROM tasks
+  JOIN labels ON labels.organization_id = tasks.organization_id
+WHERE tasks.id = ?
+  AND labels.id = ?
+  AND tasks.organization_id = ?
+`
+
+type CreateTaskLabelParams struct {
+	TaskID         string
+	LabelID        string
+	OrganizationID string
+}
+
+func (q *Queries) CreateTaskLabel(ctx context.Context, arg CreateTaskLabelParams) error {
+	_, err := q.db.ExecContext(ctx, createTaskLabel, arg.TaskID, arg.LabelID, arg.OrganizationID)
 	return err
 }
 
 const deleteTask = `-- name: DeleteTask :exec
-DELETE FROM tasks
+UPDATE tasks
+SET deleted_at = CURRENT_TIMESTAMP,
+  version = version + 1
 WHERE id = ?
+  AND organization_id = ?
+  AND deleted_at IS NULL
+`
+
+type DeleteTaskParams struct {
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) DeleteTask(ctx context.Context, arg DeleteTaskParams) error {
+	_, err := q.db.ExecContext(ctx, deleteTask, arg.ID, arg.OrganizationID)
+	return err
+}
+
+const deleteTaskAssignee = `-- name: DeleteTaskAssignee :exec
+DELETE FROM task_assignees
+WHERE task_id = ?
+  AND user_id = ?
+  AND task_id IN (
+    SELECT id
+    FROM tasks
+    WHERE organization_id = ?
+  )
 `
 
-func (q *Queries) DeleteTask(ctx context.Context, id string) error {
-	_, err := q.db.ExecContext(ctx, deleteTask, id)
+type DeleteTaskAssigneeParams struct {
+	TaskID         string
+	UserID         string
+	OrganizationID string
+}
+
+func (q *Queries) DeleteTaskAssignee(ctx context.Context, arg DeleteTaskAssigneeParams) error {
+	_, err := q.db.ExecContext(ctx, deleteTaskAssignee, arg.TaskID, arg.UserID, arg.OrganizationID)
 	return err
 }
 
+const deleteTaskLabels = `-- name: DeleteTaskLabels :exec
+DELETE FROM task_labels
+WHERE task_id = ?
+  AND task_id IN (
+    SELECT id
+    FROM tasks
+    WHERE organization_id = ?
+  )
+`
+
+type DeleteTaskLabelsParams struct {
+	TaskID         string
+	OrganizationID string
+}
+
+func (q *Queries) DeleteTaskLabels(ctx context.Context, arg DeleteTaskLabelsParams) error {
+	_, err := q.db.ExecContext(ctx, deleteTaskLabels, arg.TaskID, arg.OrganizationID)
+	return err
+}
+
+const getDeletedTaskByID = `-- name: GetDeletedTaskByID :one
+SELECT id, summary, created_by_user_id, finished_at, created_at, updated_at, status, reopen_reason, reopened_at, deleted_at, due_at, priority, overdue_notified_at, finish_policy, project_id, organization_id, work_sessions_unlocked, version
+FROM tasks
+WHERE id = ?
+  AND organization_id = ?
+  AND deleted_at IS NOT NULL
+LIMIT 1
+`
+
+type GetDeletedTaskByIDParams struct {
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) GetDeletedTaskByID(ctx context.Context, arg GetDeletedTaskByIDParams) (Task, error) {
+	row := q.db.QueryRowContext(ctx, getDeletedTaskByID, arg.ID, arg.OrganizationID)
+	var i Task
+	err := row.Scan(
+		&i.ID,
+		&i.Summary,
+		&i.CreatedByUserID,
+		&i.FinishedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Status,
+		&i.ReopenReason,
+		&i.ReopenedAt,
+		&i.DeletedAt,
+		&i.DueAt,
+		&i.Priority,
+		&i.OverdueNotifiedAt,
+		&i.FinishPolicy,
+		&i.ProjectID,
+		&i.OrganizationID,
+		&i.WorkSessionsUnlocked,
+		&i.Version,
+	)
+	return i, err
+}
+
 const getTaskByID = `-- name: GetTaskByID :one
-SELECT id, summary, assigned_to_user_id, created_by_user_id, finished_at, created_at, updated_at
+SELECT id, summary, created_by_user_id, finished_at, created_at, updated_at, status, reopen_reason, reopened_at, deleted_at, due_at, priority, overdue_notified_at, finish_policy, project_id, organization_id, work_sessions_unlocked, version
 FROM tasks
 WHERE id = ?
+  AND organization_id = ?
+  AND deleted_at IS NULL
 LIMIT 1
 `
 
-func (q *Queries) GetTaskByID(ctx context.Context, id string) (Task, error) {
-	row := q.db.QueryRowContext(ctx, getTaskByID, id)
+type GetTaskByIDParams struct {
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) GetTaskByID(ctx context.Context, arg GetTaskByIDParams) (Task, error) {
+	row := q.db.QueryRowContext(ctx, getTaskByID, arg.ID, arg.OrganizationID)
 	var i Task
 	err := row.Scan(
 		&i.ID,
 		&i.Summary,
-		&i.AssignedToUserID,
 		&i.CreatedByUserID,
 		&i.FinishedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
+		&i.ReopenReason,
+		&i.ReopenedAt,
+		&i.DeletedAt,
+		&i.DueAt,
+		&i.Priority,
+		&i.OverdueNotifiedAt,
+		&i.FinishPolicy,
+		&i.ProjectID,
+		&i.OrganizationID,
+		&i.WorkSessionsUnlocked,
+		&i.Version,
 	)
 	return i, err
 }
 
-const listTasks = `-- name: ListTasks :many
-SELECT id, summary, assigned_to_user_id, created_by_user_id, finished_at, created_at, updated_at
+const listOverdueTasksToNotify = `-- name: ListOverdueTasksToNotify :many
+SELECT id, summary, created_by_user_id, finished_at, created_at, updated_at, status, reopen_reason, reopened_at, deleted_at, due_at, priority, overdue_notified_at, finish_policy, project_id, organization_id, work_sessions_unlocked, version
 FROM tasks
+WHERE deleted_at IS NULL
+  AND status <> 'done'
+  AND due_at < ?
+  AND overdue_notified_at IS NULL
+  AND organization_id = ?
+ORDER BY due_at,
+  id
+LIMIT ?
 `
 
-func (q *Queries) ListTasks(ctx context.Context) ([]Task, error) {
-	rows, err := q.db.QueryContext(ctx, listTasks)
+type ListOverdueTasksToNotifyParams struct {
+	DueAt          sql.NullTime
+	OrganizationID string
+	Limit          int32
+}
+
+func (q *Queries) ListOverdueTasksToNotify(ctx context.Context, arg ListOverdueTasksToNotifyParams) ([]Task, error) {
+	rows, err := q.db.QueryContext(ctx, listOverdueTasksToNotify, arg.DueAt, arg.OrganizationID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -75,11 +265,22 @@ func (q *Queries) ListTasks(ctx context.Context) ([]Task, error) {
 		if err := rows.Scan(
 			&i.ID,
 			&i.Summary,
-			&i.AssignedToUserID,
 			&i.CreatedByUserID,
 			&i.FinishedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Status,
+			&i.ReopenReason,
+			&i.ReopenedAt,
+			&i.DeletedAt,
+			&i.DueAt,
+			&i.Priority,
+			&i.OverdueNotifiedAt,
+			&i.FinishPolicy,
+			&i.ProjectID,
+			&i.OrganizationID,
+			&i.WorkSessionsUnlocked,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -94,29 +295,46 @@ func (q *Queries) ListTasks(ctx context.Context) ([]Task, error) {
 	return items, nil
 }
 
-const listTasksWithFilters = `-- name: ListTasksWithFilters :many
-SELECT id, summary, assigned_to_user_id, created_by_user_id, finished_at, created_at, updated_at
-FROM tasks
-WHERE assigned_to_user_id = ?
+const listTaskAssigneesByTaskIDs = `-- name: ListTaskAssigneesByTaskIDs :many
+SELECT task_assignees.task_id, task_assignees.user_id, task_assignees.position, task_assignees.signed_off_at
+FROM task_assignees
+  JOIN tasks ON tasks.id = task_assignees.task_id
+WHERE task_assignees.task_id IN (/*SLICE:task_ids*/?)
+  AND tasks.organization_id = ?
+ORDER BY task_assignees.task_id,
+  task_assignees.position
 `
 
-func (q *Queries) ListTasksWithFilters(ctx context.Context, assignedToUserID sql.NullString) ([]Task, error) {
-	rows, err := q.db.QueryContext(ctx, listTasksWithFilters, assignedToUserID)
+type ListTaskAssigneesByTaskIDsParams struct {
+	TaskIds        []string
+	OrganizationID string
+}
+
+func (q *Queries) ListTaskAssigneesByTaskIDs(ctx context.Context, arg ListTaskAssigneesByTaskIDsParams) ([]TaskAssignee, error) {
+	query := listTaskAssigneesByTaskIDs
+	var queryParams []interface{}
+	if len(arg.TaskIds) > 0 {
+		for _, v := range arg.TaskIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:task_ids*/?", strings.Repeat(",?", len(arg.TaskIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:task_ids*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.OrganizationID)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Task
+	var items []TaskAssignee
 	for rows.Next() {
-		var i Task
+		var i TaskAssignee
 		if err := rows.Scan(
-			&i.ID,
-			&i.Summary,
-			&i.AssignedToUserID,
-			&i.CreatedByUserID,
-			&i.FinishedAt,
-			&i.CreatedAt,
-			&i.UpdatedAt,
+			&i.TaskID,
+			&i.UserID,
+			&i.Position,
+			&i.SignedOffAt,
 		); err != nil {
 			return nil, err
 		}
@@ -131,27 +349,254 @@ func (q *Queries) ListTasksWithFilters(ctx context.Context, assignedToUserID sql
 	return items, nil
 }
 
-const updateTask = `-- name: UpdateTask :exec
+const listTaskLabelsByTaskIDs = `-- name: ListTaskLabelsByTaskIDs :many
+SELECT task_labels.task_id, task_labels.label_id
+FROM task_labels
+  JOIN tasks ON tasks.id = task_labels.task_id
+WHERE task_labels.task_id IN (/*SLICE:task_ids*/?)
+  AND tasks.organization_id = ?
+`
+
+type ListTaskLabelsByTaskIDsParams struct {
+	TaskIds        []string
+	OrganizationID string
+}
+
+func (q *Queries) ListTaskLabelsByTaskIDs(ctx context.Context, arg ListTaskLabelsByTaskIDsParams) ([]TaskLabel, error) {
+	query := listTaskLabelsByTaskIDs
+	var queryParams []interface{}
+	if len(arg.TaskIds) > 0 {
+		for _, v := range arg.TaskIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:task_ids*/?", strings.Repeat(",?", len(arg.TaskIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:task_ids*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.OrganizationID)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskLabel
+	for rows.Next() {
+		var i TaskLabel
+		if err := rows.Scan(&i.TaskID, &i.LabelID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markTaskOverdueNotified = `-- name: MarkTaskOverdueNotified :execrows
+UPDATE tasks
+SET overdue_notified_at = ?,
+  updated_at = updated_at
+WHERE id = ?
+  AND organization_id = ?
+  AND overdue_notified_at IS NULL
+`
+
+type MarkTaskOverdueNotifiedParams struct {
+	OverdueNotifiedAt sql.NullTime
+	ID                string
+	OrganizationID    string
+}
+
+func (q *Queries) MarkTaskOverdueNotified(ctx context.Context, arg MarkTaskOverdueNotifiedParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, markTaskOverdueNotified, arg.OverdueNotifiedAt, arg.ID, arg.OrganizationID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const purgeDeletedTasks = `-- name: PurgeDeletedTasks :execrows
+DELETE FROM tasks
+WHERE deleted_at IS NOT NULL
+  AND deleted_at < ?
+  AND organization_id = ?
+`
+
+type PurgeDeletedTasksParams struct {
+	DeletedAt      sql.NullTime
+	OrganizationID string
+}
+
+func (q *Queries) PurgeDeletedTasks(ctx context.Context, arg PurgeDeletedTasksParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, purgeDeletedTasks, arg.DeletedAt, arg.OrganizationID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const restoreTask = `-- name: RestoreTask :exec
+UPDATE tasks
+SET deleted_at = NULL,
+  version = version + 1
+WHERE id = ?
+  AND organization_id = ?
+  AND deleted_at IS NOT NULL
+`
+
+type RestoreTaskParams struct {
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) RestoreTask(ctx context.Context, arg RestoreTaskParams) error {
+	_, err := q.db.ExecContext(ctx, restoreTask, arg.ID, arg.OrganizationID)
+	return err
+}
+
+const setTaskWorkSessionsUnlocked = `-- name: SetTaskWorkSessionsUnlocked :exec
+UPDATE tasks
+SET work_sessions_unlocked = ?,
+  version = version + 1
+WHERE id = ?
+  AND organization_id = ?
+  AND deleted_at IS NULL
+`
+
+type SetTaskWorkSessionsUnlockedParams struct {
+	WorkSessionsUnlocked bool
+	ID                   string
+	OrganizationID       string
+}
+
+func (q *Queries) SetTaskWorkSessionsUnlocked(ctx context.Context, arg SetTaskWorkSessionsUnlockedParams) error {
+	_, err := q.db.ExecContext(ctx, setTaskWorkSessionsUnlocked, arg.WorkSessionsUnlocked, arg.ID, arg.OrganizationID)
+	return err
+}
+
+const signOffTask = `-- name: SignOffTask :execrows
+UPDATE task_assignees
+SET signed_off_at = ?
+WHERE task_id = ?
+  AND user_id = ?
+  AND signed_off_at IS NULL
+  AND task_id IN (
+    SELECT id
+    FROM tasks
+    WHERE organization_id = ?
+  )
+`
+
+type SignOffTaskParams struct {
+	SignedOffAt    sql.NullTime
+	TaskID         string
+	UserID         string
+	OrganizationID string
+}
+
+func (q *Queries) SignOffTask(ctx context.Context, arg SignOffTaskParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, signOffTask,
+		arg.SignedOffAt,
+		arg.TaskID,
+		arg.UserID,
+		arg.OrganizationID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateTask = `-- name: UpdateTask :execrows
 UPDATE tasks
 SET summary = ?,
-  assigned_to_user_id = ?,
-  finished_at = ?
+  status = ?,
+  finished_at = ?,
+  reopen_reason = ?,
+  reopened_at = ?,
+  overdue_notified_at = IF(
+    due_at <=> ?,
+    overdue_notified_at,
+    NULL
+  ),
+  due_at = ?,
+  priority = ?,
+  finish_policy = ?,
+  project_id = ?,
+  version = version + 1
 WHERE id = ?
+  AND organization_id = ?
+  AND version = ?
+  AND deleted_at IS NULL
 `
 
 type UpdateTaskParams struct {
-	Summary          string
-	AssignedToUserID sql.NullString
-	FinishedAt       sql.NullTime
-	ID               string
+	Summary        string
+	Status         string
+	FinishedAt     sql.NullTime
+	ReopenReason   sql.NullString
+	ReopenedAt     sql.NullTime
+	DueAt          sql.NullTime
+	Priority       string
+	FinishPolicy   string
+	ProjectID      sql.NullString
+	ID             string
+	OrganizationID string
+	Version        int64
 }
 
-func (q *Queries) UpdateTask(ctx context.Context, arg UpdateTaskParams) error {
-	_, err := q.db.ExecContext(ctx, updateTask,
+func (q *Queries) UpdateTask(ctx context.Context, arg UpdateTaskParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateTask,
 		arg.Summary,
-		arg.AssignedToUserID,
+		arg.Status,
 		arg.FinishedAt,
+		arg.ReopenReason,
+		arg.ReopenedAt,
+		arg.DueAt,
+		arg.DueAt,
+		arg.Priority,
+		arg.FinishPolicy,
+		arg.ProjectID,
 		arg.ID,
+		arg.OrganizationID,
+		arg.Version,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const upsertTaskAssignee = `-- name: UpsertTaskAssignee :exec
+INSERT INTO task_assignees (task_id, user_id, position)
+SELECT tasks.id,
+  users.id,
+  ?
+FROM tasks
+  JOIN users ON users.organization_id = tasks.organization_id
+WHERE tasks.id = ?
+  AND users.id = ?
+  AND tasks.organization_id = ? ON DUPLICATE KEY
+UPDATE position = VALUES(position)
+`
+
+type UpsertTaskAssigneeParams struct {
+	Position       uint16
+	TaskID         string
+	UserID         string
+	OrganizationID string
+}
+
+func (q *Queries) UpsertTaskAssignee(ctx context.Context, arg UpsertTaskAssigneeParams) error {
+	_, err := q.db.ExecContext(ctx, upsertTaskAssignee,
+		arg.Position,
+		arg.TaskID,
+		arg.UserID,
+		arg.OrganizationID,
 	)
 	return err
 }
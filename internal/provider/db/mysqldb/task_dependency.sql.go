@@ -0,0 +1,130 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: task_dependency.sql
+
+package mysqldb
+
+import (
+	"context"
+)
+
+const createTaskDependency = `-- name: CreateTaskDependency :exec
+INSERT IGNORE INTO task_dependencies (task_id, blocked_by_task_id)
+SELECT tasks.id,
+  blocked_by_tasks.id
+FROM tasks
+  JOIN tasks AS blocked_by_tasks ON blocked_by_tasks.organization_id = tasks.organization_id
+WHERE tasks.id = ?
+  AND blocked_by_tasks.id = ?
+  AND tasks.organization_id = ?
+`
+
+type CreateTaskDependencyParams struct {
+	TaskID          string
+	BlockedByTaskID string
+	OrganizationID  string
+}
+
+func (q *Queries) CreateTaskDependency(ctx context.Context, arg CreateTaskDependencyParams) error {
+	_, err := q.db.ExecContext(ctx, createTaskDependency, arg.TaskID, arg.BlockedByTaskID, arg.OrganizationID)
+	return err
+}
+
+const deleteTaskDependency = `-- name: DeleteTaskDependency :exec
+DELETE FROM task_dependencies
+WHERE task_id = ?
+  AND blocked_by_task_id = ?
+  AND task_id IN (
+    SELECT id
+    FROM tasks
+    WHERE organization_id = ?
+  )
+`
+
+type DeleteTaskDependencyParams struct {
+	TaskID          string
+	BlockedByTaskID string
+	OrganizationID  string
+}
+
+func (q *Queries) DeleteTaskDependency(ctx context.Context, arg DeleteTaskDependencyParams) error {
+	_, err := q.db.ExecContext(ctx, deleteTaskDependency, arg.TaskID, arg.BlockedByTaskID, arg.OrganizationID)
+	return err
+}
+
+const listTaskDependenciesByBlockedByTaskID = `-- name: ListTaskDependenciesByBlockedByTaskID :many
+SELECT task_dependencies.task_id, task_dependencies.blocked_by_task_id, task_dependencies.created_at
+FROM task_dependencies
+  JOIN tasks ON tasks.id = task_dependencies.task_id
+WHERE task_dependencies.blocked_by_task_id = ?
+  AND tasks.organization_id = ?
+ORDER BY task_dependencies.created_at,
+  task_dependencies.task_id
+`
+
+type ListTaskDependenciesByBlockedByTaskIDParams struct {
+	BlockedByTaskID string
+	OrganizationID  string
+}
+
+func (q *Queries) ListTaskDependenciesByBlockedByTaskID(ctx context.Context, arg ListTaskDependenciesByBlockedByTaskIDParams) ([]TaskDependency, error) {
+	rows, err := q.db.QueryContext(ctx, listTaskDependenciesByBlockedByTaskID, arg.BlockedByTaskID, arg.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskDependency
+	for rows.Next() {
+		var i TaskDependency
+		if err := rows.Scan(&i.TaskID, &i.BlockedByTaskID, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTaskDependenciesByTaskID = `-- name: ListTaskDependenciesByTaskID :many
+SELECT task_dependencies.task_id, task_dependencies.blocked_by_task_id, task_dependencies.created_at
+FROM task_dependencies
+  JOIN tasks ON tasks.id = task_dependencies.task_id
+WHERE task_dependencies.task_id = ?
+  AND tasks.organization_id = ?
+ORDER BY task_dependencies.created_at,
+  task_dependencies.blocked_by_task_id
+`
+
+type ListTaskDependenciesByTaskIDParams struct {
+	TaskID         string
+	OrganizationID string
+}
+
+func (q *Queries) ListTaskDependenciesByTaskID(ctx context.Context, arg ListTaskDependenciesByTaskIDParams) ([]TaskDependency, error) {
+	rows, err := q.db.QueryContext(ctx, listTaskDependenciesByTaskID, arg.TaskID, arg.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskDependency
+	for rows.Next() {
+		var i TaskDependency
+		if err := rows.Scan(&i.TaskID, &i.BlockedByTaskID, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
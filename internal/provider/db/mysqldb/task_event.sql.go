@@ -0,0 +1,86 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: task_event.sql
+
+package mysqldb
+
+import (
+	"context"
+	"encoding/json"
+)
+
+const createTaskEvent = `-- name: CreateTaskEvent :exec
+INSERT INTO task_events (task_id, actor_user_id, type, changes)
+SELECT tasks.id,
+  ?,
+  ?,
+  ?
+FROM tasks
+WHERE tasks.id = ?
+  AND tasks.organization_id = ?
+`
+
+type CreateTaskEventParams struct {
+	ActorUserID    string
+	Type           string
+	Changes        json.RawMessage
+	TaskID         string
+	OrganizationID string
+}
+
+func (q *Queries) CreateTaskEvent(ctx context.Context, arg CreateTaskEventParams) error {
+	_, err := q.db.ExecContext(ctx, createTaskEvent,
+		arg.ActorUserID,
+		arg.Type,
+		arg.Changes,
+		arg.TaskID,
+		arg.OrganizationID,
+	)
+	return err
+}
+
+const listTaskEventsByTaskID = `-- name: ListTaskEventsByTaskID :many
+SELECT task_events.id, task_events.task_id, task_events.actor_user_id, task_events.type, task_events.changes, task_events.created_at
+FROM task_events
+  JOIN tasks ON tasks.id = task_events.task_id
+WHERE task_events.task_id = ?
+  AND tasks.organization_id = ?
+ORDER BY task_events.created_at,
+  task_events.id
+`
+
+type ListTaskEventsByTaskIDParams struct {
+	TaskID         string
+	OrganizationID string
+}
+
+func (q *Queries) ListTaskEventsByTaskID(ctx context.Context, arg ListTaskEventsByTaskIDParams) ([]TaskEvent, error) {
+	rows, err := q.db.QueryContext(ctx, listTaskEventsByTaskID, arg.TaskID, arg.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskEvent
+	for rows.Next() {
+		var i TaskEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.TaskID,
+			&i.ActorUserID,
+			&i.Type,
+			&i.Changes,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
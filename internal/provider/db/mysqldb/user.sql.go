@@ -10,19 +10,21 @@ import (
 )
 
 const createUser = `-- name: CreateUser :exec
-INSERT INTO users (role, name, email, password)
-VALUES (?, ?, ?, ?)
+INSERT INTO users (organization_id, role, name, email, password)
+VALUES (?, ?, ?, ?, ?)
 `
 
 type CreateUserParams struct {
-	Role     uint8
-	Name     string
-	Email    string
-	Password string
+	OrganizationID string
+	Role           uint8
+	Name           string
+	Email          string
+	Password       string
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
 	_, err := q.db.ExecContext(ctx, createUser,
+		arg.OrganizationID,
 		arg.Role,
 		arg.Name,
 		arg.Email,
@@ -32,14 +34,20 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, role, name, email, password, created_at, updated_at
+SELECT id, role, name, email, password, created_at, updated_at, organization_id
 FROM users
 WHERE email = ?
+  AND organization_id = ?
 LIMIT 1
 `
 
-func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByEmail, email)
+type GetUserByEmailParams struct {
+	Email          string
+	OrganizationID string
+}
+
+func (q *Queries) GetUserByEmail(ctx context.Context, arg GetUserByEmailParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByEmail, arg.Email, arg.OrganizationID)
 	var i User
 	err := row.Scan(
 		&i.ID,
@@ -49,19 +57,26 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.Password,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.OrganizationID,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, role, name, email, password, created_at, updated_at
+SELECT id, role, name, email, password, created_at, updated_at, organization_id
 FROM users
 WHERE id = ?
+  AND organization_id = ?
 LIMIT 1
 `
 
-func (q *Queries) GetUserByID(ctx context.Context, id string) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByID, id)
+type GetUserByIDParams struct {
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) GetUserByID(ctx context.Context, arg GetUserByIDParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByID, arg.ID, arg.OrganizationID)
 	var i User
 	err := row.Scan(
 		&i.ID,
@@ -71,6 +86,7 @@ func (q *Queries) GetUserByID(ctx context.Context, id string) (User, error) {
 		&i.Password,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -0,0 +1,306 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: work_session.sql
+
+package mysqldb
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+)
+
+const createWorkSession = `-- name: CreateWorkSession :exec
+INSERT INTO task_work_sessions (
+    id,
+    task_id,
+    user_id,
+    started_at,
+    ended_at,
+    note,
+    manual
+  )
+SELECT ?,
+  tasks.id,
+  users.id,
+  ?,
+  ?,
+  ?,
+  ?
+FROM tasks
+  JOIN users ON users.organization_id = tasks.organization_id
+WHERE tasks.id = ?
+  AND users.id = ?
+  AND tasks.organization_id = ?
+`
+
+type CreateWorkSessionParams struct {
+	ID             string
+	StartedAt      time.Time
+	EndedAt        sql.NullTime
+	Note           sql.NullString
+	Manual         bool
+	TaskID         string
+	UserID         string
+	OrganizationID string
+}
+
+func (q *Queries) CreateWorkSession(ctx context.Context, arg CreateWorkSessionParams) error {
+	_, err := q.db.ExecContext(ctx, createWorkSession,
+		arg.ID,
+		arg.StartedAt,
+		arg.EndedAt,
+		arg.Note,
+		arg.Manual,
+		arg.TaskID,
+		arg.UserID,
+		arg.OrganizationID,
+	)
+	return err
+}
+
+const deleteWorkSession = `-- name: DeleteWorkSession :exec
+DELETE FROM task_work_sessions
+WHERE task_work_sessions.id = ?
+  AND task_work_sessions.task_id IN (
+    SELECT tasks.id
+    FROM tasks
+    WHERE tasks.organization_id = ?
+  )
+`
+
+type DeleteWorkSessionParams struct {
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) DeleteWorkSession(ctx context.Context, arg DeleteWorkSessionParams) error {
+	_, err := q.db.ExecContext(ctx, deleteWorkSession, arg.ID, arg.OrganizationID)
+	return err
+}
+
+const getRunningWorkSessionByUserID = `-- name: GetRunningWorkSessionByUserID :one
+SELECT task_work_sessions.id, task_work_sessions.task_id, task_work_sessions.user_id, task_work_sessions.started_at, task_work_sessions.ended_at, task_work_sessions.note, task_work_sessions.manual, task_work_sessions.created_at, task_work_sessions.updated_at
+FROM task_work_sessions
+  JOIN tasks ON tasks.id = task_work_sessions.task_id
+WHERE task_work_sessions.user_id = ?
+  AND task_work_sessions.ended_at IS NULL
+  AND tasks.organization_id = ?
+LIMIT 1
+`
+
+type GetRunningWorkSessionByUserIDParams struct {
+	UserID         string
+	OrganizationID string
+}
+
+func (q *Queries) GetRunningWorkSessionByUserID(ctx context.Context, arg GetRunningWorkSessionByUserIDParams) (TaskWorkSession, error) {
+	row := q.db.QueryRowContext(ctx, getRunningWorkSessionByUserID, arg.UserID, arg.OrganizationID)
+	var i TaskWorkSession
+	err := row.Scan(
+		&i.ID,
+		&i.TaskID,
+		&i.UserID,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.Note,
+		&i.Manual,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getWorkSessionByID = `-- name: GetWorkSessionByID :one
+SELECT task_work_sessions.id, task_work_sessions.task_id, task_work_sessions.user_id, task_work_sessions.started_at, task_work_sessions.ended_at, task_work_sessions.note, task_work_sessions.manual, task_work_sessions.created_at, task_work_sessions.updated_at
+FROM task_work_sessions
+  JOIN tasks ON tasks.id = task_work_sessions.task_id
+WHERE task_work_sessions.id = ?
+  AND tasks.organization_id = ?
+LIMIT 1
+`
+
+type GetWorkSessionByIDParams struct {
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) GetWorkSessionByID(ctx context.Context, arg GetWorkSessionByIDParams) (TaskWorkSession, error) {
+	row := q.db.QueryRowContext(ctx, getWorkSessionByID, arg.ID, arg.OrganizationID)
+	var i TaskWorkSession
+	err := row.Scan(
+		&i.ID,
+		&i.TaskID,
+		&i.UserID,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.Note,
+		&i.Manual,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listWorkSessionsByTaskID = `-- name: ListWorkSessionsByTaskID :many
+SELECT task_work_sessions.id, task_work_sessions.task_id, task_work_sessions.user_id, task_work_sessions.started_at, task_work_sessions.ended_at, task_work_sessions.note, task_work_sessions.manual, task_work_sessions.created_at, task_work_sessions.updated_at
+FROM task_work_sessions
+  JOIN tasks ON tasks.id = task_work_sessions.task_id
+WHERE task_work_sessions.task_id = ?
+  AND tasks.organization_id = ?
+ORDER BY task_work_sessions.started_at,
+  task_work_sessions.id
+`
+
+type ListWorkSessionsByTaskIDParams struct {
+	TaskID         string
+	OrganizationID string
+}
+
+func (q *Queries) ListWorkSessionsByTaskID(ctx context.Context, arg ListWorkSessionsByTaskIDParams) ([]TaskWorkSession, error) {
+	rows, err := q.db.QueryContext(ctx, listWorkSessionsByTaskID, arg.TaskID, arg.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskWorkSession
+	for rows.Next() {
+		var i TaskWorkSession
+		if err := rows.Scan(
+			&i.ID,
+			&i.TaskID,
+			&i.UserID,
+			&i.StartedAt,
+			&i.EndedAt,
+			&i.Note,
+			&i.Manual,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const sumWorkSessionsByUserID = `-- name: SumWorkSessionsByUserID :many
+SELECT task_work_sessions.user_id,
+  CAST(
+    COALESCE(
+      SUM(
+        TIMESTAMPDIFF(
+          SECOND,
+          task_work_sessions.started_at,
+          COALESCE(task_work_sessions.ended_at, ?)
+        )
+      ),
+      0
+    ) AS SIGNED
+  ) AS seconds
+FROM task_work_sessions
+  JOIN tasks ON tasks.id = task_work_sessions.task_id
+WHERE tasks.organization_id = ?
+  AND tasks.deleted_at IS NULL
+  AND task_work_sessions.started_at >= ?
+  AND task_work_sessions.started_at < ?
+  AND (
+    ? = FALSE
+    OR tasks.project_id IS NULL
+    OR tasks.project_id IN (/*SLICE:scope_project_ids*/?)
+  )
+GROUP BY task_work_sessions.user_id
+ORDER BY task_work_sessions.user_id
+`
+
+type SumWorkSessionsByUserIDParams struct {
+	Now             interface{}
+	OrganizationID  string
+	StartedFrom     time.Time
+	StartedTo       time.Time
+	ProjectScoped   interface{}
+	ScopeProjectIds []sql.NullString
+}
+
+type SumWorkSessionsByUserIDRow struct {
+	UserID  string
+	Seconds int64
+}
+
+func (q *Queries) SumWorkSessionsByUserID(ctx context.Context, arg SumWorkSessionsByUserIDParams) ([]SumWorkSessionsByUserIDRow, error) {
+	query := sumWorkSessionsByUserID
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Now)
+	queryParams = append(queryParams, arg.OrganizationID)
+	queryParams = append(queryParams, arg.StartedFrom)
+	queryParams = append(queryParams, arg.StartedTo)
+	queryParams = append(queryParams, arg.ProjectScoped)
+	if len(arg.ScopeProjectIds) > 0 {
+		for _, v := range arg.ScopeProjectIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:scope_project_ids*/?", strings.Repeat(",?", len(arg.ScopeProjectIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:scope_project_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SumWorkSessionsByUserIDRow
+	for rows.Next() {
+		var i SumWorkSessionsByUserIDRow
+		if err := rows.Scan(&i.UserID, &i.Seconds); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateWorkSession = `-- name: UpdateWorkSession :exec
+UPDATE task_work_sessions
+SET started_at = ?,
+  ended_at = ?,
+  note = ?
+WHERE task_work_sessions.id = ?
+  AND task_work_sessions.task_id IN (
+    SELECT tasks.id
+    FROM tasks
+    WHERE tasks.organization_id = ?
+  )
+`
+
+type UpdateWorkSessionParams struct {
+	StartedAt      time.Time
+	EndedAt        sql.NullTime
+	Note           sql.NullString
+	ID             string
+	OrganizationID string
+}
+
+func (q *Queries) UpdateWorkSession(ctx context.Context, arg UpdateWorkSessionParams) error {
+	_, err := q.db.ExecContext(ctx, updateWorkSession,
+		arg.StartedAt,
+		arg.EndedAt,
+		arg.Note,
+		arg.ID,
+		arg.OrganizationID,
+	)
+	return err
+}
@@ -0,0 +1,32 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+)
+
+type CreateAttachmentParams struct {
+	ID          string `json:"id"`
+	TaskID      string `json:"task_id"`
+	UserID      string `json:"user_id"`
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+}
+
+type AttachmentRepo interface {
+	CreateAttachment(
+		ctx context.Context,
+		params CreateAttachmentParams,
+	) error
+	GetAttachmentByID(
+		ctx context.Context,
+		id string,
+	) (entity.Attachment, error)
+	// ListAttachments lists the attachments of the task, oldest first.
+	ListAttachments(
+		ctx context.Context,
+		taskID string,
+	) ([]entity.Attachment, error)
+}
@@ -0,0 +1,22 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+)
+
+type CalendarFeedRepo interface {
+	GetCalendarFeedTokenByHash(
+		ctx context.Context,
+		tokenHash string,
+	) (entity.CalendarFeedToken, error)
+	// SetCalendarFeedToken replaces the feed token of the user, which
+	// revokes the previous one.
+	SetCalendarFeedToken(
+		ctx context.Context,
+		userID string,
+		tokenHash string,
+	) error
+	DeleteCalendarFeedToken(ctx context.Context, userID string) error
+}
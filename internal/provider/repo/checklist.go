@@ -0,0 +1,52 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+)
+
+type CreateChecklistItemParams struct {
+	ID       string `json:"id"`
+	TaskID   string `json:"task_id"`
+	Position int    `json:"position"`
+	Title    string `json:"title"`
+	Required bool   `json:"required"`
+}
+
+type UpdateChecklistItemParams struct {
+	ID       string     `json:"id"`
+	Title    string     `json:"title"`
+	Required bool       `json:"required"`
+	Done     bool       `json:"done"`
+	DoneAt   *time.Time `json:"done_at"`
+}
+
+type ChecklistRepo interface {
+	CreateChecklistItem(
+		ctx context.Context,
+		params CreateChecklistItemParams,
+	) error
+	GetChecklistItemByID(
+		ctx context.Context,
+		id string,
+	) (entity.ChecklistItem, error)
+	// ListChecklistItems lists the checklist items of the task,
+	// ordered by position.
+	ListChecklistItems(
+		ctx context.Context,
+		taskID string,
+	) ([]entity.ChecklistItem, error)
+	UpdateChecklistItem(
+		ctx context.Context,
+		params UpdateChecklistItemParams,
+	) error
+	DeleteChecklistItem(ctx context.Context, id string) error
+	// CountChecklistItems returns the checklist progress of the tasks by
+	// task ID, tasks without checklist items are left out.
+	CountChecklistItems(
+		ctx context.Context,
+		taskIDs []string,
+	) (map[string]entity.ChecklistProgress, error)
+}
@@ -0,0 +1,26 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+)
+
+type CreateCommentParams struct {
+	ID     string `json:"id"`
+	TaskID string `json:"task_id"`
+	UserID string `json:"user_id"`
+	Body   string `json:"body"`
+}
+
+type CommentRepo interface {
+	CreateComment(
+		ctx context.Context,
+		params CreateCommentParams,
+	) error
+	// ListComments lists the comments of the task, oldest first.
+	ListComments(
+		ctx context.Context,
+		taskID string,
+	) ([]entity.Comment, error)
+}
@@ -0,0 +1,69 @@
+package inmemoryrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+type InMemoryAttachmentRepo struct {
+	Attachments   []entity.Attachment
+	organizations organizationRows
+}
+
+func NewInMemoryAttachmentRepo() *InMemoryAttachmentRepo {
+	return &InMemoryAttachmentRepo{
+		Attachments: []entity.Attachment{},
+	}
+}
+
+func (im *InMemoryAttachmentRepo) CreateAttachment(
+	ctx context.Context,
+	params repo.CreateAttachmentParams,
+) error {
+	attachment := entity.Attachment{}
+	if err := copier.Copy(&attachment, params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	attachment.CreatedAt = time.Now()
+
+	im.Attachments = append(im.Attachments, attachment)
+	im.organizations.add(ctx, attachment.ID)
+
+	return nil
+}
+
+func (im *InMemoryAttachmentRepo) GetAttachmentByID(
+	ctx context.Context,
+	id string,
+) (entity.Attachment, error) {
+	for _, attachment := range im.Attachments {
+		if attachment.ID == id &&
+			im.organizations.contains(ctx, attachment.ID) {
+			return attachment, nil
+		}
+	}
+
+	return entity.Attachment{}, nil
+}
+
+func (im *InMemoryAttachmentRepo) ListAttachments(
+	ctx context.Context,
+	taskID string,
+) ([]entity.Attachment, error) {
+	attachments := []entity.Attachment{}
+	for _, attachment := range im.Attachments {
+		if attachment.TaskID == taskID &&
+			im.organizations.contains(ctx, attachment.ID) {
+			attachments = append(attachments, attachment)
+		}
+	}
+
+	return attachments, nil
+}
+
+var _ repo.AttachmentRepo = (*InMemoryAttachmentRepo)(nil)
@@ -0,0 +1,71 @@
+package inmemoryrepo
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type InMemoryCalendarFeedRepo struct {
+	Tokens        []entity.CalendarFeedToken
+	organizations organizationRows
+}
+
+func NewInMemoryCalendarFeedRepo() *InMemoryCalendarFeedRepo {
+	return &InMemoryCalendarFeedRepo{
+		Tokens: []entity.CalendarFeedToken{},
+	}
+}
+
+func (im *InMemoryCalendarFeedRepo) GetCalendarFeedTokenByHash(
+	ctx context.Context,
+	tokenHash string,
+) (entity.CalendarFeedToken, error) {
+	for _, token := range im.Tokens {
+		if token.TokenHash == tokenHash &&
+			im.organizations.contains(ctx, token.UserID) {
+			return token, nil
+		}
+	}
+
+	return entity.CalendarFeedToken{}, nil
+}
+
+func (im *InMemoryCalendarFeedRepo) SetCalendarFeedToken(
+	ctx context.Context,
+	userID string,
+	tokenHash string,
+) error {
+	if err := im.DeleteCalendarFeedToken(ctx, userID); err != nil {
+		return err
+	}
+
+	im.Tokens = append(im.Tokens, entity.CalendarFeedToken{
+		UserID:    userID,
+		TokenHash: tokenHash,
+		CreatedAt: time.Now(),
+	})
+	im.organizations.add(ctx, userID)
+
+	return nil
+}
+
+func (im *InMemoryCalendarFeedRepo) DeleteCalendarFeedToken(
+	ctx context.Context,
+	userID string,
+) error {
+	im.Tokens = slices.DeleteFunc(
+		im.Tokens,
+		func(token entity.CalendarFeedToken) bool {
+			return token.UserID == userID &&
+				im.organizations.contains(ctx, token.UserID)
+		},
+	)
+
+	return nil
+}
+
+var _ repo.CalendarFeedRepo = (*InMemoryCalendarFeedRepo)(nil)
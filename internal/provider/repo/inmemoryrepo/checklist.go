@@ -0,0 +1,129 @@
+package inmemoryrepo
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+type InMemoryChecklistRepo struct {
+	Items         []entity.ChecklistItem
+	organizations organizationRows
+}
+
+func NewInMemoryChecklistRepo() *InMemoryChecklistRepo {
+	return &InMemoryChecklistRepo{
+		Items: []entity.ChecklistItem{},
+	}
+}
+
+func (im *InMemoryChecklistRepo) CreateChecklistItem(
+	ctx context.Context,
+	params repo.CreateChecklistItemParams,
+) error {
+	item := entity.ChecklistItem{}
+	if err := copier.Copy(&item, params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	item.CreatedAt = time.Now()
+	item.UpdatedAt = time.Now()
+
+	im.Items = append(im.Items, item)
+	im.organizations.add(ctx, item.ID)
+
+	return nil
+}
+
+func (im *InMemoryChecklistRepo) GetChecklistItemByID(
+	ctx context.Context,
+	id string,
+) (entity.ChecklistItem, error) {
+	for _, item := range im.Items {
+		if item.ID == id && im.organizations.contains(ctx, item.ID) {
+			return item, nil
+		}
+	}
+
+	return entity.ChecklistItem{}, nil
+}
+
+func (im *InMemoryChecklistRepo) ListChecklistItems(
+	ctx context.Context,
+	taskID string,
+) ([]entity.ChecklistItem, error) {
+	items := []entity.ChecklistItem{}
+	for _, item := range im.Items {
+		if item.TaskID == taskID && im.organizations.contains(ctx, item.ID) {
+			items = append(items, item)
+		}
+	}
+
+	slices.SortStableFunc(items, func(a, b entity.ChecklistItem) int {
+		return cmp.Compare(a.Position, b.Position)
+	})
+
+	return items, nil
+}
+
+func (im *InMemoryChecklistRepo) UpdateChecklistItem(
+	ctx context.Context,
+	params repo.UpdateChecklistItemParams,
+) error {
+	for i, item := range im.Items {
+		if item.ID != params.ID || !im.organizations.contains(ctx, item.ID) {
+			continue
+		}
+
+		item.Title = params.Title
+		item.Required = params.Required
+		item.Done = params.Done
+		item.DoneAt = params.DoneAt
+		item.UpdatedAt = time.Now()
+
+		im.Items[i] = item
+		break
+	}
+
+	return nil
+}
+
+func (im *InMemoryChecklistRepo) DeleteChecklistItem(
+	ctx context.Context,
+	id string,
+) error {
+	im.Items = slices.DeleteFunc(im.Items, func(item entity.ChecklistItem) bool {
+		return item.ID == id && im.organizations.contains(ctx, item.ID)
+	})
+
+	return nil
+}
+
+func (im *InMemoryChecklistRepo) CountChecklistItems(
+	ctx context.Context,
+	taskIDs []string,
+) (map[string]entity.ChecklistProgress, error) {
+	progressByTaskID := map[string]entity.ChecklistProgress{}
+	for _, item := range im.Items {
+		if !slices.Contains(taskIDs, item.TaskID) ||
+			!im.organizations.contains(ctx, item.ID) {
+			continue
+		}
+
+		progress := progressByTaskID[item.TaskID]
+		progress.Total++
+		if item.Done {
+			progress.Done++
+		}
+		progressByTaskID[item.TaskID] = progress
+	}
+
+	return progressByTaskID, nil
+}
+
+var _ repo.ChecklistRepo = (*InMemoryChecklistRepo)(nil)
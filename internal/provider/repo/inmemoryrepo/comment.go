@@ -0,0 +1,56 @@
+package inmemoryrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+type InMemoryCommentRepo struct {
+	Comments      []entity.Comment
+	organizations organizationRows
+}
+
+func NewInMemoryCommentRepo() *InMemoryCommentRepo {
+	return &InMemoryCommentRepo{
+		Comments: []entity.Comment{},
+	}
+}
+
+func (im *InMemoryCommentRepo) CreateComment(
+	ctx context.Context,
+	params repo.CreateCommentParams,
+) error {
+	comment := entity.Comment{}
+	if err := copier.Copy(&comment, params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	comment.CreatedAt = time.Now()
+	comment.UpdatedAt = time.Now()
+
+	im.Comments = append(im.Comments, comment)
+	im.organizations.add(ctx, comment.ID)
+
+	return nil
+}
+
+func (im *InMemoryCommentRepo) ListComments(
+	ctx context.Context,
+	taskID string,
+) ([]entity.Comment, error) {
+	comments := []entity.Comment{}
+	for _, comment := range im.Comments {
+		if comment.TaskID == taskID &&
+			im.organizations.contains(ctx, comment.ID) {
+			comments = append(comments, comment)
+		}
+	}
+
+	return comments, nil
+}
+
+var _ repo.CommentRepo = (*InMemoryCommentRepo)(nil)
@@ -0,0 +1,18 @@
+package inmemoryrepo
+
+import (
+	"testing"
+
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/repotest"
+)
+
+func TestInMemoryRepos_TenantIsolation(t *testing.T) {
+	userRepo := NewInMemoryUserRepo()
+
+	repotest.RunTenantIsolation(t, repotest.Repos{
+		Organizations: NewInMemoryOrganizationRepo(userRepo, nil),
+		Users:         userRepo,
+		Labels:        NewInMemoryLabelRepo(),
+		Tasks:         NewInMemoryTaskRepo(),
+	})
+}
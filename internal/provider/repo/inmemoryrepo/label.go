@@ -0,0 +1,108 @@
+package inmemoryrepo
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+// InMemoryLabelRepo only holds the labels, the labels of each task are
+// kept by InMemoryTaskRepo. Unlike the database, deleting a label does
+// not remove it from the tasks.
+type InMemoryLabelRepo struct {
+	Labels []entity.Label
+}
+
+func NewInMemoryLabelRepo() *InMemoryLabelRepo {
+	return &InMemoryLabelRepo{
+		Labels: []entity.Label{},
+	}
+}
+
+func (im *InMemoryLabelRepo) CreateLabel(
+	ctx context.Context,
+	params repo.CreateLabelParams,
+) error {
+	im.Labels = append(im.Labels, entity.Label{
+		ID:             params.ID,
+		OrganizationID: organizationID(ctx),
+		Name:           params.Name,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	})
+
+	return nil
+}
+
+func (im *InMemoryLabelRepo) GetLabelByID(
+	ctx context.Context,
+	id string,
+) (entity.Label, error) {
+	for _, label := range im.Labels {
+		if label.ID == id && label.OrganizationID == organizationID(ctx) {
+			return label, nil
+		}
+	}
+
+	return entity.Label{}, nil
+}
+
+func (im *InMemoryLabelRepo) GetLabelByName(
+	ctx context.Context,
+	name string,
+) (entity.Label, error) {
+	for _, label := range im.Labels {
+		if label.Name == name && label.OrganizationID == organizationID(ctx) {
+			return label, nil
+		}
+	}
+
+	return entity.Label{}, nil
+}
+
+func (im *InMemoryLabelRepo) ListLabels(
+	ctx context.Context,
+) ([]entity.Label, error) {
+	labels := []entity.Label{}
+	for _, label := range im.Labels {
+		if label.OrganizationID == organizationID(ctx) {
+			labels = append(labels, label)
+		}
+	}
+
+	slices.SortFunc(labels, func(a, b entity.Label) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return labels, nil
+}
+
+func (im *InMemoryLabelRepo) UpdateLabel(
+	ctx context.Context,
+	params repo.UpdateLabelParams,
+) error {
+	for i, label := range im.Labels {
+		if label.ID == params.ID &&
+			label.OrganizationID == organizationID(ctx) {
+			im.Labels[i].Name = params.Name
+			im.Labels[i].UpdatedAt = time.Now()
+			break
+		}
+	}
+
+	return nil
+}
+
+func (im *InMemoryLabelRepo) DeleteLabel(ctx context.Context, id string) error {
+	im.Labels = slices.DeleteFunc(im.Labels, func(label entity.Label) bool {
+		return label.ID == id && label.OrganizationID == organizationID(ctx)
+	})
+
+	return nil
+}
+
+var _ repo.LabelRepo = (*InMemoryLabelRepo)(nil)
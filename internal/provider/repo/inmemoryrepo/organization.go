@@ -0,0 +1,125 @@
+package inmemoryrepo
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+// InMemoryOrganizationRepo finds the organization of users and calendar
+// feed tokens in the user and calendar feed repositories it is given, the
+// way the MySQL repository joins their tables.
+type InMemoryOrganizationRepo struct {
+	Organizations    []entity.Organization
+	userRepo         *InMemoryUserRepo
+	calendarFeedRepo *InMemoryCalendarFeedRepo
+}
+
+func NewInMemoryOrganizationRepo(
+	userRepo *InMemoryUserRepo,
+	calendarFeedRepo *InMemoryCalendarFeedRepo,
+) *InMemoryOrganizationRepo {
+	return &InMemoryOrganizationRepo{
+		Organizations:    []entity.Organization{},
+		userRepo:         userRepo,
+		calendarFeedRepo: calendarFeedRepo,
+	}
+}
+
+func (im *InMemoryOrganizationRepo) CreateOrganization(
+	ctx context.Context,
+	params repo.CreateOrganizationParams,
+) error {
+	im.Organizations = append(im.Organizations, entity.Organization{
+		ID:        params.ID,
+		Name:      params.Name,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	})
+
+	return nil
+}
+
+func (im *InMemoryOrganizationRepo) GetOrganizationByID(
+	ctx context.Context,
+	id string,
+) (entity.Organization, error) {
+	for _, organization := range im.Organizations {
+		if organization.ID == id {
+			return organization, nil
+		}
+	}
+
+	return entity.Organization{}, nil
+}
+
+func (im *InMemoryOrganizationRepo) ListOrganizations(
+	ctx context.Context,
+) ([]entity.Organization, error) {
+	organizations := slices.Clone(im.Organizations)
+	slices.SortFunc(
+		organizations,
+		func(a, b entity.Organization) int {
+			return strings.Compare(a.Name, b.Name)
+		},
+	)
+
+	return organizations, nil
+}
+
+func (im *InMemoryOrganizationRepo) GetOrganizationIDByUserID(
+	ctx context.Context,
+	userID string,
+) (string, error) {
+	if im.userRepo == nil {
+		return "", nil
+	}
+
+	for _, user := range im.userRepo.Users {
+		if user.ID == userID {
+			return user.OrganizationID, nil
+		}
+	}
+
+	return "", nil
+}
+
+func (im *InMemoryOrganizationRepo) GetOrganizationIDByUserEmail(
+	ctx context.Context,
+	email string,
+) (string, error) {
+	if im.userRepo == nil {
+		return "", nil
+	}
+
+	for _, user := range im.userRepo.Users {
+		if user.Email == email {
+			return user.OrganizationID, nil
+		}
+	}
+
+	return "", nil
+}
+
+func (im *InMemoryOrganizationRepo) GetOrganizationIDByCalendarFeedTokenHash(
+	ctx context.Context,
+	tokenHash string,
+) (string, error) {
+	if im.calendarFeedRepo == nil {
+		return "", nil
+	}
+
+	for _, token := range im.calendarFeedRepo.Tokens {
+		if token.TokenHash == tokenHash {
+			return im.GetOrganizationIDByUserID(ctx, token.UserID)
+		}
+	}
+
+	return "", nil
+}
+
+var _ repo.OrganizationRepo = (*InMemoryOrganizationRepo)(nil)
@@ -0,0 +1,219 @@
+package inmemoryrepo
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+// InMemoryProjectRepo does not know the tasks, so ProjectHasTasks
+// reports the IDs in TaskProjectIDs instead. Members belong to the
+// organization of their project.
+type InMemoryProjectRepo struct {
+	Projects []entity.Project
+	Members  []entity.ProjectMember
+	// TaskProjectIDs are the projects that have tasks.
+	TaskProjectIDs []string
+}
+
+func NewInMemoryProjectRepo() *InMemoryProjectRepo {
+	return &InMemoryProjectRepo{
+		Projects:       []entity.Project{},
+		Members:        []entity.ProjectMember{},
+		TaskProjectIDs: []string{},
+	}
+}
+
+func (im *InMemoryProjectRepo) CreateProject(
+	ctx context.Context,
+	params repo.CreateProjectParams,
+) error {
+	im.Projects = append(im.Projects, entity.Project{
+		ID:             params.ID,
+		OrganizationID: organizationID(ctx),
+		Name:           params.Name,
+		Description:    params.Description,
+		ManagerUserID:  params.ManagerUserID,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	})
+
+	return nil
+}
+
+func (im *InMemoryProjectRepo) GetProjectByID(
+	ctx context.Context,
+	id string,
+) (entity.Project, error) {
+	for _, project := range im.Projects {
+		if project.ID == id && project.OrganizationID == organizationID(ctx) {
+			return project, nil
+		}
+	}
+
+	return entity.Project{}, nil
+}
+
+func (im *InMemoryProjectRepo) ListProjectsByMemberUserID(
+	ctx context.Context,
+	userID string,
+) ([]entity.Project, error) {
+	projects := []entity.Project{}
+	for _, project := range im.Projects {
+		if project.OrganizationID == organizationID(ctx) &&
+			im.isMember(project.ID, userID) {
+			projects = append(projects, project)
+		}
+	}
+
+	slices.SortFunc(projects, func(a, b entity.Project) int {
+		return cmp.Or(
+			strings.Compare(a.Name, b.Name),
+			strings.Compare(a.ID, b.ID),
+		)
+	})
+
+	return projects, nil
+}
+
+func (im *InMemoryProjectRepo) UpdateProject(
+	ctx context.Context,
+	params repo.UpdateProjectParams,
+) error {
+	for i, project := range im.Projects {
+		if project.ID == params.ID &&
+			project.OrganizationID == organizationID(ctx) {
+			im.Projects[i].Name = params.Name
+			im.Projects[i].Description = params.Description
+			im.Projects[i].ManagerUserID = params.ManagerUserID
+			im.Projects[i].UpdatedAt = time.Now()
+			break
+		}
+	}
+
+	return nil
+}
+
+func (im *InMemoryProjectRepo) DeleteProject(ctx context.Context, id string) error {
+	if !im.exists(ctx, id) {
+		return nil
+	}
+
+	im.Projects = slices.DeleteFunc(
+		im.Projects,
+		func(project entity.Project) bool {
+			return project.ID == id
+		},
+	)
+	im.Members = slices.DeleteFunc(
+		im.Members,
+		func(member entity.ProjectMember) bool {
+			return member.ProjectID == id
+		},
+	)
+
+	return nil
+}
+
+func (im *InMemoryProjectRepo) ProjectHasTasks(
+	ctx context.Context,
+	id string,
+) (bool, error) {
+	return im.exists(ctx, id) && slices.Contains(im.TaskProjectIDs, id), nil
+}
+
+func (im *InMemoryProjectRepo) AddProjectMember(
+	ctx context.Context,
+	projectID string,
+	userID string,
+) error {
+	if !im.exists(ctx, projectID) || im.isMember(projectID, userID) {
+		return nil
+	}
+
+	im.Members = append(im.Members, entity.ProjectMember{
+		ProjectID: projectID,
+		UserID:    userID,
+		CreatedAt: time.Now(),
+	})
+
+	return nil
+}
+
+func (im *InMemoryProjectRepo) RemoveProjectMember(
+	ctx context.Context,
+	projectID string,
+	userID string,
+) error {
+	if !im.exists(ctx, projectID) {
+		return nil
+	}
+
+	im.Members = slices.DeleteFunc(
+		im.Members,
+		func(member entity.ProjectMember) bool {
+			return member.ProjectID == projectID && member.UserID == userID
+		},
+	)
+
+	return nil
+}
+
+func (im *InMemoryProjectRepo) ListProjectMembers(
+	ctx context.Context,
+	projectID string,
+) ([]entity.ProjectMember, error) {
+	members := []entity.ProjectMember{}
+	if !im.exists(ctx, projectID) {
+		return members, nil
+	}
+
+	for _, member := range im.Members {
+		if member.ProjectID == projectID {
+			members = append(members, member)
+		}
+	}
+
+	return members, nil
+}
+
+func (im *InMemoryProjectRepo) GetProjectMember(
+	ctx context.Context,
+	projectID string,
+	userID string,
+) (entity.ProjectMember, error) {
+	if !im.exists(ctx, projectID) {
+		return entity.ProjectMember{}, nil
+	}
+
+	for _, member := range im.Members {
+		if member.ProjectID == projectID && member.UserID == userID {
+			return member, nil
+		}
+	}
+
+	return entity.ProjectMember{}, nil
+}
+
+// exists reports whether the project belongs to the organization ctx
+// acts on.
+func (im *InMemoryProjectRepo) exists(ctx context.Context, id string) bool {
+	project, _ := im.GetProjectByID(ctx, id)
+	return project.ID != ""
+}
+
+func (im *InMemoryProjectRepo) isMember(projectID, userID string) bool {
+	return slices.ContainsFunc(
+		im.Members,
+		func(member entity.ProjectMember) bool {
+			return member.ProjectID == projectID && member.UserID == userID
+		},
+	)
+}
+
+var _ repo.ProjectRepo = (*InMemoryProjectRepo)(nil)
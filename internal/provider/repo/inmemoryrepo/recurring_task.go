@@ -0,0 +1,154 @@
+package inmemoryrepo
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type InMemoryRecurringTaskRepo struct {
+	RecurringTasks []entity.RecurringTask
+	// Occurrences holds the claimed occurrences of each recurring task by
+	// recurring task ID.
+	Occurrences map[string][]time.Time
+}
+
+func NewInMemoryRecurringTaskRepo() *InMemoryRecurringTaskRepo {
+	return &InMemoryRecurringTaskRepo{
+		RecurringTasks: []entity.RecurringTask{},
+		Occurrences:    map[string][]time.Time{},
+	}
+}
+
+func (im *InMemoryRecurringTaskRepo) CreateRecurringTask(
+	ctx context.Context,
+	params repo.CreateRecurringTaskParams,
+) error {
+	im.RecurringTasks = append(im.RecurringTasks, entity.RecurringTask{
+		ID:               params.ID,
+		OrganizationID:   organizationID(ctx),
+		Summary:          params.Summary,
+		Schedule:         params.Schedule,
+		AssignedToUserID: params.AssignedToUserID,
+		CreatedByUserID:  params.CreatedByUserID,
+		NextRunAt:        params.NextRunAt,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	})
+
+	return nil
+}
+
+func (im *InMemoryRecurringTaskRepo) GetRecurringTaskByID(
+	ctx context.Context,
+	id string,
+) (entity.RecurringTask, error) {
+	for _, recurringTask := range im.RecurringTasks {
+		if recurringTask.ID == id &&
+			recurringTask.OrganizationID == organizationID(ctx) {
+			return recurringTask, nil
+		}
+	}
+
+	return entity.RecurringTask{}, nil
+}
+
+func (im *InMemoryRecurringTaskRepo) ListRecurringTasks(
+	ctx context.Context,
+) ([]entity.RecurringTask, error) {
+	recurringTasks := []entity.RecurringTask{}
+	for _, recurringTask := range im.RecurringTasks {
+		if recurringTask.OrganizationID == organizationID(ctx) {
+			recurringTasks = append(recurringTasks, recurringTask)
+		}
+	}
+
+	return recurringTasks, nil
+}
+
+func (im *InMemoryRecurringTaskRepo) ListDueRecurringTasks(
+	ctx context.Context,
+	now time.Time,
+) ([]entity.RecurringTask, error) {
+	recurringTasks := []entity.RecurringTask{}
+	for _, recurringTask := range im.RecurringTasks {
+		if !recurringTask.NextRunAt.After(now) &&
+			recurringTask.OrganizationID == organizationID(ctx) {
+			recurringTasks = append(recurringTasks, recurringTask)
+		}
+	}
+
+	slices.SortFunc(recurringTasks, func(a, b entity.RecurringTask) int {
+		return a.NextRunAt.Compare(b.NextRunAt)
+	})
+
+	return recurringTasks, nil
+}
+
+func (im *InMemoryRecurringTaskRepo) UpdateRecurringTaskNextRunAt(
+	ctx context.Context,
+	id string,
+	nextRunAt time.Time,
+) error {
+	for i, recurringTask := range im.RecurringTasks {
+		if recurringTask.ID == id &&
+			recurringTask.OrganizationID == organizationID(ctx) {
+			im.RecurringTasks[i].NextRunAt = nextRunAt
+			im.RecurringTasks[i].UpdatedAt = time.Now()
+			break
+		}
+	}
+
+	return nil
+}
+
+func (im *InMemoryRecurringTaskRepo) DeleteRecurringTask(
+	ctx context.Context,
+	id string,
+) error {
+	if !im.exists(ctx, id) {
+		return nil
+	}
+
+	im.RecurringTasks = slices.DeleteFunc(
+		im.RecurringTasks,
+		func(recurringTask entity.RecurringTask) bool {
+			return recurringTask.ID == id
+		},
+	)
+	delete(im.Occurrences, id)
+
+	return nil
+}
+
+func (im *InMemoryRecurringTaskRepo) ClaimRecurringTaskOccurrence(
+	ctx context.Context,
+	id string,
+	scheduledAt time.Time,
+) (bool, error) {
+	if !im.exists(ctx, id) {
+		return false, nil
+	}
+
+	for _, occurrence := range im.Occurrences[id] {
+		if occurrence.Equal(scheduledAt) {
+			return false, nil
+		}
+	}
+
+	im.Occurrences[id] = append(im.Occurrences[id], scheduledAt)
+
+	return true, nil
+}
+
+// exists reports whether the recurring task belongs to the organization
+// ctx acts on.
+func (im *InMemoryRecurringTaskRepo) exists(ctx context.Context, id string) bool {
+	recurringTask, _ := im.GetRecurringTaskByID(ctx, id)
+	return recurringTask.ID != ""
+}
+
+var _ repo.RecurringTaskRepo = (*InMemoryRecurringTaskRepo)(nil)
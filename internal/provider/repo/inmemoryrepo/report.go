@@ -0,0 +1,140 @@
+package inmemoryrepo
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+// InMemoryReportRepo aggregates the tasks of the task repository it is
+// given, the way the MySQL repository aggregates their table.
+type InMemoryReportRepo struct {
+	taskRepo *InMemoryTaskRepo
+}
+
+func NewInMemoryReportRepo(taskRepo *InMemoryTaskRepo) *InMemoryReportRepo {
+	return &InMemoryReportRepo{
+		taskRepo: taskRepo,
+	}
+}
+
+// reportKey identifies a report row.
+type reportKey struct {
+	bucketStart time.Time
+	userID      string
+}
+
+func (im *InMemoryReportRepo) GetTaskReport(
+	ctx context.Context,
+	params repo.TaskReportParams,
+) ([]entity.TaskReportRow, error) {
+	rowsByKey := map[reportKey]*entity.TaskReportRow{}
+	finishSecondsByKey := map[reportKey][]int64{}
+
+	row := func(key reportKey) *entity.TaskReportRow {
+		if _, ok := rowsByKey[key]; !ok {
+			rowsByKey[key] = &entity.TaskReportRow{
+				BucketStart: key.bucketStart,
+				UserID:      key.userID,
+			}
+		}
+		return rowsByKey[key]
+	}
+
+	for _, task := range im.taskRepo.tasks(ctx) {
+		if task.DeletedAt != nil || !inReportScope(task, params) {
+			continue
+		}
+
+		userIDs := []string{task.CreatedByUserID}
+		if params.GroupBy != entity.ReportGroupByCreator {
+			userIDs = withAssignees(task).AssigneeIDs
+		}
+
+		for _, userID := range userIDs {
+			if inReportPeriod(task.CreatedAt, params) {
+				key := reportKey{
+					bucketStart: reportBucketStart(task.CreatedAt, params.Bucket),
+					userID:      userID,
+				}
+				row(key).CreatedCount++
+			}
+
+			if task.FinishedAt != nil &&
+				inReportPeriod(*task.FinishedAt, params) {
+				key := reportKey{
+					bucketStart: reportBucketStart(
+						*task.FinishedAt,
+						params.Bucket,
+					),
+					userID: userID,
+				}
+				row(key).FinishedCount++
+				finishSecondsByKey[key] = append(
+					finishSecondsByKey[key],
+					int64(task.FinishedAt.Sub(task.CreatedAt).Seconds()),
+				)
+			}
+		}
+	}
+
+	rows := make([]entity.TaskReportRow, 0, len(rowsByKey))
+	for key, row := range rowsByKey {
+		if finishSeconds := finishSecondsByKey[key]; len(finishSeconds) > 0 {
+			slices.Sort(finishSeconds)
+			row.MedianFinishSeconds = nearestRank(finishSeconds, 50)
+			row.P90FinishSeconds = nearestRank(finishSeconds, 90)
+		}
+		rows = append(rows, *row)
+	}
+
+	slices.SortFunc(rows, func(a, b entity.TaskReportRow) int {
+		return cmp.Or(
+			a.BucketStart.Compare(b.BucketStart),
+			strings.Compare(a.UserID, b.UserID),
+		)
+	})
+
+	return rows, nil
+}
+
+func inReportScope(task entity.Task, params repo.TaskReportParams) bool {
+	return !params.ProjectScoped || task.ProjectID == nil ||
+		slices.Contains(params.ScopeProjectIDs, *task.ProjectID)
+}
+
+func inReportPeriod(date time.Time, params repo.TaskReportParams) bool {
+	return !date.Before(params.From) && date.Before(params.To)
+}
+
+// reportBucketStart returns the start of the bucket of the date in UTC,
+// where weeks start on Monday.
+func reportBucketStart(date time.Time, bucket entity.ReportBucket) time.Time {
+	year, month, day := date.UTC().Date()
+	start := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	switch bucket {
+	case entity.ReportBucketWeek:
+		daysSinceMonday := (int(start.Weekday()) + 6) % 7
+		return start.AddDate(0, 0, -daysSinceMonday)
+	case entity.ReportBucketMonth:
+		return start.AddDate(0, 0, 1-day)
+	default:
+		return start
+	}
+}
+
+// nearestRank returns the percentile of the sorted values, which is the
+// smallest value whose rank is at least the percentile of their count.
+func nearestRank(sorted []int64, percentile int) *int64 {
+	rank := (percentile*len(sorted) + 99) / 100
+	value := sorted[max(rank, 1)-1]
+	return &value
+}
+
+var _ repo.ReportRepo = (*InMemoryReportRepo)(nil)
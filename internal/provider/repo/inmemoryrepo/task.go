@@ -1,33 +1,54 @@
 package inmemoryrepo
 
 import (
+	"cmp"
 	"context"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
 	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
-	"github.com/google/uuid"
 	"github.com/jinzhu/copier"
 )
 
 type InMemoryTaskRepo struct {
 	Tasks []entity.Task
+	// LabelIDs holds the label IDs of the tasks by task ID.
+	LabelIDs map[string][]string
+	// OverdueNotifiedAt holds when the tasks were notified as overdue
+	// by task ID.
+	OverdueNotifiedAt map[string]time.Time
 }
 
 func NewInMemoryTaskRepo() *InMemoryTaskRepo {
 	return &InMemoryTaskRepo{
-		Tasks: []entity.Task{},
+		Tasks:             []entity.Task{},
+		LabelIDs:          map[string][]string{},
+		OverdueNotifiedAt: map[string]time.Time{},
 	}
 }
 
 func (im *InMemoryTaskRepo) GetTaskByID(
-	_ context.Context,
+	ctx context.Context,
 	id string,
 ) (entity.Task, error) {
-	for _, task := range im.Tasks {
-		if task.ID == id {
-			return task, nil
+	for _, task := range im.tasks(ctx) {
+		if task.ID == id && task.DeletedAt == nil {
+			return withAssignees(task), nil
+		}
+	}
+
+	return entity.Task{}, nil
+}
+
+func (im *InMemoryTaskRepo) GetDeletedTaskByID(
+	ctx context.Context,
+	id string,
+) (entity.Task, error) {
+	for _, task := range im.tasks(ctx) {
+		if task.ID == id && task.DeletedAt != nil {
+			return withAssignees(task), nil
 		}
 	}
 
@@ -35,30 +56,172 @@ func (im *InMemoryTaskRepo) GetTaskByID(
 }
 
 func (im *InMemoryTaskRepo) ListTasks(
-	_ context.Context,
+	ctx context.Context,
 	opts ...repo.ListTasksOption,
 ) ([]entity.Task, error) {
-	params := repo.ListTasksParams{}
-	for _, opt := range opts {
-		opt(&params)
+	params := repo.NewListTasksParams(opts...)
+
+	tasks := []entity.Task{}
+	for _, task := range im.tasks(ctx) {
+		task = withAssignees(task)
+		if !matchesListTasksFilters(task, params) ||
+			!matchesLabels(im.LabelIDs[task.ID], params) {
+			continue
+		}
+
+		if params.After != nil &&
+			compareTaskToCursor(task, *params.After, params) <= 0 {
+			continue
+		}
+
+		if params.Before != nil &&
+			compareTaskToCursor(task, *params.Before, params) >= 0 {
+			continue
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	slices.SortFunc(tasks, func(a, b entity.Task) int {
+		return compareTaskToCursor(
+			a,
+			repo.NewTaskCursor(params.SortBy, b),
+			params,
+		)
+	})
+
+	if params.Limit > 0 && len(tasks) > params.Limit {
+		if params.Before != nil {
+			tasks = tasks[len(tasks)-params.Limit:]
+		} else {
+			tasks = tasks[:params.Limit]
+		}
+	}
+
+	return tasks, nil
+}
+
+func matchesListTasksFilters(
+	task entity.Task,
+	params repo.ListTasksParams,
+) bool {
+	if params.Deleted != (task.DeletedAt != nil) {
+		return false
+	}
+
+	if params.AssignedToUserID != "" &&
+		!task.IsAssignedTo(params.AssignedToUserID) {
+		return false
+	}
+
+	if params.Unassigned && len(task.AssigneeIDs) > 0 {
+		return false
+	}
+
+	if params.CreatedByUserID != "" &&
+		task.CreatedByUserID != params.CreatedByUserID {
+		return false
+	}
+
+	if params.Finished != nil && *params.Finished != (task.FinishedAt != nil) {
+		return false
+	}
+
+	if params.CreatedFrom != nil && task.CreatedAt.Before(*params.CreatedFrom) {
+		return false
+	}
+
+	if params.CreatedTo != nil && task.CreatedAt.After(*params.CreatedTo) {
+		return false
+	}
+
+	if params.FinishedFrom != nil &&
+		(task.FinishedAt == nil || task.FinishedAt.Before(*params.FinishedFrom)) {
+		return false
+	}
+
+	if params.FinishedTo != nil &&
+		(task.FinishedAt == nil || task.FinishedAt.After(*params.FinishedTo)) {
+		return false
+	}
+
+	if params.DueFrom != nil &&
+		(task.DueAt == nil || task.DueAt.Before(*params.DueFrom)) {
+		return false
+	}
+
+	if params.DueTo != nil &&
+		(task.DueAt == nil || task.DueAt.After(*params.DueTo)) {
+		return false
+	}
+
+	if params.OverdueAt != nil && !task.IsOverdue(*params.OverdueAt) {
+		return false
+	}
+
+	if len(params.Priorities) > 0 &&
+		!slices.Contains(params.Priorities, task.Priority) {
+		return false
+	}
+
+	if params.ProjectID != "" &&
+		(task.ProjectID == nil || *task.ProjectID != params.ProjectID) {
+		return false
+	}
+
+	if params.ProjectScoped && task.ProjectID != nil &&
+		!slices.Contains(params.ScopeProjectIDs, *task.ProjectID) {
+		return false
+	}
+
+	return true
+}
+
+func matchesLabels(taskLabelIDs []string, params repo.ListTasksParams) bool {
+	if len(params.LabelIDs) == 0 {
+		return true
 	}
 
-	if params.AssignedToUserID != "" {
-		var tasks []entity.Task
-		for _, task := range im.Tasks {
-			if task.AssignedToUserID != nil &&
-				*task.AssignedToUserID == params.AssignedToUserID {
-				tasks = append(tasks, task)
-			}
+	for _, labelID := range params.LabelIDs {
+		hasLabel := slices.Contains(taskLabelIDs, labelID)
+		if params.LabelMatch == repo.LabelMatchAll && !hasLabel {
+			return false
+		}
+
+		if params.LabelMatch != repo.LabelMatchAll && hasLabel {
+			return true
 		}
-		return tasks, nil
 	}
 
-	return im.Tasks, nil
+	return params.LabelMatch == repo.LabelMatchAll
+}
+
+// compareTaskToCursor compares the task position in the listing
+// order against the cursor, returning -1, 0 or 1.
+func compareTaskToCursor(
+	task entity.Task,
+	cursor repo.TaskCursor,
+	params repo.ListTasksParams,
+) int {
+	var c int
+	if params.SortBy == repo.TaskSortByPriority {
+		c = cmp.Compare(params.SortBy.SortRank(task), cursor.Rank)
+	} else {
+		c = params.SortBy.SortValue(task).Compare(cursor.Value)
+	}
+	if c == 0 {
+		c = strings.Compare(task.ID, cursor.ID)
+	}
+
+	if params.SortDirection == repo.SortDesc {
+		return -c
+	}
+
+	return c
 }
 
 func (im *InMemoryTaskRepo) CreateTask(
-	_ context.Context,
+	ctx context.Context,
 	params repo.CreateTaskParams,
 ) error {
 	task := entity.Task{}
@@ -66,9 +229,19 @@ func (im *InMemoryTaskRepo) CreateTask(
 		return entity.NewErr(err)
 	}
 
-	task.ID = uuid.NewString()
+	task.OrganizationID = organizationID(ctx)
+	task.Status = entity.TaskStatusOpen
+	if task.Priority == "" {
+		task.Priority = entity.TaskPriorityNormal
+	}
+	if task.FinishPolicy == "" {
+		task.FinishPolicy = entity.TaskFinishPolicyAny
+	}
+	task.AssigneeIDs = slices.Clone(params.AssigneeIDs)
+	task = withAssignees(task)
 	task.CreatedAt = time.Now()
 	task.UpdatedAt = time.Now()
+	task.Version = 1
 
 	im.Tasks = append(im.Tasks, task)
 
@@ -76,35 +249,181 @@ func (im *InMemoryTaskRepo) CreateTask(
 }
 
 func (im *InMemoryTaskRepo) UpdateTask(
-	_ context.Context,
+	ctx context.Context,
 	params repo.UpdateTaskParams,
 ) error {
 	for i, task := range im.Tasks {
-		if task.ID != params.ID {
+		if task.ID != params.ID || task.DeletedAt != nil ||
+			!belongs(ctx, task) || task.Version != params.Version {
 			continue
 		}
 
-		if err := copier.CopyWithOption(
-			&task,
-			params,
-			copier.Option{IgnoreEmpty: true},
-		); err != nil {
-			return entity.NewErr(err)
-		}
-
+		task.Summary = params.Summary
+		task.Status = params.Status
+		task.FinishedAt = params.FinishedAt
+		task.ReopenReason = params.ReopenReason
+		task.ReopenedAt = params.ReopenedAt
+		task.Priority = params.Priority
+		task.FinishPolicy = params.FinishPolicy
+		task.ProjectID = params.ProjectID
 		task.UpdatedAt = time.Now()
 
+		if !equalTimes(task.DueAt, params.DueAt) {
+			delete(im.OverdueNotifiedAt, task.ID)
+		}
+		task.DueAt = params.DueAt
+		task.Version++
+
 		im.Tasks[i] = task
+		return nil
+	}
+
+	return entity.ErrTaskVersionMismatch
+}
+
+func (im *InMemoryTaskRepo) DeleteTask(ctx context.Context, id string) error {
+	for i, task := range im.Tasks {
+		if task.ID == id && task.DeletedAt == nil && belongs(ctx, task) {
+			deletedAt := time.Now()
+			im.Tasks[i].DeletedAt = &deletedAt
+			im.Tasks[i].Version++
+			break
+		}
+	}
+
+	return nil
+}
+
+func (im *InMemoryTaskRepo) RestoreTask(ctx context.Context, id string) error {
+	for i, task := range im.Tasks {
+		if task.ID == id && task.DeletedAt != nil && belongs(ctx, task) {
+			im.Tasks[i].DeletedAt = nil
+			im.Tasks[i].Version++
+			break
+		}
+	}
+
+	return nil
+}
+
+func (im *InMemoryTaskRepo) PurgeDeletedTasks(
+	ctx context.Context,
+	deletedBefore time.Time,
+) (int64, error) {
+	var count int64
+	im.Tasks = slices.DeleteFunc(im.Tasks, func(task entity.Task) bool {
+		purge := task.DeletedAt != nil &&
+			task.DeletedAt.Before(deletedBefore) &&
+			belongs(ctx, task)
+		if purge {
+			count++
+			delete(im.LabelIDs, task.ID)
+		}
+		return purge
+	})
+
+	return count, nil
+}
+
+func (im *InMemoryTaskRepo) SetTaskLabels(
+	ctx context.Context,
+	taskID string,
+	labelIDs []string,
+) error {
+	if !im.exists(ctx, taskID) {
+		return nil
+	}
+
+	if im.LabelIDs == nil {
+		im.LabelIDs = map[string][]string{}
+	}
+
+	im.LabelIDs[taskID] = slices.Clone(labelIDs)
+
+	return nil
+}
+
+func (im *InMemoryTaskRepo) ListTaskLabelIDs(
+	ctx context.Context,
+	taskIDs []string,
+) (map[string][]string, error) {
+	labelIDsByTaskID := map[string][]string{}
+	for _, taskID := range taskIDs {
+		if !im.exists(ctx, taskID) {
+			continue
+		}
+
+		if labelIDs := im.LabelIDs[taskID]; len(labelIDs) > 0 {
+			labelIDsByTaskID[taskID] = slices.Clone(labelIDs)
+		}
+	}
+
+	return labelIDsByTaskID, nil
+}
+
+func (im *InMemoryTaskRepo) SetTaskAssignees(
+	ctx context.Context,
+	taskID string,
+	userIDs []string,
+) error {
+	for i, task := range im.Tasks {
+		if task.ID != taskID || !belongs(ctx, task) {
+			continue
+		}
+
+		task = withAssignees(task)
+		task.SignedOffUserIDs = slices.DeleteFunc(
+			slices.Clone(task.SignedOffUserIDs),
+			func(userID string) bool {
+				return !slices.Contains(userIDs, userID)
+			},
+		)
+		task.AssigneeIDs = slices.Clone(userIDs)
+		task.AssignedToUserID = nil
+
+		im.Tasks[i] = withAssignees(task)
 		break
 	}
 
 	return nil
 }
 
-func (im *InMemoryTaskRepo) DeleteTask(_ context.Context, id string) error {
+func (im *InMemoryTaskRepo) SignOffTask(
+	ctx context.Context,
+	taskID string,
+	userID string,
+	_ time.Time,
+) (bool, error) {
 	for i, task := range im.Tasks {
-		if task.ID == id {
-			im.Tasks = slices.Delete(im.Tasks, i, i+1)
+		if task.ID != taskID || !belongs(ctx, task) {
+			continue
+		}
+
+		task = withAssignees(task)
+		if !task.IsAssignedTo(userID) ||
+			slices.Contains(task.SignedOffUserIDs, userID) {
+			return false, nil
+		}
+
+		task.SignedOffUserIDs = append(
+			slices.Clone(task.SignedOffUserIDs),
+			userID,
+		)
+
+		im.Tasks[i] = task
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (im *InMemoryTaskRepo) ClearTaskSignOffs(
+	ctx context.Context,
+	taskID string,
+) error {
+	for i, task := range im.Tasks {
+		if task.ID == taskID && belongs(ctx, task) {
+			im.Tasks[i].SignedOffUserIDs = nil
 			break
 		}
 	}
@@ -112,4 +431,126 @@ func (im *InMemoryTaskRepo) DeleteTask(_ context.Context, id string) error {
 	return nil
 }
 
+func (im *InMemoryTaskRepo) SetTaskWorkSessionsUnlocked(
+	ctx context.Context,
+	taskID string,
+	unlocked bool,
+) error {
+	for i, task := range im.Tasks {
+		if task.ID == taskID && task.DeletedAt == nil && belongs(ctx, task) {
+			im.Tasks[i].WorkSessionsUnlocked = unlocked
+			im.Tasks[i].Version++
+			break
+		}
+	}
+
+	return nil
+}
+
+func (im *InMemoryTaskRepo) ListOverdueTasksToNotify(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]entity.Task, error) {
+	tasks := []entity.Task{}
+	for _, task := range im.tasks(ctx) {
+		if _, notified := im.OverdueNotifiedAt[task.ID]; notified ||
+			task.DeletedAt != nil ||
+			!task.IsOverdue(now) {
+			continue
+		}
+
+		tasks = append(tasks, withAssignees(task))
+	}
+
+	slices.SortFunc(tasks, func(a, b entity.Task) int {
+		if c := a.DueAt.Compare(*b.DueAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	if len(tasks) > limit {
+		tasks = tasks[:limit]
+	}
+
+	return tasks, nil
+}
+
+func (im *InMemoryTaskRepo) MarkTaskOverdueNotified(
+	ctx context.Context,
+	id string,
+	notifiedAt time.Time,
+) (bool, error) {
+	if !im.exists(ctx, id) {
+		return false, nil
+	}
+
+	if _, notified := im.OverdueNotifiedAt[id]; notified {
+		return false, nil
+	}
+
+	if im.OverdueNotifiedAt == nil {
+		im.OverdueNotifiedAt = map[string]time.Time{}
+	}
+
+	im.OverdueNotifiedAt[id] = notifiedAt
+
+	return true, nil
+}
+
+// tasks returns the tasks of the organization ctx acts on, deleted ones
+// included.
+func (im *InMemoryTaskRepo) tasks(ctx context.Context) []entity.Task {
+	tasks := []entity.Task{}
+	for _, task := range im.Tasks {
+		if belongs(ctx, task) {
+			tasks = append(tasks, task)
+		}
+	}
+
+	return tasks
+}
+
+// belongs reports whether the task belongs to the organization ctx acts
+// on.
+func belongs(ctx context.Context, task entity.Task) bool {
+	return task.OrganizationID == organizationID(ctx)
+}
+
+// exists reports whether the task, deleted or not, belongs to the
+// organization ctx acts on.
+func (im *InMemoryTaskRepo) exists(ctx context.Context, id string) bool {
+	return slices.ContainsFunc(im.tasks(ctx), func(task entity.Task) bool {
+		return task.ID == id
+	})
+}
+
+// withAssignees returns the task with AssigneeIDs and AssignedToUserID
+// in sync, as tasks may be seeded with only the single assignee.
+func withAssignees(task entity.Task) entity.Task {
+	if len(task.AssigneeIDs) == 0 && task.AssignedToUserID != nil {
+		task.AssigneeIDs = []string{*task.AssignedToUserID}
+	}
+
+	task.AssigneeIDs = slices.Clone(task.AssigneeIDs)
+	task.SignedOffUserIDs = slices.Clone(task.SignedOffUserIDs)
+
+	task.AssignedToUserID = nil
+	if len(task.AssigneeIDs) > 0 {
+		assignedToUserID := task.AssigneeIDs[0]
+		task.AssignedToUserID = &assignedToUserID
+	}
+
+	return task
+}
+
+// equalTimes reports whether both times are unset or the same instant.
+func equalTimes(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
 var _ repo.TaskRepo = (*InMemoryTaskRepo)(nil)
@@ -0,0 +1,96 @@
+package inmemoryrepo
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type InMemoryTaskDependencyRepo struct {
+	Dependencies  []entity.TaskDependency
+	organizations organizationRows
+}
+
+func NewInMemoryTaskDependencyRepo() *InMemoryTaskDependencyRepo {
+	return &InMemoryTaskDependencyRepo{
+		Dependencies: []entity.TaskDependency{},
+	}
+}
+
+func (im *InMemoryTaskDependencyRepo) CreateTaskDependency(
+	ctx context.Context,
+	params repo.TaskDependencyParams,
+) error {
+	for _, dependency := range im.Dependencies {
+		if dependency.TaskID == params.TaskID &&
+			dependency.BlockedByTaskID == params.BlockedByTaskID {
+			return nil
+		}
+	}
+
+	dependency := entity.TaskDependency{
+		TaskID:          params.TaskID,
+		BlockedByTaskID: params.BlockedByTaskID,
+		CreatedAt:       time.Now(),
+	}
+
+	im.Dependencies = append(im.Dependencies, dependency)
+	im.organizations.add(ctx, dependencyKey(dependency))
+
+	return nil
+}
+
+func (im *InMemoryTaskDependencyRepo) DeleteTaskDependency(
+	ctx context.Context,
+	params repo.TaskDependencyParams,
+) error {
+	im.Dependencies = slices.DeleteFunc(
+		im.Dependencies,
+		func(dependency entity.TaskDependency) bool {
+			return dependency.TaskID == params.TaskID &&
+				dependency.BlockedByTaskID == params.BlockedByTaskID &&
+				im.organizations.contains(ctx, dependencyKey(dependency))
+		},
+	)
+
+	return nil
+}
+
+func (im *InMemoryTaskDependencyRepo) ListTaskBlockers(
+	ctx context.Context,
+	taskID string,
+) ([]entity.TaskDependency, error) {
+	dependencies := []entity.TaskDependency{}
+	for _, dependency := range im.Dependencies {
+		if dependency.TaskID == taskID &&
+			im.organizations.contains(ctx, dependencyKey(dependency)) {
+			dependencies = append(dependencies, dependency)
+		}
+	}
+
+	return dependencies, nil
+}
+
+func (im *InMemoryTaskDependencyRepo) ListTaskDependents(
+	ctx context.Context,
+	blockedByTaskID string,
+) ([]entity.TaskDependency, error) {
+	dependencies := []entity.TaskDependency{}
+	for _, dependency := range im.Dependencies {
+		if dependency.BlockedByTaskID == blockedByTaskID &&
+			im.organizations.contains(ctx, dependencyKey(dependency)) {
+			dependencies = append(dependencies, dependency)
+		}
+	}
+
+	return dependencies, nil
+}
+
+func dependencyKey(dependency entity.TaskDependency) string {
+	return dependency.TaskID + "/" + dependency.BlockedByTaskID
+}
+
+var _ repo.TaskDependencyRepo = (*InMemoryTaskDependencyRepo)(nil)
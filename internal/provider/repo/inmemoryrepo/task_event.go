@@ -0,0 +1,56 @@
+package inmemoryrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type InMemoryTaskEventRepo struct {
+	Events        []entity.TaskEvent
+	organizations organizationRows
+}
+
+func NewInMemoryTaskEventRepo() *InMemoryTaskEventRepo {
+	return &InMemoryTaskEventRepo{
+		Events: []entity.TaskEvent{},
+	}
+}
+
+func (im *InMemoryTaskEventRepo) CreateTaskEvent(
+	ctx context.Context,
+	params repo.CreateTaskEventParams,
+) error {
+	event := entity.TaskEvent{
+		ID:          uuid.NewString(),
+		TaskID:      params.TaskID,
+		ActorUserID: params.ActorUserID,
+		Type:        params.Type,
+		Changes:     params.Changes,
+		CreatedAt:   time.Now(),
+	}
+
+	im.Events = append(im.Events, event)
+	im.organizations.add(ctx, event.ID)
+
+	return nil
+}
+
+func (im *InMemoryTaskEventRepo) ListTaskEvents(
+	ctx context.Context,
+	taskID string,
+) ([]entity.TaskEvent, error) {
+	events := []entity.TaskEvent{}
+	for _, event := range im.Events {
+		if event.TaskID == taskID && im.organizations.contains(ctx, event.ID) {
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+var _ repo.TaskEventRepo = (*InMemoryTaskEventRepo)(nil)
@@ -0,0 +1,33 @@
+package inmemoryrepo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/pkg/tenant"
+)
+
+// organizationID returns the organization ctx acts on. Unlike the MySQL
+// repositories, the in-memory ones do not fail without one: they act on
+// the rows of no organization instead, which are the ones the use case
+// tests seed.
+func organizationID(ctx context.Context) string {
+	organizationID, _ := tenant.OrganizationID(ctx)
+	return organizationID
+}
+
+// organizationRows records the organization the rows were created in by
+// row key, for the repositories whose entities do not hold it. Seeded rows
+// are not recorded, so they belong to no organization.
+type organizationRows map[string]string
+
+func (r *organizationRows) add(ctx context.Context, key string) {
+	if *r == nil {
+		*r = organizationRows{}
+	}
+	(*r)[key] = organizationID(ctx)
+}
+
+// contains reports whether the row belongs to the organization ctx acts on.
+func (r organizationRows) contains(ctx context.Context, key string) bool {
+	return r[key] == organizationID(ctx)
+}
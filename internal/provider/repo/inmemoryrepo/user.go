@@ -21,7 +21,7 @@ func NewInMemoryUserRepo() *InMemoryUserRepo {
 }
 
 func (im *InMemoryUserRepo) CreateUser(
-	_ context.Context,
+	ctx context.Context,
 	params repo.CreateUserParams,
 ) error {
 	user := entity.User{}
@@ -30,6 +30,7 @@ func (im *InMemoryUserRepo) CreateUser(
 	}
 
 	user.ID = uuid.NewString()
+	user.OrganizationID = organizationID(ctx)
 	user.CreatedAt = time.Now()
 	user.UpdatedAt = time.Now()
 
@@ -39,11 +40,11 @@ func (im *InMemoryUserRepo) CreateUser(
 }
 
 func (im *InMemoryUserRepo) GetUserByID(
-	_ context.Context,
+	ctx context.Context,
 	id string,
 ) (entity.User, error) {
 	for _, user := range im.Users {
-		if user.ID == id {
+		if user.ID == id && user.OrganizationID == organizationID(ctx) {
 			return user, nil
 		}
 	}
@@ -52,11 +53,11 @@ func (im *InMemoryUserRepo) GetUserByID(
 }
 
 func (im *InMemoryUserRepo) GetUserByEmail(
-	_ context.Context,
+	ctx context.Context,
 	email string,
 ) (entity.User, error) {
 	for _, user := range im.Users {
-		if user.Email == email {
+		if user.Email == email && user.OrganizationID == organizationID(ctx) {
 			return user, nil
 		}
 	}
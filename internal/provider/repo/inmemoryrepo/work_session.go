@@ -0,0 +1,207 @@
+package inmemoryrepo
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+// InMemoryWorkSessionRepo leaves out the sessions of the tasks deleted in,
+// or out of the project scope of, the task repository it is given when
+// summing work time, the way the MySQL repository joins their tables.
+type InMemoryWorkSessionRepo struct {
+	Sessions      []entity.WorkSession
+	taskRepo      *InMemoryTaskRepo
+	organizations organizationRows
+}
+
+func NewInMemoryWorkSessionRepo(
+	taskRepo *InMemoryTaskRepo,
+) *InMemoryWorkSessionRepo {
+	return &InMemoryWorkSessionRepo{
+		Sessions: []entity.WorkSession{},
+		taskRepo: taskRepo,
+	}
+}
+
+func (im *InMemoryWorkSessionRepo) CreateWorkSession(
+	ctx context.Context,
+	params repo.CreateWorkSessionParams,
+) error {
+	session := entity.WorkSession{}
+	if err := copier.Copy(&session, params); err != nil {
+		return entity.NewErr(err)
+	}
+
+	session.CreatedAt = time.Now()
+	session.UpdatedAt = time.Now()
+
+	im.Sessions = append(im.Sessions, session)
+	im.organizations.add(ctx, session.ID)
+
+	return nil
+}
+
+func (im *InMemoryWorkSessionRepo) GetWorkSessionByID(
+	ctx context.Context,
+	id string,
+) (entity.WorkSession, error) {
+	for _, session := range im.Sessions {
+		if session.ID == id && im.organizations.contains(ctx, session.ID) {
+			return session, nil
+		}
+	}
+
+	return entity.WorkSession{}, nil
+}
+
+func (im *InMemoryWorkSessionRepo) GetRunningWorkSession(
+	ctx context.Context,
+	userID string,
+) (entity.WorkSession, error) {
+	for _, session := range im.Sessions {
+		if session.UserID == userID && session.IsRunning() &&
+			im.organizations.contains(ctx, session.ID) {
+			return session, nil
+		}
+	}
+
+	return entity.WorkSession{}, nil
+}
+
+func (im *InMemoryWorkSessionRepo) ListWorkSessions(
+	ctx context.Context,
+	taskID string,
+) ([]entity.WorkSession, error) {
+	sessions := []entity.WorkSession{}
+	for _, session := range im.Sessions {
+		if session.TaskID == taskID &&
+			im.organizations.contains(ctx, session.ID) {
+			sessions = append(sessions, session)
+		}
+	}
+
+	slices.SortStableFunc(sessions, func(a, b entity.WorkSession) int {
+		return cmp.Or(
+			a.StartedAt.Compare(b.StartedAt),
+			strings.Compare(a.ID, b.ID),
+		)
+	})
+
+	return sessions, nil
+}
+
+func (im *InMemoryWorkSessionRepo) UpdateWorkSession(
+	ctx context.Context,
+	params repo.UpdateWorkSessionParams,
+) error {
+	for i, session := range im.Sessions {
+		if session.ID != params.ID ||
+			!im.organizations.contains(ctx, session.ID) {
+			continue
+		}
+
+		session.StartedAt = params.StartedAt
+		session.EndedAt = params.EndedAt
+		session.Note = params.Note
+		session.UpdatedAt = time.Now()
+
+		im.Sessions[i] = session
+		break
+	}
+
+	return nil
+}
+
+func (im *InMemoryWorkSessionRepo) DeleteWorkSession(
+	ctx context.Context,
+	id string,
+) error {
+	im.Sessions = slices.DeleteFunc(
+		im.Sessions,
+		func(session entity.WorkSession) bool {
+			return session.ID == id &&
+				im.organizations.contains(ctx, session.ID)
+		},
+	)
+
+	return nil
+}
+
+func (im *InMemoryWorkSessionRepo) SumWorkTimeByUser(
+	ctx context.Context,
+	params repo.SumWorkTimeByUserParams,
+) ([]entity.WorkTimeTotal, error) {
+	secondsByUserID := map[string]int64{}
+	for _, session := range im.Sessions {
+		if !im.organizations.contains(ctx, session.ID) ||
+			session.StartedAt.Before(params.StartedFrom) ||
+			!session.StartedAt.Before(params.StartedTo) ||
+			im.isTaskDeleted(ctx, session.TaskID) ||
+			!im.isTaskInScope(ctx, session.TaskID, params) {
+			continue
+		}
+
+		secondsByUserID[session.UserID] += int64(
+			session.Duration(params.Now).Seconds(),
+		)
+	}
+
+	totals := []entity.WorkTimeTotal{}
+	for userID, seconds := range secondsByUserID {
+		totals = append(totals, entity.WorkTimeTotal{
+			UserID:  userID,
+			Seconds: seconds,
+		})
+	}
+
+	slices.SortFunc(totals, func(a, b entity.WorkTimeTotal) int {
+		return strings.Compare(a.UserID, b.UserID)
+	})
+
+	return totals, nil
+}
+
+func (im *InMemoryWorkSessionRepo) isTaskDeleted(
+	ctx context.Context,
+	taskID string,
+) bool {
+	if im.taskRepo == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(
+		im.taskRepo.tasks(ctx),
+		func(task entity.Task) bool {
+			return task.ID == taskID && task.DeletedAt != nil
+		},
+	)
+}
+
+// isTaskInScope tells whether the task is kept by the project scope of
+// params, which needs the task repository to know the task project.
+func (im *InMemoryWorkSessionRepo) isTaskInScope(
+	ctx context.Context,
+	taskID string,
+	params repo.SumWorkTimeByUserParams,
+) bool {
+	if !params.ProjectScoped || im.taskRepo == nil {
+		return true
+	}
+
+	return !slices.ContainsFunc(
+		im.taskRepo.tasks(ctx),
+		func(task entity.Task) bool {
+			return task.ID == taskID && task.ProjectID != nil &&
+				!slices.Contains(params.ScopeProjectIDs, *task.ProjectID)
+		},
+	)
+}
+
+var _ repo.WorkSessionRepo = (*InMemoryWorkSessionRepo)(nil)
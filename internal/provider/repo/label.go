@@ -0,0 +1,28 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+)
+
+type CreateLabelParams struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type UpdateLabelParams struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type LabelRepo interface {
+	CreateLabel(ctx context.Context, params CreateLabelParams) error
+	GetLabelByID(ctx context.Context, id string) (entity.Label, error)
+	GetLabelByName(ctx context.Context, name string) (entity.Label, error)
+	// ListLabels lists every label ordered by name.
+	ListLabels(ctx context.Context) ([]entity.Label, error)
+	UpdateLabel(ctx context.Context, params UpdateLabelParams) error
+	// DeleteLabel deletes the label, removing it from the tasks.
+	DeleteLabel(ctx context.Context, id string) error
+}
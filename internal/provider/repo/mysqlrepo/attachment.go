@@ -0,0 +1,109 @@
+package mysqlrepo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/db/mysqldb"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+type MySQLAttachmentRepo struct {
+	queries *Queries
+}
+
+func NewMySQLAttachmentRepo(queries *Queries) *MySQLAttachmentRepo {
+	return &MySQLAttachmentRepo{
+		queries: queries,
+	}
+}
+
+func (m MySQLAttachmentRepo) CreateAttachment(
+	ctx context.Context,
+	params repo.CreateAttachmentParams,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	args := mysqldb.CreateAttachmentParams{}
+	if err := copier.Copy(&args, params); err != nil {
+		return entity.NewErr(err)
+	}
+	args.OrganizationID = organizationID
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.CreateAttachment(ctx, args); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLAttachmentRepo) GetAttachmentByID(
+	ctx context.Context,
+	id string,
+) (entity.Attachment, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return entity.Attachment{}, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	result, err := db.GetAttachmentByID(
+		ctx,
+		mysqldb.GetAttachmentByIDParams{
+			ID:             id,
+			OrganizationID: organizationID,
+		},
+	)
+
+	if err == sql.ErrNoRows {
+		return entity.Attachment{}, nil
+	}
+
+	if err != nil {
+		return entity.Attachment{}, entity.NewErr(err)
+	}
+
+	attachment := entity.Attachment{}
+	if err := copier.Copy(&attachment, result); err != nil {
+		return entity.Attachment{}, entity.NewErr(err)
+	}
+
+	return attachment, nil
+}
+
+func (m MySQLAttachmentRepo) ListAttachments(
+	ctx context.Context,
+	taskID string,
+) ([]entity.Attachment, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	results, err := db.ListAttachmentsByTaskID(
+		ctx,
+		mysqldb.ListAttachmentsByTaskIDParams{
+			TaskID:         taskID,
+			OrganizationID: organizationID,
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	attachments := []entity.Attachment{}
+	if err := copier.Copy(&attachments, results); err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return attachments, nil
+}
+
+var _ repo.AttachmentRepo = (*MySQLAttachmentRepo)(nil)
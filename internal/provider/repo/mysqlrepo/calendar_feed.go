@@ -0,0 +1,105 @@
+package mysqlrepo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/db/mysqldb"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+type MySQLCalendarFeedRepo struct {
+	queries *Queries
+}
+
+func NewMySQLCalendarFeedRepo(queries *Queries) *MySQLCalendarFeedRepo {
+	return &MySQLCalendarFeedRepo{
+		queries: queries,
+	}
+}
+
+func (m MySQLCalendarFeedRepo) GetCalendarFeedTokenByHash(
+	ctx context.Context,
+	tokenHash string,
+) (entity.CalendarFeedToken, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return entity.CalendarFeedToken{}, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	result, err := db.GetCalendarFeedTokenByHash(
+		ctx,
+		mysqldb.GetCalendarFeedTokenByHashParams{
+			TokenHash:      tokenHash,
+			OrganizationID: organizationID,
+		},
+	)
+
+	if err == sql.ErrNoRows {
+		return entity.CalendarFeedToken{}, nil
+	}
+
+	if err != nil {
+		return entity.CalendarFeedToken{}, entity.NewErr(err)
+	}
+
+	token := entity.CalendarFeedToken{}
+	if err := copier.Copy(&token, result); err != nil {
+		return entity.CalendarFeedToken{}, entity.NewErr(err)
+	}
+
+	return token, nil
+}
+
+func (m MySQLCalendarFeedRepo) SetCalendarFeedToken(
+	ctx context.Context,
+	userID string,
+	tokenHash string,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.UpsertCalendarFeedToken(
+		ctx,
+		mysqldb.UpsertCalendarFeedTokenParams{
+			UserID:         userID,
+			TokenHash:      tokenHash,
+			OrganizationID: organizationID,
+		},
+	); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLCalendarFeedRepo) DeleteCalendarFeedToken(
+	ctx context.Context,
+	userID string,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.DeleteCalendarFeedToken(
+		ctx,
+		mysqldb.DeleteCalendarFeedTokenParams{
+			UserID:         userID,
+			OrganizationID: organizationID,
+		},
+	); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+var _ repo.CalendarFeedRepo = (*MySQLCalendarFeedRepo)(nil)
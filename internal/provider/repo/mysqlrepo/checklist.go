@@ -0,0 +1,203 @@
+package mysqlrepo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/db/mysqldb"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+type MySQLChecklistRepo struct {
+	queries *Queries
+}
+
+func NewMySQLChecklistRepo(queries *Queries) *MySQLChecklistRepo {
+	return &MySQLChecklistRepo{
+		queries: queries,
+	}
+}
+
+func (m MySQLChecklistRepo) CreateChecklistItem(
+	ctx context.Context,
+	params repo.CreateChecklistItemParams,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	args := mysqldb.CreateChecklistItemParams{
+		ID:             params.ID,
+		TaskID:         params.TaskID,
+		Position:       int32(params.Position),
+		Title:          params.Title,
+		Required:       params.Required,
+		OrganizationID: organizationID,
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.CreateChecklistItem(ctx, args); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLChecklistRepo) GetChecklistItemByID(
+	ctx context.Context,
+	id string,
+) (entity.ChecklistItem, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return entity.ChecklistItem{}, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	result, err := db.GetChecklistItemByID(
+		ctx,
+		mysqldb.GetChecklistItemByIDParams{
+			ID:             id,
+			OrganizationID: organizationID,
+		},
+	)
+
+	if err == sql.ErrNoRows {
+		return entity.ChecklistItem{}, nil
+	}
+
+	if err != nil {
+		return entity.ChecklistItem{}, entity.NewErr(err)
+	}
+
+	item := entity.ChecklistItem{}
+	if err := copier.Copy(&item, result); err != nil {
+		return entity.ChecklistItem{}, entity.NewErr(err)
+	}
+
+	return item, nil
+}
+
+func (m MySQLChecklistRepo) ListChecklistItems(
+	ctx context.Context,
+	taskID string,
+) ([]entity.ChecklistItem, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	results, err := db.ListChecklistItemsByTaskID(
+		ctx,
+		mysqldb.ListChecklistItemsByTaskIDParams{
+			TaskID:         taskID,
+			OrganizationID: organizationID,
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	items := []entity.ChecklistItem{}
+	if err := copier.Copy(&items, results); err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return items, nil
+}
+
+func (m MySQLChecklistRepo) UpdateChecklistItem(
+	ctx context.Context,
+	params repo.UpdateChecklistItemParams,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	args := mysqldb.UpdateChecklistItemParams{
+		ID:             params.ID,
+		Title:          params.Title,
+		Required:       params.Required,
+		Done:           params.Done,
+		OrganizationID: organizationID,
+	}
+
+	if params.DoneAt != nil {
+		args.DoneAt = sql.NullTime{
+			Time:  *params.DoneAt,
+			Valid: true,
+		}
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.UpdateChecklistItem(ctx, args); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLChecklistRepo) DeleteChecklistItem(
+	ctx context.Context,
+	id string,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.DeleteChecklistItem(
+		ctx,
+		mysqldb.DeleteChecklistItemParams{
+			ID:             id,
+			OrganizationID: organizationID,
+		},
+	); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLChecklistRepo) CountChecklistItems(
+	ctx context.Context,
+	taskIDs []string,
+) (map[string]entity.ChecklistProgress, error) {
+	progressByTaskID := map[string]entity.ChecklistProgress{}
+	if len(taskIDs) == 0 {
+		return progressByTaskID, nil
+	}
+
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	results, err := db.CountChecklistItemsByTaskIDs(
+		ctx,
+		mysqldb.CountChecklistItemsByTaskIDsParams{
+			TaskIds:        taskIDs,
+			OrganizationID: organizationID,
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	for _, result := range results {
+		progressByTaskID[result.TaskID] = entity.ChecklistProgress{
+			Done:  int(result.Done),
+			Total: int(result.Total),
+		}
+	}
+
+	return progressByTaskID, nil
+}
+
+var _ repo.ChecklistRepo = (*MySQLChecklistRepo)(nil)
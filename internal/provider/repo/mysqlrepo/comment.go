@@ -0,0 +1,74 @@
+package mysqlrepo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/db/mysqldb"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+type MySQLCommentRepo struct {
+	queries *Queries
+}
+
+func NewMySQLCommentRepo(queries *Queries) *MySQLCommentRepo {
+	return &MySQLCommentRepo{
+		queries: queries,
+	}
+}
+
+func (m MySQLCommentRepo) CreateComment(
+	ctx context.Context,
+	params repo.CreateCommentParams,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	args := mysqldb.CreateCommentParams{}
+	if err := copier.Copy(&args, params); err != nil {
+		return entity.NewErr(err)
+	}
+	args.OrganizationID = organizationID
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.CreateComment(ctx, args); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLCommentRepo) ListComments(
+	ctx context.Context,
+	taskID string,
+) ([]entity.Comment, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	results, err := db.ListCommentsByTaskID(
+		ctx,
+		mysqldb.ListCommentsByTaskIDParams{
+			TaskID:         taskID,
+			OrganizationID: organizationID,
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	comments := []entity.Comment{}
+	if err := copier.Copy(&comments, results); err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return comments, nil
+}
+
+var _ repo.CommentRepo = (*MySQLCommentRepo)(nil)
@@ -7,6 +7,8 @@ import (
 	_ "github.com/go-sql-driver/mysql" // mysql driver
 
 	"github.com/danielmesquitta/tasks-api/internal/config"
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/tenant"
 	"github.com/danielmesquitta/tasks-api/internal/pkg/transactioner"
 	"github.com/danielmesquitta/tasks-api/internal/provider/db/mysqldb"
 )
@@ -31,12 +33,16 @@ func NewMySQLDBConn(
 
 type Queries struct {
 	*mysqldb.Queries
+
+	// db is used to run queries built at runtime,
+	// which can not be generated by sqlc.
+	db mysqldb.DBTX
 }
 
 func NewMySQLQueries(
 	dbConn *sql.DB,
 ) *Queries {
-	return &Queries{mysqldb.New(dbConn)}
+	return &Queries{mysqldb.New(dbConn), dbConn}
 }
 
 func (q *Queries) getDBorTX(
@@ -44,7 +50,18 @@ func (q *Queries) getDBorTX(
 ) *Queries {
 	tx, ok := ctx.Value(transactioner.CtxTxKey).(*sql.Tx)
 	if ok {
-		return &Queries{q.WithTx(tx)}
+		return &Queries{q.WithTx(tx), tx}
 	}
 	return q
 }
+
+// requireOrganizationID returns the organization ctx acts on, which
+// every query is scoped to. Queries do not run without one, instead of
+// reaching the data of every organization.
+func requireOrganizationID(ctx context.Context) (string, error) {
+	organizationID, ok := tenant.OrganizationID(ctx)
+	if !ok {
+		return "", entity.ErrMissingOrganization
+	}
+	return organizationID, nil
+}
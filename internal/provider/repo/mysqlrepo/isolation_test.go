@@ -0,0 +1,116 @@
+package mysqlrepo
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo/repotest"
+	"github.com/danielmesquitta/tasks-api/test/testutil"
+	"github.com/google/uuid"
+)
+
+// newTestQueries connects to the migrated database in TEST_DB_CONNECTION,
+// skipping the test if it is not set.
+func newTestQueries(t *testing.T) *Queries {
+	t.Helper()
+
+	dbConnection := os.Getenv("TEST_DB_CONNECTION")
+	if dbConnection == "" {
+		t.Skip("TEST_DB_CONNECTION is not set")
+	}
+
+	dbConn, err := sql.Open("mysql", dbConnection)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { dbConn.Close() })
+
+	if err := dbConn.Ping(); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewMySQLQueries(dbConn)
+}
+
+func TestMySQLRepos_TenantIsolation(t *testing.T) {
+	queries := newTestQueries(t)
+
+	repotest.RunTenantIsolation(t, repotest.Repos{
+		Organizations: NewMySQLOrganizationRepo(queries),
+		Users:         NewMySQLUserRepo(queries),
+		Labels:        NewMySQLLabelRepo(queries),
+		Tasks:         NewMySQLTaskRepo(queries),
+	})
+}
+
+func TestMySQLRepos_WithoutOrganization(t *testing.T) {
+	// Without a database, since the queries must not be run at all
+	queries := NewMySQLQueries(nil)
+	taskRepo := NewMySQLTaskRepo(queries)
+	userRepo := NewMySQLUserRepo(queries)
+
+	ctx := context.Background()
+	id := uuid.NewString()
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "GetTaskByID",
+			call: func() error {
+				_, err := taskRepo.GetTaskByID(ctx, id)
+				return err
+			},
+		},
+		{
+			name: "ListTasks",
+			call: func() error {
+				_, err := taskRepo.ListTasks(ctx)
+				return err
+			},
+		},
+		{
+			name: "CreateTask",
+			call: func() error {
+				return taskRepo.CreateTask(ctx, repo.CreateTaskParams{ID: id})
+			},
+		},
+		{
+			name: "DeleteTask",
+			call: func() error {
+				return taskRepo.DeleteTask(ctx, id)
+			},
+		},
+		{
+			name: "GetUserByEmail",
+			call: func() error {
+				_, err := userRepo.GetUserByEmail(ctx, "johndoe@email.com")
+				return err
+			},
+		},
+		{
+			name: "CreateUser",
+			call: func() error {
+				return userRepo.CreateUser(ctx, repo.CreateUserParams{})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if !testutil.IsSameErr(err, entity.ErrMissingOrganization) {
+				t.Errorf(
+					"%s() error = %v, wantErr %v",
+					tt.name,
+					err,
+					entity.ErrMissingOrganization,
+				)
+			}
+		})
+	}
+}
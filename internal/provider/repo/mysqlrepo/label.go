@@ -0,0 +1,155 @@
+package mysqlrepo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/db/mysqldb"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+type MySQLLabelRepo struct {
+	queries *Queries
+}
+
+func NewMySQLLabelRepo(queries *Queries) *MySQLLabelRepo {
+	return &MySQLLabelRepo{
+		queries: queries,
+	}
+}
+
+func (m MySQLLabelRepo) CreateLabel(
+	ctx context.Context,
+	params repo.CreateLabelParams,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.CreateLabel(ctx, mysqldb.CreateLabelParams{
+		ID:             params.ID,
+		OrganizationID: organizationID,
+		Name:           params.Name,
+	}); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLLabelRepo) GetLabelByID(
+	ctx context.Context,
+	id string,
+) (entity.Label, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return entity.Label{}, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	result, err := db.GetLabelByID(ctx, mysqldb.GetLabelByIDParams{
+		ID:             id,
+		OrganizationID: organizationID,
+	})
+	return toLabel(result, err)
+}
+
+func (m MySQLLabelRepo) GetLabelByName(
+	ctx context.Context,
+	name string,
+) (entity.Label, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return entity.Label{}, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	result, err := db.GetLabelByName(ctx, mysqldb.GetLabelByNameParams{
+		Name:           name,
+		OrganizationID: organizationID,
+	})
+	return toLabel(result, err)
+}
+
+func toLabel(result mysqldb.Label, err error) (entity.Label, error) {
+	if err == sql.ErrNoRows {
+		return entity.Label{}, nil
+	}
+
+	if err != nil {
+		return entity.Label{}, entity.NewErr(err)
+	}
+
+	label := entity.Label{}
+	if err := copier.Copy(&label, result); err != nil {
+		return entity.Label{}, entity.NewErr(err)
+	}
+
+	return label, nil
+}
+
+func (m MySQLLabelRepo) ListLabels(
+	ctx context.Context,
+) ([]entity.Label, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	results, err := db.ListLabels(ctx, organizationID)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	labels := []entity.Label{}
+	if err := copier.Copy(&labels, results); err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return labels, nil
+}
+
+func (m MySQLLabelRepo) UpdateLabel(
+	ctx context.Context,
+	params repo.UpdateLabelParams,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.UpdateLabel(ctx, mysqldb.UpdateLabelParams{
+		ID:             params.ID,
+		Name:           params.Name,
+		OrganizationID: organizationID,
+	}); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLLabelRepo) DeleteLabel(ctx context.Context, id string) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.DeleteLabel(ctx, mysqldb.DeleteLabelParams{
+		ID:             id,
+		OrganizationID: organizationID,
+	}); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+var _ repo.LabelRepo = (*MySQLLabelRepo)(nil)
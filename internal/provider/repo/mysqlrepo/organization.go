@@ -0,0 +1,116 @@
+package mysqlrepo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/db/mysqldb"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+type MySQLOrganizationRepo struct {
+	queries *Queries
+}
+
+func NewMySQLOrganizationRepo(queries *Queries) *MySQLOrganizationRepo {
+	return &MySQLOrganizationRepo{
+		queries: queries,
+	}
+}
+
+func (m MySQLOrganizationRepo) CreateOrganization(
+	ctx context.Context,
+	params repo.CreateOrganizationParams,
+) error {
+	db := m.queries.getDBorTX(ctx)
+	if err := db.CreateOrganization(ctx, mysqldb.CreateOrganizationParams{
+		ID:   params.ID,
+		Name: params.Name,
+	}); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLOrganizationRepo) GetOrganizationByID(
+	ctx context.Context,
+	id string,
+) (entity.Organization, error) {
+	db := m.queries.getDBorTX(ctx)
+	result, err := db.GetOrganizationByID(ctx, id)
+
+	if err == sql.ErrNoRows {
+		return entity.Organization{}, nil
+	}
+
+	if err != nil {
+		return entity.Organization{}, entity.NewErr(err)
+	}
+
+	organization := entity.Organization{}
+	if err := copier.Copy(&organization, result); err != nil {
+		return entity.Organization{}, entity.NewErr(err)
+	}
+
+	return organization, nil
+}
+
+func (m MySQLOrganizationRepo) ListOrganizations(
+	ctx context.Context,
+) ([]entity.Organization, error) {
+	db := m.queries.getDBorTX(ctx)
+	results, err := db.ListOrganizations(ctx)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	organizations := []entity.Organization{}
+	if err := copier.Copy(&organizations, results); err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return organizations, nil
+}
+
+func (m MySQLOrganizationRepo) GetOrganizationIDByUserID(
+	ctx context.Context,
+	userID string,
+) (string, error) {
+	db := m.queries.getDBorTX(ctx)
+	return toOrganizationID(db.GetOrganizationIDByUserID(ctx, userID))
+}
+
+func (m MySQLOrganizationRepo) GetOrganizationIDByUserEmail(
+	ctx context.Context,
+	email string,
+) (string, error) {
+	db := m.queries.getDBorTX(ctx)
+	return toOrganizationID(db.GetOrganizationIDByUserEmail(ctx, email))
+}
+
+func (m MySQLOrganizationRepo) GetOrganizationIDByCalendarFeedTokenHash(
+	ctx context.Context,
+	tokenHash string,
+) (string, error) {
+	db := m.queries.getDBorTX(ctx)
+	return toOrganizationID(
+		db.GetOrganizationIDByCalendarFeedTokenHash(ctx, tokenHash),
+	)
+}
+
+func toOrganizationID(organizationID string, err error) (string, error) {
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", entity.NewErr(err)
+	}
+
+	return organizationID, nil
+}
+
+var _ repo.OrganizationRepo = (*MySQLOrganizationRepo)(nil)
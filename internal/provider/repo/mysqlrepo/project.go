@@ -0,0 +1,275 @@
+package mysqlrepo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/db/mysqldb"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+type MySQLProjectRepo struct {
+	queries *Queries
+}
+
+func NewMySQLProjectRepo(queries *Queries) *MySQLProjectRepo {
+	return &MySQLProjectRepo{
+		queries: queries,
+	}
+}
+
+func (m MySQLProjectRepo) CreateProject(
+	ctx context.Context,
+	params repo.CreateProjectParams,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.CreateProject(ctx, mysqldb.CreateProjectParams{
+		ID:             params.ID,
+		OrganizationID: organizationID,
+		Name:           params.Name,
+		Description:    params.Description,
+		ManagerUserID:  params.ManagerUserID,
+	}); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLProjectRepo) GetProjectByID(
+	ctx context.Context,
+	id string,
+) (entity.Project, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return entity.Project{}, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	result, err := db.GetProjectByID(ctx, mysqldb.GetProjectByIDParams{
+		ID:             id,
+		OrganizationID: organizationID,
+	})
+
+	if err == sql.ErrNoRows {
+		return entity.Project{}, nil
+	}
+
+	if err != nil {
+		return entity.Project{}, entity.NewErr(err)
+	}
+
+	project := entity.Project{}
+	if err := copier.Copy(&project, result); err != nil {
+		return entity.Project{}, entity.NewErr(err)
+	}
+
+	return project, nil
+}
+
+func (m MySQLProjectRepo) ListProjectsByMemberUserID(
+	ctx context.Context,
+	userID string,
+) ([]entity.Project, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	results, err := db.ListProjectsByMemberUserID(
+		ctx,
+		mysqldb.ListProjectsByMemberUserIDParams{
+			UserID:         userID,
+			OrganizationID: organizationID,
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	projects := []entity.Project{}
+	if err := copier.Copy(&projects, results); err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return projects, nil
+}
+
+func (m MySQLProjectRepo) UpdateProject(
+	ctx context.Context,
+	params repo.UpdateProjectParams,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.UpdateProject(ctx, mysqldb.UpdateProjectParams{
+		ID:             params.ID,
+		Name:           params.Name,
+		Description:    params.Description,
+		ManagerUserID:  params.ManagerUserID,
+		OrganizationID: organizationID,
+	}); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLProjectRepo) DeleteProject(ctx context.Context, id string) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.DeleteProject(ctx, mysqldb.DeleteProjectParams{
+		ID:             id,
+		OrganizationID: organizationID,
+	}); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLProjectRepo) ProjectHasTasks(
+	ctx context.Context,
+	id string,
+) (bool, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	hasTasks, err := db.ProjectHasTasks(ctx, mysqldb.ProjectHasTasksParams{
+		ProjectID: sql.NullString{
+			String: id,
+			Valid:  true,
+		},
+		OrganizationID: organizationID,
+	})
+	if err != nil {
+		return false, entity.NewErr(err)
+	}
+
+	return hasTasks, nil
+}
+
+func (m MySQLProjectRepo) AddProjectMember(
+	ctx context.Context,
+	projectID string,
+	userID string,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.AddProjectMember(ctx, mysqldb.AddProjectMemberParams{
+		ProjectID:      projectID,
+		UserID:         userID,
+		OrganizationID: organizationID,
+	}); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLProjectRepo) RemoveProjectMember(
+	ctx context.Context,
+	projectID string,
+	userID string,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.RemoveProjectMember(ctx, mysqldb.RemoveProjectMemberParams{
+		ProjectID:      projectID,
+		UserID:         userID,
+		OrganizationID: organizationID,
+	}); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLProjectRepo) ListProjectMembers(
+	ctx context.Context,
+	projectID string,
+) ([]entity.ProjectMember, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	results, err := db.ListProjectMembers(
+		ctx,
+		mysqldb.ListProjectMembersParams{
+			ProjectID:      projectID,
+			OrganizationID: organizationID,
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	members := []entity.ProjectMember{}
+	if err := copier.Copy(&members, results); err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return members, nil
+}
+
+func (m MySQLProjectRepo) GetProjectMember(
+	ctx context.Context,
+	projectID string,
+	userID string,
+) (entity.ProjectMember, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return entity.ProjectMember{}, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	result, err := db.GetProjectMember(ctx, mysqldb.GetProjectMemberParams{
+		ProjectID:      projectID,
+		UserID:         userID,
+		OrganizationID: organizationID,
+	})
+
+	if err == sql.ErrNoRows {
+		return entity.ProjectMember{}, nil
+	}
+
+	if err != nil {
+		return entity.ProjectMember{}, entity.NewErr(err)
+	}
+
+	return entity.ProjectMember{
+		ProjectID: result.ProjectID,
+		UserID:    result.UserID,
+		CreatedAt: result.CreatedAt,
+	}, nil
+}
+
+var _ repo.ProjectRepo = (*MySQLProjectRepo)(nil)
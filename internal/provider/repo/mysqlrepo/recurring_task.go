@@ -0,0 +1,216 @@
+package mysqlrepo
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/db/mysqldb"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+type MySQLRecurringTaskRepo struct {
+	queries *Queries
+}
+
+func NewMySQLRecurringTaskRepo(queries *Queries) *MySQLRecurringTaskRepo {
+	return &MySQLRecurringTaskRepo{
+		queries: queries,
+	}
+}
+
+func (m MySQLRecurringTaskRepo) CreateRecurringTask(
+	ctx context.Context,
+	params repo.CreateRecurringTaskParams,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	args := mysqldb.CreateRecurringTaskParams{
+		ID:              params.ID,
+		OrganizationID:  organizationID,
+		Summary:         params.Summary,
+		Schedule:        params.Schedule,
+		CreatedByUserID: params.CreatedByUserID,
+		NextRunAt:       params.NextRunAt,
+	}
+
+	if params.AssignedToUserID != nil {
+		args.AssignedToUserID = sql.NullString{
+			String: *params.AssignedToUserID,
+			Valid:  true,
+		}
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.CreateRecurringTask(ctx, args); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLRecurringTaskRepo) GetRecurringTaskByID(
+	ctx context.Context,
+	id string,
+) (entity.RecurringTask, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return entity.RecurringTask{}, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	result, err := db.GetRecurringTaskByID(
+		ctx,
+		mysqldb.GetRecurringTaskByIDParams{
+			ID:             id,
+			OrganizationID: organizationID,
+		},
+	)
+
+	if err == sql.ErrNoRows {
+		return entity.RecurringTask{}, nil
+	}
+
+	if err != nil {
+		return entity.RecurringTask{}, entity.NewErr(err)
+	}
+
+	recurringTask := entity.RecurringTask{}
+	if err := copier.Copy(&recurringTask, result); err != nil {
+		return entity.RecurringTask{}, entity.NewErr(err)
+	}
+
+	return recurringTask, nil
+}
+
+func (m MySQLRecurringTaskRepo) ListRecurringTasks(
+	ctx context.Context,
+) ([]entity.RecurringTask, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	results, err := db.ListRecurringTasks(ctx, organizationID)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	recurringTasks := []entity.RecurringTask{}
+	if err := copier.Copy(&recurringTasks, results); err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return recurringTasks, nil
+}
+
+func (m MySQLRecurringTaskRepo) ListDueRecurringTasks(
+	ctx context.Context,
+	now time.Time,
+) ([]entity.RecurringTask, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	results, err := db.ListDueRecurringTasks(
+		ctx,
+		mysqldb.ListDueRecurringTasksParams{
+			NextRunAt:      now,
+			OrganizationID: organizationID,
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	recurringTasks := []entity.RecurringTask{}
+	if err := copier.Copy(&recurringTasks, results); err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return recurringTasks, nil
+}
+
+func (m MySQLRecurringTaskRepo) UpdateRecurringTaskNextRunAt(
+	ctx context.Context,
+	id string,
+	nextRunAt time.Time,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.UpdateRecurringTaskNextRunAt(
+		ctx,
+		mysqldb.UpdateRecurringTaskNextRunAtParams{
+			ID:             id,
+			NextRunAt:      nextRunAt,
+			OrganizationID: organizationID,
+		},
+	); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLRecurringTaskRepo) DeleteRecurringTask(
+	ctx context.Context,
+	id string,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.DeleteRecurringTask(
+		ctx,
+		mysqldb.DeleteRecurringTaskParams{
+			ID:             id,
+			OrganizationID: organizationID,
+		},
+	); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLRecurringTaskRepo) ClaimRecurringTaskOccurrence(
+	ctx context.Context,
+	id string,
+	scheduledAt time.Time,
+) (bool, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	rows, err := db.ClaimRecurringTaskOccurrence(
+		ctx,
+		mysqldb.ClaimRecurringTaskOccurrenceParams{
+			RecurringTaskID: id,
+			ScheduledAt:     scheduledAt,
+			OrganizationID:  organizationID,
+		},
+	)
+	if err != nil {
+		return false, entity.NewErr(err)
+	}
+
+	return rows == 1, nil
+}
+
+var _ repo.RecurringTaskRepo = (*MySQLRecurringTaskRepo)(nil)
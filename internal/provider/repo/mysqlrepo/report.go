@@ -0,0 +1,45 @@
+package mysqlrepo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type MySQLReportRepo struct {
+	queries *Queries
+}
+
+func NewMySQLReportRepo(queries *Queries) *MySQLReportRepo {
+	return &MySQLReportRepo{
+		queries: queries,
+	}
+}
+
+func (m MySQLReportRepo) GetTaskReport(
+	ctx context.Context,
+	params repo.TaskReportParams,
+) ([]entity.TaskReportRow, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args := buildTaskReportQuery(organizationID, params)
+
+	db := m.queries.getDBorTX(ctx)
+	rows, err := db.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	results, err := scanTaskReportRows(rows)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return results, nil
+}
+
+var _ repo.ReportRepo = (*MySQLReportRepo)(nil)
@@ -0,0 +1,200 @@
+package mysqlrepo
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+// reportUserColumns maps the report groupings to the column holding the
+// user each task is counted for.
+var reportUserColumns = map[entity.ReportGroupBy]string{
+	entity.ReportGroupByTechnician: "task_assignees.user_id",
+	entity.ReportGroupByCreator:    "tasks.created_by_user_id",
+}
+
+// reportBucketExprs maps the report buckets to the SQL expression of the
+// date their bucket starts on, given a date column.
+var reportBucketExprs = map[entity.ReportBucket]string{
+	entity.ReportBucketDay:   "DATE(%[1]s)",
+	entity.ReportBucketWeek:  "DATE_SUB(DATE(%[1]s), INTERVAL WEEKDAY(%[1]s) DAY)",
+	entity.ReportBucketMonth: "DATE_SUB(DATE(%[1]s), INTERVAL DAYOFMONTH(%[1]s) - 1 DAY)",
+}
+
+// buildTaskReportQuery builds the query to aggregate the tasks of the
+// organization into report rows, since the grouping and the buckets can
+// not be parameterized with sqlc. The percentiles are computed with the
+// nearest-rank method: the smallest time to finish whose rank is at least
+// the percentile of the count of finished tasks.
+func buildTaskReportQuery(
+	organizationID string,
+	params repo.TaskReportParams,
+) (query string, args []any) {
+	userColumn, ok := reportUserColumns[params.GroupBy]
+	if !ok {
+		userColumn = reportUserColumns[entity.ReportGroupByTechnician]
+	}
+
+	bucketExpr, ok := reportBucketExprs[params.Bucket]
+	if !ok {
+		bucketExpr = reportBucketExprs[entity.ReportBucketDay]
+	}
+	createdBucket := fmt.Sprintf(bucketExpr, "created_at")
+	finishedBucket := fmt.Sprintf(bucketExpr, "finished_at")
+
+	conditions := []string{
+		"tasks.deleted_at IS NULL",
+		"tasks.organization_id = ?",
+	}
+	args = append(args, organizationID)
+
+	if params.ProjectScoped {
+		if len(params.ScopeProjectIDs) == 0 {
+			conditions = append(conditions, "tasks.project_id IS NULL")
+		} else {
+			conditions = append(conditions, fmt.Sprintf(
+				"(tasks.project_id IS NULL OR tasks.project_id IN (%s))",
+				placeholders(len(params.ScopeProjectIDs)),
+			))
+			for _, projectID := range params.ScopeProjectIDs {
+				args = append(args, projectID)
+			}
+		}
+	}
+
+	from := "tasks"
+	if params.GroupBy != entity.ReportGroupByCreator {
+		from += "\n    JOIN task_assignees ON task_assignees.task_id = tasks.id"
+	}
+
+	query = fmt.Sprintf(`WITH report_tasks AS (
+  SELECT %s AS user_id,
+    tasks.created_at,
+    tasks.finished_at
+  FROM %s
+  WHERE %s
+),
+created AS (
+  SELECT user_id,
+    %s AS bucket_start,
+    COUNT(*) AS created_count
+  FROM report_tasks
+  WHERE created_at >= ?
+    AND created_at < ?
+  GROUP BY user_id,
+    bucket_start
+),
+finished AS (
+  SELECT user_id,
+    %s AS bucket_start,
+    TIMESTAMPDIFF(SECOND, created_at, finished_at) AS finish_seconds
+  FROM report_tasks
+  WHERE finished_at >= ?
+    AND finished_at < ?
+),
+ranked AS (
+  SELECT user_id,
+    bucket_start,
+    finish_seconds,
+    ROW_NUMBER() OVER (
+      PARTITION BY user_id,
+      bucket_start
+      ORDER BY finish_seconds
+    ) AS finish_rank,
+    COUNT(*) OVER (PARTITION BY user_id, bucket_start) AS finished_total
+  FROM finished
+),
+finished_stats AS (
+  SELECT user_id,
+    bucket_start,
+    COUNT(*) AS finished_count,
+    MIN(
+      CASE
+        WHEN finish_rank >= CEIL(0.5 * finished_total) THEN finish_seconds
+      END
+    ) AS median_finish_seconds,
+    MIN(
+      CASE
+        WHEN finish_rank >= CEIL(0.9 * finished_total) THEN finish_seconds
+      END
+    ) AS p90_finish_seconds
+  FROM ranked
+  GROUP BY user_id,
+    bucket_start
+),
+report_keys AS (
+  SELECT user_id,
+    bucket_start
+  FROM created
+  UNION
+  SELECT user_id,
+    bucket_start
+  FROM finished_stats
+)
+SELECT report_keys.bucket_start,
+  report_keys.user_id,
+  COALESCE(created.created_count, 0) AS created_count,
+  COALESCE(finished_stats.finished_count, 0) AS finished_count,
+  finished_stats.median_finish_seconds,
+  finished_stats.p90_finish_seconds
+FROM report_keys
+  LEFT JOIN created ON created.user_id = report_keys.user_id
+  AND created.bucket_start = report_keys.bucket_start
+  LEFT JOIN finished_stats ON finished_stats.user_id = report_keys.user_id
+  AND finished_stats.bucket_start = report_keys.bucket_start
+ORDER BY report_keys.bucket_start,
+  report_keys.user_id`,
+		userColumn,
+		from,
+		strings.Join(conditions, "\n    AND "),
+		createdBucket,
+		finishedBucket,
+	)
+	args = append(args, params.From, params.To, params.From, params.To)
+
+	return query, args
+}
+
+func scanTaskReportRows(rows *sql.Rows) ([]entity.TaskReportRow, error) {
+	defer rows.Close()
+
+	items := []entity.TaskReportRow{}
+	for rows.Next() {
+		var (
+			i             entity.TaskReportRow
+			medianSeconds sql.NullInt64
+			p90Seconds    sql.NullInt64
+		)
+		if err := rows.Scan(
+			&i.BucketStart,
+			&i.UserID,
+			&i.CreatedCount,
+			&i.FinishedCount,
+			&medianSeconds,
+			&p90Seconds,
+		); err != nil {
+			return nil, err
+		}
+
+		if medianSeconds.Valid {
+			i.MedianFinishSeconds = &medianSeconds.Int64
+		}
+
+		if p90Seconds.Valid {
+			i.P90FinishSeconds = &p90Seconds.Int64
+		}
+
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
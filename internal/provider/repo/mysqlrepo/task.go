@@ -3,6 +3,8 @@ package mysqlrepo
 import (
 	"context"
 	"database/sql"
+	"slices"
+	"time"
 
 	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
 	"github.com/danielmesquitta/tasks-api/internal/provider/db/mysqldb"
@@ -24,7 +26,49 @@ func (m MySQLTaskRepo) GetTaskByID(
 	ctx context.Context,
 	id string,
 ) (entity.Task, error) {
-	result, err := m.queries.GetTaskByID(ctx, id)
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return entity.Task{}, err
+	}
+
+	result, err := m.queries.GetTaskByID(ctx, mysqldb.GetTaskByIDParams{
+		ID:             id,
+		OrganizationID: organizationID,
+	})
+
+	if err == sql.ErrNoRows {
+		return entity.Task{}, nil
+	}
+
+	if err != nil {
+		return entity.Task{}, entity.NewErr(err)
+	}
+
+	tasks := make([]entity.Task, 1)
+	if err := copier.Copy(&tasks[0], result); err != nil {
+		return entity.Task{}, entity.NewErr(err)
+	}
+
+	if err := m.setTaskAssignees(ctx, tasks); err != nil {
+		return entity.Task{}, err
+	}
+
+	return tasks[0], nil
+}
+
+func (m MySQLTaskRepo) GetDeletedTaskByID(
+	ctx context.Context,
+	id string,
+) (entity.Task, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return entity.Task{}, err
+	}
+
+	result, err := m.queries.GetDeletedTaskByID(ctx, mysqldb.GetDeletedTaskByIDParams{
+		ID:             id,
+		OrganizationID: organizationID,
+	})
 
 	if err == sql.ErrNoRows {
 		return entity.Task{}, nil
@@ -34,37 +78,46 @@ func (m MySQLTaskRepo) GetTaskByID(
 		return entity.Task{}, entity.NewErr(err)
 	}
 
-	task := entity.Task{}
-	if err := copier.Copy(&task, result); err != nil {
+	tasks := make([]entity.Task, 1)
+	if err := copier.Copy(&tasks[0], result); err != nil {
 		return entity.Task{}, entity.NewErr(err)
 	}
 
-	return task, nil
+	if err := m.setTaskAssignees(ctx, tasks); err != nil {
+		return entity.Task{}, err
+	}
+
+	return tasks[0], nil
 }
 
 func (m MySQLTaskRepo) ListTasks(
 	ctx context.Context,
 	opts ...repo.ListTasksOption,
 ) (tasks []entity.Task, err error) {
-	params := repo.ListTasksParams{}
-	for _, opt := range opts {
-		opt(&params)
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	var results []mysqldb.Task
-	if params.AssignedToUserID == "" {
-		results, err = m.queries.ListTasks(ctx)
-	} else {
-		results, err = m.queries.ListTasksWithFilters(ctx, sql.NullString{
-			String: params.AssignedToUserID,
-			Valid:  true,
-		})
+	params := repo.NewListTasksParams(opts...)
+
+	query, args := buildListTasksQuery(organizationID, params)
+
+	db := m.queries.getDBorTX(ctx)
+	rows, err := db.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.NewErr(err)
 	}
 
+	results, err := scanTasks(rows)
 	if err != nil {
 		return nil, entity.NewErr(err)
 	}
 
+	if params.Before != nil {
+		slices.Reverse(results)
+	}
+
 	for _, result := range results {
 		task := entity.Task{}
 		if err := copier.Copy(&task, result); err != nil {
@@ -73,50 +126,487 @@ func (m MySQLTaskRepo) ListTasks(
 		tasks = append(tasks, task)
 	}
 
+	if err := m.setTaskAssignees(ctx, tasks); err != nil {
+		return nil, err
+	}
+
 	return tasks, nil
 }
 
+// setTaskAssignees fills the assignees and sign-offs of the tasks.
+func (m MySQLTaskRepo) setTaskAssignees(
+	ctx context.Context,
+	tasks []entity.Task,
+) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	taskIDs := make([]string, len(tasks))
+	for i, task := range tasks {
+		taskIDs[i] = task.ID
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	results, err := db.ListTaskAssigneesByTaskIDs(
+		ctx,
+		mysqldb.ListTaskAssigneesByTaskIDsParams{
+			TaskIds:        taskIDs,
+			OrganizationID: organizationID,
+		},
+	)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	assigneesByTaskID := map[string][]mysqldb.TaskAssignee{}
+	for _, result := range results {
+		assigneesByTaskID[result.TaskID] = append(
+			assigneesByTaskID[result.TaskID],
+			result,
+		)
+	}
+
+	for i, task := range tasks {
+		for _, assignee := range assigneesByTaskID[task.ID] {
+			task.AssigneeIDs = append(task.AssigneeIDs, assignee.UserID)
+			if assignee.SignedOffAt.Valid {
+				task.SignedOffUserIDs = append(
+					task.SignedOffUserIDs,
+					assignee.UserID,
+				)
+			}
+		}
+
+		if len(task.AssigneeIDs) > 0 {
+			task.AssignedToUserID = &task.AssigneeIDs[0]
+		}
+
+		tasks[i] = task
+	}
+
+	return nil
+}
+
 func (m MySQLTaskRepo) CreateTask(
 	ctx context.Context,
 	params repo.CreateTaskParams,
 ) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
 	args := mysqldb.CreateTaskParams{}
 	if err := copier.Copy(&args, params); err != nil {
 		return entity.NewErr(err)
 	}
+	args.OrganizationID = organizationID
+
+	if params.DueAt != nil {
+		args.DueAt = sql.NullTime{
+			Time:  *params.DueAt,
+			Valid: true,
+		}
+	}
+
+	if params.ProjectID != nil {
+		args.ProjectID = sql.NullString{
+			String: *params.ProjectID,
+			Valid:  true,
+		}
+	}
 
 	db := m.queries.getDBorTX(ctx)
 	if err := db.CreateTask(ctx, args); err != nil {
 		return entity.NewErr(err)
 	}
 
-	return nil
+	return m.SetTaskAssignees(ctx, params.ID, params.AssigneeIDs)
 }
 
 func (m MySQLTaskRepo) UpdateTask(
 	ctx context.Context,
 	params repo.UpdateTaskParams,
 ) error {
-	args := mysqldb.UpdateTaskParams{}
-	if err := copier.Copy(&args, params); err != nil {
-		return entity.NewErr(err)
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	args := mysqldb.UpdateTaskParams{
+		ID:             params.ID,
+		OrganizationID: organizationID,
+		Summary:        params.Summary,
+		Status:         string(params.Status),
+		Priority:       string(params.Priority),
+		FinishPolicy:   string(params.FinishPolicy),
+		Version:        params.Version,
+	}
+
+	if params.FinishedAt != nil {
+		args.FinishedAt = sql.NullTime{
+			Time:  *params.FinishedAt,
+			Valid: true,
+		}
+	}
+
+	if params.ReopenReason != nil {
+		args.ReopenReason = sql.NullString{
+			String: *params.ReopenReason,
+			Valid:  true,
+		}
+	}
+
+	if params.ReopenedAt != nil {
+		args.ReopenedAt = sql.NullTime{
+			Time:  *params.ReopenedAt,
+			Valid: true,
+		}
+	}
+
+	if params.DueAt != nil {
+		args.DueAt = sql.NullTime{
+			Time:  *params.DueAt,
+			Valid: true,
+		}
+	}
+
+	if params.ProjectID != nil {
+		args.ProjectID = sql.NullString{
+			String: *params.ProjectID,
+			Valid:  true,
+		}
 	}
 
 	db := m.queries.getDBorTX(ctx)
-	if err := db.UpdateTask(ctx, args); err != nil {
+	rows, err := db.UpdateTask(ctx, args)
+	if err != nil {
 		return entity.NewErr(err)
 	}
 
+	if rows == 0 {
+		return entity.ErrTaskVersionMismatch
+	}
+
 	return nil
 }
 
 func (m MySQLTaskRepo) DeleteTask(ctx context.Context, id string) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.DeleteTask(ctx, mysqldb.DeleteTaskParams{
+		ID:             id,
+		OrganizationID: organizationID,
+	}); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLTaskRepo) RestoreTask(ctx context.Context, id string) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.RestoreTask(ctx, mysqldb.RestoreTaskParams{
+		ID:             id,
+		OrganizationID: organizationID,
+	}); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLTaskRepo) PurgeDeletedTasks(
+	ctx context.Context,
+	deletedBefore time.Time,
+) (int64, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	count, err := db.PurgeDeletedTasks(ctx, mysqldb.PurgeDeletedTasksParams{
+		DeletedAt: sql.NullTime{
+			Time:  deletedBefore,
+			Valid: true,
+		},
+		OrganizationID: organizationID,
+	})
+	if err != nil {
+		return 0, entity.NewErr(err)
+	}
+
+	return count, nil
+}
+
+func (m MySQLTaskRepo) SetTaskLabels(
+	ctx context.Context,
+	taskID string,
+	labelIDs []string,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.DeleteTaskLabels(ctx, mysqldb.DeleteTaskLabelsParams{
+		TaskID:         taskID,
+		OrganizationID: organizationID,
+	}); err != nil {
+		return entity.NewErr(err)
+	}
+
+	for _, labelID := range labelIDs {
+		if err := db.CreateTaskLabel(ctx, mysqldb.CreateTaskLabelParams{
+			TaskID:         taskID,
+			LabelID:        labelID,
+			OrganizationID: organizationID,
+		}); err != nil {
+			return entity.NewErr(err)
+		}
+	}
+
+	return nil
+}
+
+func (m MySQLTaskRepo) ListTaskLabelIDs(
+	ctx context.Context,
+	taskIDs []string,
+) (map[string][]string, error) {
+	labelIDsByTaskID := map[string][]string{}
+	if len(taskIDs) == 0 {
+		return labelIDsByTaskID, nil
+	}
+
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	results, err := db.ListTaskLabelsByTaskIDs(
+		ctx,
+		mysqldb.ListTaskLabelsByTaskIDsParams{
+			TaskIds:        taskIDs,
+			OrganizationID: organizationID,
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	for _, result := range results {
+		labelIDsByTaskID[result.TaskID] = append(
+			labelIDsByTaskID[result.TaskID],
+			result.LabelID,
+		)
+	}
+
+	return labelIDsByTaskID, nil
+}
+
+func (m MySQLTaskRepo) SetTaskAssignees(
+	ctx context.Context,
+	taskID string,
+	userIDs []string,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
 	db := m.queries.getDBorTX(ctx)
-	if err := db.DeleteTask(ctx, id); err != nil {
+	results, err := db.ListTaskAssigneesByTaskIDs(
+		ctx,
+		mysqldb.ListTaskAssigneesByTaskIDsParams{
+			TaskIds:        []string{taskID},
+			OrganizationID: organizationID,
+		},
+	)
+	if err != nil {
 		return entity.NewErr(err)
 	}
 
+	for _, result := range results {
+		if slices.Contains(userIDs, result.UserID) {
+			continue
+		}
+
+		if err := db.DeleteTaskAssignee(ctx, mysqldb.DeleteTaskAssigneeParams{
+			TaskID:         taskID,
+			UserID:         result.UserID,
+			OrganizationID: organizationID,
+		}); err != nil {
+			return entity.NewErr(err)
+		}
+	}
+
+	for position, userID := range userIDs {
+		if err := db.UpsertTaskAssignee(ctx, mysqldb.UpsertTaskAssigneeParams{
+			TaskID:         taskID,
+			UserID:         userID,
+			Position:       uint16(position),
+			OrganizationID: organizationID,
+		}); err != nil {
+			return entity.NewErr(err)
+		}
+	}
+
 	return nil
 }
 
+func (m MySQLTaskRepo) SignOffTask(
+	ctx context.Context,
+	taskID string,
+	userID string,
+	signedOffAt time.Time,
+) (bool, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	rows, err := db.SignOffTask(ctx, mysqldb.SignOffTaskParams{
+		SignedOffAt: sql.NullTime{
+			Time:  signedOffAt,
+			Valid: true,
+		},
+		TaskID:         taskID,
+		UserID:         userID,
+		OrganizationID: organizationID,
+	})
+	if err != nil {
+		return false, entity.NewErr(err)
+	}
+
+	return rows == 1, nil
+}
+
+func (m MySQLTaskRepo) ClearTaskSignOffs(
+	ctx context.Context,
+	taskID string,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.ClearTaskSignOffs(ctx, mysqldb.ClearTaskSignOffsParams{
+		TaskID:         taskID,
+		OrganizationID: organizationID,
+	}); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLTaskRepo) SetTaskWorkSessionsUnlocked(
+	ctx context.Context,
+	taskID string,
+	unlocked bool,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.SetTaskWorkSessionsUnlocked(
+		ctx,
+		mysqldb.SetTaskWorkSessionsUnlockedParams{
+			WorkSessionsUnlocked: unlocked,
+			ID:                   taskID,
+			OrganizationID:       organizationID,
+		},
+	); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLTaskRepo) ListOverdueTasksToNotify(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]entity.Task, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	results, err := db.ListOverdueTasksToNotify(
+		ctx,
+		mysqldb.ListOverdueTasksToNotifyParams{
+			DueAt:          sql.NullTime{Time: now, Valid: true},
+			OrganizationID: organizationID,
+			Limit:          int32(limit),
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	tasks := []entity.Task{}
+	if err := copier.Copy(&tasks, results); err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	if err := m.setTaskAssignees(ctx, tasks); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+func (m MySQLTaskRepo) MarkTaskOverdueNotified(
+	ctx context.Context,
+	id string,
+	notifiedAt time.Time,
+) (bool, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	rows, err := db.MarkTaskOverdueNotified(
+		ctx,
+		mysqldb.MarkTaskOverdueNotifiedParams{
+			ID:             id,
+			OrganizationID: organizationID,
+			OverdueNotifiedAt: sql.NullTime{
+				Time:  notifiedAt,
+				Valid: true,
+			},
+		},
+	)
+	if err != nil {
+		return false, entity.NewErr(err)
+	}
+
+	return rows == 1, nil
+}
+
 var _ repo.TaskRepo = (*MySQLTaskRepo)(nil)
@@ -0,0 +1,128 @@
+package mysqlrepo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/db/mysqldb"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+type MySQLTaskDependencyRepo struct {
+	queries *Queries
+}
+
+func NewMySQLTaskDependencyRepo(queries *Queries) *MySQLTaskDependencyRepo {
+	return &MySQLTaskDependencyRepo{
+		queries: queries,
+	}
+}
+
+func (m MySQLTaskDependencyRepo) CreateTaskDependency(
+	ctx context.Context,
+	params repo.TaskDependencyParams,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.CreateTaskDependency(
+		ctx,
+		mysqldb.CreateTaskDependencyParams{
+			TaskID:          params.TaskID,
+			BlockedByTaskID: params.BlockedByTaskID,
+			OrganizationID:  organizationID,
+		},
+	); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLTaskDependencyRepo) DeleteTaskDependency(
+	ctx context.Context,
+	params repo.TaskDependencyParams,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.DeleteTaskDependency(
+		ctx,
+		mysqldb.DeleteTaskDependencyParams{
+			TaskID:          params.TaskID,
+			BlockedByTaskID: params.BlockedByTaskID,
+			OrganizationID:  organizationID,
+		},
+	); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLTaskDependencyRepo) ListTaskBlockers(
+	ctx context.Context,
+	taskID string,
+) ([]entity.TaskDependency, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	results, err := db.ListTaskDependenciesByTaskID(
+		ctx,
+		mysqldb.ListTaskDependenciesByTaskIDParams{
+			TaskID:         taskID,
+			OrganizationID: organizationID,
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	dependencies := []entity.TaskDependency{}
+	if err := copier.Copy(&dependencies, results); err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return dependencies, nil
+}
+
+func (m MySQLTaskDependencyRepo) ListTaskDependents(
+	ctx context.Context,
+	blockedByTaskID string,
+) ([]entity.TaskDependency, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	results, err := db.ListTaskDependenciesByBlockedByTaskID(
+		ctx,
+		mysqldb.ListTaskDependenciesByBlockedByTaskIDParams{
+			BlockedByTaskID: blockedByTaskID,
+			OrganizationID:  organizationID,
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	dependencies := []entity.TaskDependency{}
+	if err := copier.Copy(&dependencies, results); err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return dependencies, nil
+}
+
+var _ repo.TaskDependencyRepo = (*MySQLTaskDependencyRepo)(nil)
@@ -0,0 +1,91 @@
+package mysqlrepo
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/db/mysqldb"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+type MySQLTaskEventRepo struct {
+	queries *Queries
+}
+
+func NewMySQLTaskEventRepo(queries *Queries) *MySQLTaskEventRepo {
+	return &MySQLTaskEventRepo{
+		queries: queries,
+	}
+}
+
+func (m MySQLTaskEventRepo) CreateTaskEvent(
+	ctx context.Context,
+	params repo.CreateTaskEventParams,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	changes, err := json.Marshal(params.Changes)
+	if err != nil {
+		return entity.NewErr(err)
+	}
+
+	args := mysqldb.CreateTaskEventParams{
+		TaskID:         params.TaskID,
+		ActorUserID:    params.ActorUserID,
+		Type:           string(params.Type),
+		Changes:        changes,
+		OrganizationID: organizationID,
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.CreateTaskEvent(ctx, args); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLTaskEventRepo) ListTaskEvents(
+	ctx context.Context,
+	taskID string,
+) ([]entity.TaskEvent, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	results, err := db.ListTaskEventsByTaskID(
+		ctx,
+		mysqldb.ListTaskEventsByTaskIDParams{
+			TaskID:         taskID,
+			OrganizationID: organizationID,
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	events := make([]entity.TaskEvent, len(results))
+	for i, result := range results {
+		events[i] = entity.TaskEvent{
+			ID:          result.ID,
+			TaskID:      result.TaskID,
+			ActorUserID: result.ActorUserID,
+			Type:        entity.TaskEventType(result.Type),
+			CreatedAt:   result.CreatedAt,
+		}
+
+		if err := json.Unmarshal(result.Changes, &events[i].Changes); err != nil {
+			return nil, entity.NewErr(err)
+		}
+	}
+
+	return events, nil
+}
+
+var _ repo.TaskEventRepo = (*MySQLTaskEventRepo)(nil)
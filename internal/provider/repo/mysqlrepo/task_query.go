@@ -0,0 +1,269 @@
+package mysqlrepo
+
+import (
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/danielmesquitta/tasks-api/internal/provider/db/mysqldb"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+)
+
+// taskColumns must follow the fields order of mysqldb.Task.
+const taskColumns = `id,
+  summary,
+  created_by_user_id,
+  finished_at,
+  created_at,
+  updated_at,
+  status,
+  reopen_reason,
+  reopened_at,
+  deleted_at,
+  due_at,
+  priority,
+  overdue_notified_at,
+  finish_policy,
+  project_id,
+  organization_id,
+  work_sessions_unlocked,
+  version`
+
+// taskSortColumns maps sort fields to their SQL expressions. Unfinished
+// tasks take repo.MissingDateSortValue when sorting by finished date,
+// and so do tasks without due date when sorting by due date.
+var taskSortColumns = map[repo.TaskSortField]string{
+	repo.TaskSortByCreatedAt:  "created_at",
+	repo.TaskSortByUpdatedAt:  "updated_at",
+	repo.TaskSortByFinishedAt: "COALESCE(finished_at, CAST('9999-12-31 23:59:59' AS DATETIME))",
+	repo.TaskSortByDueAt:      "COALESCE(due_at, CAST('9999-12-31 23:59:59' AS DATETIME))",
+	repo.TaskSortByPriority:   "FIELD(priority, 'low', 'normal', 'high', 'critical')",
+}
+
+// buildListTasksQuery builds the query to list the tasks of the
+// organization, since the combination of filters and sorting can not be
+// expressed with sqlc.
+func buildListTasksQuery(
+	organizationID string,
+	params repo.ListTasksParams,
+) (query string, args []any) {
+	conditions := []string{"deleted_at IS NULL", "organization_id = ?"}
+	if params.Deleted {
+		conditions[0] = "deleted_at IS NOT NULL"
+	}
+	args = append(args, organizationID)
+
+	if params.AssignedToUserID != "" {
+		conditions = append(
+			conditions,
+			"id IN (SELECT task_id FROM task_assignees WHERE user_id = ?)",
+		)
+		args = append(args, params.AssignedToUserID)
+	}
+
+	if params.Unassigned {
+		conditions = append(
+			conditions,
+			"id NOT IN (SELECT task_id FROM task_assignees)",
+		)
+	}
+
+	if params.CreatedByUserID != "" {
+		conditions = append(conditions, "created_by_user_id = ?")
+		args = append(args, params.CreatedByUserID)
+	}
+
+	if params.Finished != nil {
+		if *params.Finished {
+			conditions = append(conditions, "finished_at IS NOT NULL")
+		} else {
+			conditions = append(conditions, "finished_at IS NULL")
+		}
+	}
+
+	if params.CreatedFrom != nil {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, *params.CreatedFrom)
+	}
+
+	if params.CreatedTo != nil {
+		conditions = append(conditions, "created_at <= ?")
+		args = append(args, *params.CreatedTo)
+	}
+
+	if params.FinishedFrom != nil {
+		conditions = append(conditions, "finished_at >= ?")
+		args = append(args, *params.FinishedFrom)
+	}
+
+	if params.FinishedTo != nil {
+		conditions = append(conditions, "finished_at <= ?")
+		args = append(args, *params.FinishedTo)
+	}
+
+	if params.DueFrom != nil {
+		conditions = append(conditions, "due_at >= ?")
+		args = append(args, *params.DueFrom)
+	}
+
+	if params.DueTo != nil {
+		conditions = append(conditions, "due_at <= ?")
+		args = append(args, *params.DueTo)
+	}
+
+	if params.OverdueAt != nil {
+		conditions = append(conditions, "due_at < ?", "status <> 'done'")
+		args = append(args, *params.OverdueAt)
+	}
+
+	if len(params.Priorities) > 0 {
+		conditions = append(conditions, fmt.Sprintf(
+			"priority IN (%s)",
+			placeholders(len(params.Priorities)),
+		))
+		for _, priority := range params.Priorities {
+			args = append(args, string(priority))
+		}
+	}
+
+	if params.ProjectID != "" {
+		conditions = append(conditions, "project_id = ?")
+		args = append(args, params.ProjectID)
+	}
+
+	if params.ProjectScoped {
+		if len(params.ScopeProjectIDs) == 0 {
+			conditions = append(conditions, "project_id IS NULL")
+		} else {
+			conditions = append(conditions, fmt.Sprintf(
+				"(project_id IS NULL OR project_id IN (%s))",
+				placeholders(len(params.ScopeProjectIDs)),
+			))
+			for _, projectID := range params.ScopeProjectIDs {
+				args = append(args, projectID)
+			}
+		}
+	}
+
+	if len(params.LabelIDs) > 0 {
+		condition := fmt.Sprintf(
+			"id IN (SELECT task_id FROM task_labels WHERE label_id IN (%s)",
+			placeholders(len(params.LabelIDs)),
+		)
+		for _, labelID := range params.LabelIDs {
+			args = append(args, labelID)
+		}
+
+		if params.LabelMatch == repo.LabelMatchAll {
+			condition += " GROUP BY task_id HAVING COUNT(DISTINCT label_id) = ?"
+			args = append(args, len(uniqueStrings(params.LabelIDs)))
+		}
+
+		conditions = append(conditions, condition+")")
+	}
+
+	sortColumn, ok := taskSortColumns[params.SortBy]
+	if !ok {
+		sortColumn = taskSortColumns[repo.TaskSortByCreatedAt]
+	}
+
+	desc := params.SortDirection == repo.SortDesc
+
+	cursor := params.After
+	if params.Before != nil {
+		// Walk the listing backwards from the cursor, the results
+		// must be reversed afterwards.
+		cursor = params.Before
+		desc = !desc
+	}
+
+	if cursor != nil {
+		operator := ">"
+		if desc {
+			operator = "<"
+		}
+		conditions = append(conditions, fmt.Sprintf(
+			"(%[1]s %[2]s ? OR (%[1]s = ? AND id %[2]s ?))",
+			sortColumn,
+			operator,
+		))
+		var cursorValue any = cursor.Value
+		if params.SortBy == repo.TaskSortByPriority {
+			cursorValue = cursor.Rank
+		}
+		args = append(args, cursorValue, cursorValue, cursor.ID)
+	}
+
+	order := "ASC"
+	if desc {
+		order = "DESC"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("SELECT " + taskColumns + "\nFROM tasks")
+	sb.WriteString("\nWHERE " + strings.Join(conditions, "\n  AND "))
+	sb.WriteString(fmt.Sprintf(
+		"\nORDER BY %s %s,\n  id %s",
+		sortColumn,
+		order,
+		order,
+	))
+
+	if params.Limit > 0 {
+		sb.WriteString("\nLIMIT ?")
+		args = append(args, params.Limit)
+	}
+
+	return sb.String(), args
+}
+
+// placeholders returns n comma separated query placeholders.
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+// uniqueStrings returns the values without duplicates.
+func uniqueStrings(values []string) []string {
+	unique := slices.Clone(values)
+	slices.Sort(unique)
+	return slices.Compact(unique)
+}
+
+func scanTasks(rows *sql.Rows) ([]mysqldb.Task, error) {
+	defer rows.Close()
+
+	var items []mysqldb.Task
+	for rows.Next() {
+		var i mysqldb.Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.Summary,
+			&i.CreatedByUserID,
+			&i.FinishedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Status,
+			&i.ReopenReason,
+			&i.ReopenedAt,
+			&i.DeletedAt,
+			&i.DueAt,
+			&i.Priority,
+			&i.OverdueNotifiedAt,
+			&i.FinishPolicy,
+			&i.ProjectID,
+			&i.OrganizationID,
+			&i.WorkSessionsUnlocked,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -24,7 +24,15 @@ func (m MySQLUserRepo) GetUserByID(
 	ctx context.Context,
 	id string,
 ) (entity.User, error) {
-	result, err := m.queries.GetUserByID(ctx, id)
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return entity.User{}, err
+	}
+
+	result, err := m.queries.GetUserByID(ctx, mysqldb.GetUserByIDParams{
+		ID:             id,
+		OrganizationID: organizationID,
+	})
 
 	if err == sql.ErrNoRows {
 		return entity.User{}, nil
@@ -46,7 +54,15 @@ func (m MySQLUserRepo) GetUserByEmail(
 	ctx context.Context,
 	email string,
 ) (entity.User, error) {
-	result, err := m.queries.GetUserByEmail(ctx, email)
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return entity.User{}, err
+	}
+
+	result, err := m.queries.GetUserByEmail(ctx, mysqldb.GetUserByEmailParams{
+		Email:          email,
+		OrganizationID: organizationID,
+	})
 
 	if err == sql.ErrNoRows {
 		return entity.User{}, nil
@@ -68,10 +84,16 @@ func (m MySQLUserRepo) CreateUser(
 	ctx context.Context,
 	params repo.CreateUserParams,
 ) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
 	args := mysqldb.CreateUserParams{}
 	if err := copier.Copy(&args, params); err != nil {
 		return entity.NewErr(err)
 	}
+	args.OrganizationID = organizationID
 
 	db := m.queries.getDBorTX(ctx)
 	if err := db.CreateUser(ctx, args); err != nil {
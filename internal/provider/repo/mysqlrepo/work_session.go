@@ -0,0 +1,261 @@
+package mysqlrepo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/provider/db/mysqldb"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+type MySQLWorkSessionRepo struct {
+	queries *Queries
+}
+
+func NewMySQLWorkSessionRepo(queries *Queries) *MySQLWorkSessionRepo {
+	return &MySQLWorkSessionRepo{
+		queries: queries,
+	}
+}
+
+func (m MySQLWorkSessionRepo) CreateWorkSession(
+	ctx context.Context,
+	params repo.CreateWorkSessionParams,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	args := mysqldb.CreateWorkSessionParams{
+		ID:             params.ID,
+		StartedAt:      params.StartedAt,
+		Manual:         params.Manual,
+		TaskID:         params.TaskID,
+		UserID:         params.UserID,
+		OrganizationID: organizationID,
+	}
+
+	if params.EndedAt != nil {
+		args.EndedAt = sql.NullTime{
+			Time:  *params.EndedAt,
+			Valid: true,
+		}
+	}
+
+	if params.Note != nil {
+		args.Note = sql.NullString{
+			String: *params.Note,
+			Valid:  true,
+		}
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.CreateWorkSession(ctx, args); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLWorkSessionRepo) GetWorkSessionByID(
+	ctx context.Context,
+	id string,
+) (entity.WorkSession, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return entity.WorkSession{}, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	result, err := db.GetWorkSessionByID(
+		ctx,
+		mysqldb.GetWorkSessionByIDParams{
+			ID:             id,
+			OrganizationID: organizationID,
+		},
+	)
+
+	if err == sql.ErrNoRows {
+		return entity.WorkSession{}, nil
+	}
+
+	if err != nil {
+		return entity.WorkSession{}, entity.NewErr(err)
+	}
+
+	session := entity.WorkSession{}
+	if err := copier.Copy(&session, result); err != nil {
+		return entity.WorkSession{}, entity.NewErr(err)
+	}
+
+	return session, nil
+}
+
+func (m MySQLWorkSessionRepo) GetRunningWorkSession(
+	ctx context.Context,
+	userID string,
+) (entity.WorkSession, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return entity.WorkSession{}, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	result, err := db.GetRunningWorkSessionByUserID(
+		ctx,
+		mysqldb.GetRunningWorkSessionByUserIDParams{
+			UserID:         userID,
+			OrganizationID: organizationID,
+		},
+	)
+
+	if err == sql.ErrNoRows {
+		return entity.WorkSession{}, nil
+	}
+
+	if err != nil {
+		return entity.WorkSession{}, entity.NewErr(err)
+	}
+
+	session := entity.WorkSession{}
+	if err := copier.Copy(&session, result); err != nil {
+		return entity.WorkSession{}, entity.NewErr(err)
+	}
+
+	return session, nil
+}
+
+func (m MySQLWorkSessionRepo) ListWorkSessions(
+	ctx context.Context,
+	taskID string,
+) ([]entity.WorkSession, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	results, err := db.ListWorkSessionsByTaskID(
+		ctx,
+		mysqldb.ListWorkSessionsByTaskIDParams{
+			TaskID:         taskID,
+			OrganizationID: organizationID,
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	sessions := []entity.WorkSession{}
+	if err := copier.Copy(&sessions, results); err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	return sessions, nil
+}
+
+func (m MySQLWorkSessionRepo) UpdateWorkSession(
+	ctx context.Context,
+	params repo.UpdateWorkSessionParams,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	args := mysqldb.UpdateWorkSessionParams{
+		ID:             params.ID,
+		StartedAt:      params.StartedAt,
+		OrganizationID: organizationID,
+	}
+
+	if params.EndedAt != nil {
+		args.EndedAt = sql.NullTime{
+			Time:  *params.EndedAt,
+			Valid: true,
+		}
+	}
+
+	if params.Note != nil {
+		args.Note = sql.NullString{
+			String: *params.Note,
+			Valid:  true,
+		}
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.UpdateWorkSession(ctx, args); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLWorkSessionRepo) DeleteWorkSession(
+	ctx context.Context,
+	id string,
+) error {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	if err := db.DeleteWorkSession(
+		ctx,
+		mysqldb.DeleteWorkSessionParams{
+			ID:             id,
+			OrganizationID: organizationID,
+		},
+	); err != nil {
+		return entity.NewErr(err)
+	}
+
+	return nil
+}
+
+func (m MySQLWorkSessionRepo) SumWorkTimeByUser(
+	ctx context.Context,
+	params repo.SumWorkTimeByUserParams,
+) ([]entity.WorkTimeTotal, error) {
+	organizationID, err := requireOrganizationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scopeProjectIDs := make([]sql.NullString, len(params.ScopeProjectIDs))
+	for i, projectID := range params.ScopeProjectIDs {
+		scopeProjectIDs[i] = sql.NullString{String: projectID, Valid: true}
+	}
+
+	db := m.queries.getDBorTX(ctx)
+	results, err := db.SumWorkSessionsByUserID(
+		ctx,
+		mysqldb.SumWorkSessionsByUserIDParams{
+			Now:             params.Now,
+			OrganizationID:  organizationID,
+			StartedFrom:     params.StartedFrom,
+			StartedTo:       params.StartedTo,
+			ProjectScoped:   params.ProjectScoped,
+			ScopeProjectIds: scopeProjectIDs,
+		},
+	)
+	if err != nil {
+		return nil, entity.NewErr(err)
+	}
+
+	totals := make([]entity.WorkTimeTotal, 0, len(results))
+	for _, result := range results {
+		totals = append(totals, entity.WorkTimeTotal{
+			UserID:  result.UserID,
+			Seconds: result.Seconds,
+		})
+	}
+
+	return totals, nil
+}
+
+var _ repo.WorkSessionRepo = (*MySQLWorkSessionRepo)(nil)
@@ -0,0 +1,44 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+)
+
+type CreateOrganizationParams struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// OrganizationRepo is the only repository not scoped to the organization
+// in the context, since its lookups are how the organization a request
+// acts on is found before there is one. The organization ID lookups
+// return an empty ID when nothing matches.
+type OrganizationRepo interface {
+	CreateOrganization(
+		ctx context.Context,
+		params CreateOrganizationParams,
+	) error
+	GetOrganizationByID(
+		ctx context.Context,
+		id string,
+	) (entity.Organization, error)
+	// ListOrganizations lists every organization ordered by name, for the
+	// jobs that run over all of them.
+	ListOrganizations(ctx context.Context) ([]entity.Organization, error)
+	GetOrganizationIDByUserID(
+		ctx context.Context,
+		userID string,
+	) (string, error)
+	// GetOrganizationIDByUserEmail finds the organization of the user
+	// with the email, which is unique across organizations.
+	GetOrganizationIDByUserEmail(
+		ctx context.Context,
+		email string,
+	) (string, error)
+	GetOrganizationIDByCalendarFeedTokenHash(
+		ctx context.Context,
+		tokenHash string,
+	) (string, error)
+}
@@ -0,0 +1,55 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+)
+
+type CreateProjectParams struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	ManagerUserID string `json:"manager_user_id"`
+}
+
+type UpdateProjectParams struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	ManagerUserID string `json:"manager_user_id"`
+}
+
+type ProjectRepo interface {
+	CreateProject(ctx context.Context, params CreateProjectParams) error
+	GetProjectByID(ctx context.Context, id string) (entity.Project, error)
+	// ListProjectsByMemberUserID lists the projects the user is a member
+	// of ordered by name.
+	ListProjectsByMemberUserID(
+		ctx context.Context,
+		userID string,
+	) ([]entity.Project, error)
+	UpdateProject(ctx context.Context, params UpdateProjectParams) error
+	// DeleteProject deletes the project and its members, which can only
+	// be done once it has no tasks, deleted ones included.
+	DeleteProject(ctx context.Context, id string) error
+	// ProjectHasTasks reports whether any task, deleted ones included,
+	// belongs to the project.
+	ProjectHasTasks(ctx context.Context, id string) (bool, error)
+	// AddProjectMember adds the user to the project members, doing
+	// nothing if they already are one.
+	AddProjectMember(ctx context.Context, projectID, userID string) error
+	RemoveProjectMember(ctx context.Context, projectID, userID string) error
+	// ListProjectMembers lists the project members in the order they
+	// were added.
+	ListProjectMembers(
+		ctx context.Context,
+		projectID string,
+	) ([]entity.ProjectMember, error)
+	// GetProjectMember returns an empty member if the user is not one.
+	GetProjectMember(
+		ctx context.Context,
+		projectID string,
+		userID string,
+	) (entity.ProjectMember, error)
+}
@@ -0,0 +1,49 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+)
+
+type CreateRecurringTaskParams struct {
+	ID               string    `json:"id"`
+	Summary          string    `json:"summary"`
+	Schedule         string    `json:"schedule"`
+	AssignedToUserID *string   `json:"assigned_to_user_id"`
+	CreatedByUserID  string    `json:"created_by_user_id"`
+	NextRunAt        time.Time `json:"next_run_at"`
+}
+
+type RecurringTaskRepo interface {
+	CreateRecurringTask(
+		ctx context.Context,
+		params CreateRecurringTaskParams,
+	) error
+	GetRecurringTaskByID(
+		ctx context.Context,
+		id string,
+	) (entity.RecurringTask, error)
+	ListRecurringTasks(ctx context.Context) ([]entity.RecurringTask, error)
+	// ListDueRecurringTasks lists the recurring tasks whose next run is
+	// at or before the given time, the most overdue first.
+	ListDueRecurringTasks(
+		ctx context.Context,
+		now time.Time,
+	) ([]entity.RecurringTask, error)
+	UpdateRecurringTaskNextRunAt(
+		ctx context.Context,
+		id string,
+		nextRunAt time.Time,
+	) error
+	DeleteRecurringTask(ctx context.Context, id string) error
+	// ClaimRecurringTaskOccurrence records the occurrence of the recurring
+	// task scheduled at the given time, returning false if it was already
+	// claimed, so each occurrence is created once across replicas.
+	ClaimRecurringTaskOccurrence(
+		ctx context.Context,
+		id string,
+		scheduledAt time.Time,
+	) (bool, error)
+}
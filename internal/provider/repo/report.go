@@ -0,0 +1,31 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+)
+
+type TaskReportParams struct {
+	GroupBy entity.ReportGroupBy `json:"group_by"`
+	Bucket  entity.ReportBucket  `json:"bucket"`
+	// From and To bound the creation and finish dates counted, To being
+	// exclusive.
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+	// ProjectScoped keeps only the tasks without a project or in one of
+	// the ScopeProjectIDs.
+	ProjectScoped   bool     `json:"project_scoped"`
+	ScopeProjectIDs []string `json:"scope_project_ids"`
+}
+
+type ReportRepo interface {
+	// GetTaskReport aggregates the tasks that are not deleted into a row
+	// per user and bucket with any task created or finished in it,
+	// ordered by bucket and then by user ID.
+	GetTaskReport(
+		ctx context.Context,
+		params TaskReportParams,
+	) ([]entity.TaskReportRow, error)
+}
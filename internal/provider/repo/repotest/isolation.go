@@ -0,0 +1,578 @@
+// Package repotest holds the test suites shared by the implementations of
+// the repositories, so that each one is held to the same behavior.
+package repotest
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+	"github.com/danielmesquitta/tasks-api/internal/pkg/tenant"
+	"github.com/danielmesquitta/tasks-api/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+// Repos are the repositories the isolation suite runs against, which
+// must share the same storage.
+type Repos struct {
+	Organizations repo.OrganizationRepo
+	Users         repo.UserRepo
+	Labels        repo.LabelRepo
+	Tasks         repo.TaskRepo
+}
+
+// tenantRows are the rows created in one organization for a test.
+type tenantRows struct {
+	ctx     context.Context
+	orgID   string
+	userID  string
+	email   string
+	labelID string
+	taskID  string
+}
+
+// RunTenantIsolation checks that every TaskRepo and UserRepo method only
+// reads and writes the rows of the organization in the context. Each test
+// creates its rows in two new organizations and acts from the first one
+// on the rows of the second, which must be neither found nor changed.
+func RunTenantIsolation(t *testing.T, repos Repos) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	newOrganization := func(t *testing.T) context.Context {
+		t.Helper()
+
+		params := repo.CreateOrganizationParams{
+			ID:   uuid.NewString(),
+			Name: "Organization " + uuid.NewString(),
+		}
+		if err := repos.Organizations.CreateOrganization(
+			ctx,
+			params,
+		); err != nil {
+			t.Fatalf("CreateOrganization() error = %v", err)
+		}
+
+		return tenant.WithOrganizationID(ctx, params.ID)
+	}
+
+	// newRows creates a user, a label and an overdue task assigned to
+	// the user and labeled with the label in a new organization.
+	newRows := func(t *testing.T) tenantRows {
+		t.Helper()
+
+		orgCtx := newOrganization(t)
+		orgID, _ := tenant.OrganizationID(orgCtx)
+		email := uuid.NewString() + "@email.com"
+
+		if err := repos.Users.CreateUser(orgCtx, repo.CreateUserParams{
+			Name:     "John Doe",
+			Role:     entity.RoleTechnician,
+			Email:    email,
+			Password: "P@ssw0rd",
+		}); err != nil {
+			t.Fatalf("CreateUser() error = %v", err)
+		}
+
+		user, err := repos.Users.GetUserByEmail(orgCtx, email)
+		if err != nil || user.ID == "" {
+			t.Fatalf("GetUserByEmail() = %v, %v, want the user", user, err)
+		}
+
+		labelID := uuid.NewString()
+		if err := repos.Labels.CreateLabel(orgCtx, repo.CreateLabelParams{
+			ID:   labelID,
+			Name: uuid.NewString(),
+		}); err != nil {
+			t.Fatalf("CreateLabel() error = %v", err)
+		}
+
+		taskID := uuid.NewString()
+		dueAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+		if err := repos.Tasks.CreateTask(orgCtx, repo.CreateTaskParams{
+			ID:              taskID,
+			Summary:         "Summary",
+			CreatedByUserID: user.ID,
+			AssigneeIDs:     []string{user.ID},
+			DueAt:           &dueAt,
+			Priority:        entity.TaskPriorityNormal,
+			FinishPolicy:    entity.TaskFinishPolicyAll,
+		}); err != nil {
+			t.Fatalf("CreateTask() error = %v", err)
+		}
+
+		if err := repos.Tasks.SetTaskLabels(
+			orgCtx,
+			taskID,
+			[]string{labelID},
+		); err != nil {
+			t.Fatalf("SetTaskLabels() error = %v", err)
+		}
+
+		return tenantRows{
+			ctx:     orgCtx,
+			orgID:   orgID,
+			userID:  user.ID,
+			email:   email,
+			labelID: labelID,
+			taskID:  taskID,
+		}
+	}
+
+	getTask := func(t *testing.T, rows tenantRows) entity.Task {
+		t.Helper()
+
+		task, err := repos.Tasks.GetTaskByID(rows.ctx, rows.taskID)
+		if err != nil {
+			t.Fatalf("GetTaskByID() error = %v", err)
+		}
+		if task.ID == "" {
+			t.Fatalf("GetTaskByID() did not find the task in its organization")
+		}
+
+		return task
+	}
+
+	getDeletedTask := func(t *testing.T, rows tenantRows) entity.Task {
+		t.Helper()
+
+		task, err := repos.Tasks.GetDeletedTaskByID(rows.ctx, rows.taskID)
+		if err != nil {
+			t.Fatalf("GetDeletedTaskByID() error = %v", err)
+		}
+		if task.ID == "" {
+			t.Fatalf(
+				"GetDeletedTaskByID() did not find the task in its organization",
+			)
+		}
+
+		return task
+	}
+
+	deleteTask := func(t *testing.T, rows tenantRows) {
+		t.Helper()
+
+		if err := repos.Tasks.DeleteTask(rows.ctx, rows.taskID); err != nil {
+			t.Fatalf("DeleteTask() error = %v", err)
+		}
+	}
+
+	t.Run("UserRepo.GetUserByID", func(t *testing.T) {
+		a, b := newRows(t), newRows(t)
+
+		user, err := repos.Users.GetUserByID(a.ctx, b.userID)
+		if err != nil {
+			t.Fatalf("GetUserByID() error = %v", err)
+		}
+		if user.ID != "" {
+			t.Errorf("GetUserByID() found the user of another organization")
+		}
+
+		user, err = repos.Users.GetUserByID(a.ctx, a.userID)
+		if err != nil || user.ID != a.userID {
+			t.Errorf("GetUserByID() = %v, %v, want the user", user, err)
+		}
+	})
+
+	t.Run("UserRepo.GetUserByEmail", func(t *testing.T) {
+		a, b := newRows(t), newRows(t)
+
+		user, err := repos.Users.GetUserByEmail(a.ctx, b.email)
+		if err != nil {
+			t.Fatalf("GetUserByEmail() error = %v", err)
+		}
+		if user.ID != "" {
+			t.Errorf("GetUserByEmail() found the user of another organization")
+		}
+	})
+
+	t.Run("UserRepo.CreateUser", func(t *testing.T) {
+		a, b := newRows(t), newRows(t)
+
+		email := uuid.NewString() + "@email.com"
+		if err := repos.Users.CreateUser(a.ctx, repo.CreateUserParams{
+			Name:     "Jane Doe",
+			Role:     entity.RoleManager,
+			Email:    email,
+			Password: "P@ssw0rd",
+		}); err != nil {
+			t.Fatalf("CreateUser() error = %v", err)
+		}
+
+		user, err := repos.Users.GetUserByEmail(a.ctx, email)
+		if err != nil || user.OrganizationID != a.orgID {
+			t.Errorf(
+				"CreateUser() created %v, %v, want it in the organization",
+				user,
+				err,
+			)
+		}
+
+		user, err = repos.Users.GetUserByEmail(b.ctx, email)
+		if err != nil {
+			t.Fatalf("GetUserByEmail() error = %v", err)
+		}
+		if user.ID != "" {
+			t.Errorf("CreateUser() created the user in another organization")
+		}
+	})
+
+	t.Run("TaskRepo.GetTaskByID", func(t *testing.T) {
+		a, b := newRows(t), newRows(t)
+
+		task, err := repos.Tasks.GetTaskByID(a.ctx, b.taskID)
+		if err != nil {
+			t.Fatalf("GetTaskByID() error = %v", err)
+		}
+		if task.ID != "" {
+			t.Errorf("GetTaskByID() found the task of another organization")
+		}
+
+		if task := getTask(t, a); task.OrganizationID != a.orgID {
+			t.Errorf(
+				"GetTaskByID() organization = %v, want %v",
+				task.OrganizationID,
+				a.orgID,
+			)
+		}
+	})
+
+	t.Run("TaskRepo.GetDeletedTaskByID", func(t *testing.T) {
+		a, b := newRows(t), newRows(t)
+		deleteTask(t, b)
+
+		task, err := repos.Tasks.GetDeletedTaskByID(a.ctx, b.taskID)
+		if err != nil {
+			t.Fatalf("GetDeletedTaskByID() error = %v", err)
+		}
+		if task.ID != "" {
+			t.Errorf(
+				"GetDeletedTaskByID() found the task of another organization",
+			)
+		}
+	})
+
+	t.Run("TaskRepo.ListTasks", func(t *testing.T) {
+		a, b := newRows(t), newRows(t)
+		deleteTask(t, newRows(t))
+
+		listings := map[string][]repo.ListTasksOption{
+			"all":         nil,
+			"assigned":    {repo.WithAssignedToUserID(b.userID)},
+			"created by":  {repo.WithCreatedByUserID(b.userID)},
+			"labeled":     {repo.WithLabels(repo.LabelMatchAny, b.labelID)},
+			"deleted":     {repo.WithDeleted()},
+			"overdue":     {repo.WithOverdue(time.Now())},
+			"by due date": {repo.WithSort(repo.TaskSortByDueAt, repo.SortAsc)},
+		}
+
+		for name, opts := range listings {
+			tasks, err := repos.Tasks.ListTasks(a.ctx, opts...)
+			if err != nil {
+				t.Fatalf("ListTasks() %s error = %v", name, err)
+			}
+
+			for _, task := range tasks {
+				if task.OrganizationID != a.orgID {
+					t.Errorf(
+						"ListTasks() %s listed the task of another organization",
+						name,
+					)
+				}
+			}
+		}
+
+		tasks, err := repos.Tasks.ListTasks(a.ctx)
+		if err != nil {
+			t.Fatalf("ListTasks() error = %v", err)
+		}
+		if !slices.ContainsFunc(tasks, func(task entity.Task) bool {
+			return task.ID == a.taskID
+		}) {
+			t.Errorf("ListTasks() did not list the task of the organization")
+		}
+	})
+
+	t.Run("TaskRepo.CreateTask", func(t *testing.T) {
+		a, b := newRows(t), newRows(t)
+
+		task, err := repos.Tasks.GetTaskByID(b.ctx, a.taskID)
+		if err != nil {
+			t.Fatalf("GetTaskByID() error = %v", err)
+		}
+		if task.ID != "" {
+			t.Errorf("CreateTask() created the task in another organization")
+		}
+	})
+
+	t.Run("TaskRepo.UpdateTask", func(t *testing.T) {
+		a, b := newRows(t), newRows(t)
+		want := getTask(t, b)
+
+		err := repos.Tasks.UpdateTask(a.ctx, repo.UpdateTaskParams{
+			ID:           b.taskID,
+			Summary:      "Changed",
+			Status:       entity.TaskStatusInProgress,
+			Priority:     entity.TaskPriorityHigh,
+			FinishPolicy: entity.TaskFinishPolicyAny,
+			Version:      want.Version,
+		})
+		if !errors.Is(err, entity.ErrTaskVersionMismatch) {
+			t.Fatalf(
+				"UpdateTask() error = %v, want %v",
+				err,
+				entity.ErrTaskVersionMismatch,
+			)
+		}
+
+		got := getTask(t, b)
+		if got.Summary != want.Summary || got.Status != want.Status ||
+			got.Priority != want.Priority || got.DueAt == nil {
+			t.Errorf("UpdateTask() changed the task of another organization")
+		}
+	})
+
+	t.Run("TaskRepo.DeleteTask", func(t *testing.T) {
+		a, b := newRows(t), newRows(t)
+
+		if err := repos.Tasks.DeleteTask(a.ctx, b.taskID); err != nil {
+			t.Fatalf("DeleteTask() error = %v", err)
+		}
+
+		getTask(t, b)
+	})
+
+	t.Run("TaskRepo.RestoreTask", func(t *testing.T) {
+		a, b := newRows(t), newRows(t)
+		deleteTask(t, b)
+
+		if err := repos.Tasks.RestoreTask(a.ctx, b.taskID); err != nil {
+			t.Fatalf("RestoreTask() error = %v", err)
+		}
+
+		getDeletedTask(t, b)
+	})
+
+	t.Run("TaskRepo.PurgeDeletedTasks", func(t *testing.T) {
+		a, b := newRows(t), newRows(t)
+		deleteTask(t, b)
+
+		count, err := repos.Tasks.PurgeDeletedTasks(
+			a.ctx,
+			time.Now().Add(time.Hour),
+		)
+		if err != nil {
+			t.Fatalf("PurgeDeletedTasks() error = %v", err)
+		}
+		if count != 0 {
+			t.Errorf("PurgeDeletedTasks() = %v, want 0", count)
+		}
+
+		getDeletedTask(t, b)
+	})
+
+	t.Run("TaskRepo.SetTaskLabels", func(t *testing.T) {
+		a, b := newRows(t), newRows(t)
+
+		if err := repos.Tasks.SetTaskLabels(
+			a.ctx,
+			b.taskID,
+			[]string{a.labelID},
+		); err != nil {
+			t.Fatalf("SetTaskLabels() error = %v", err)
+		}
+
+		labelIDs, err := repos.Tasks.ListTaskLabelIDs(
+			b.ctx,
+			[]string{b.taskID},
+		)
+		if err != nil {
+			t.Fatalf("ListTaskLabelIDs() error = %v", err)
+		}
+		if !slices.Equal(labelIDs[b.taskID], []string{b.labelID}) {
+			t.Errorf(
+				"SetTaskLabels() changed the labels of another organization to %v",
+				labelIDs[b.taskID],
+			)
+		}
+	})
+
+	t.Run("TaskRepo.ListTaskLabelIDs", func(t *testing.T) {
+		a, b := newRows(t), newRows(t)
+
+		labelIDs, err := repos.Tasks.ListTaskLabelIDs(
+			a.ctx,
+			[]string{a.taskID, b.taskID},
+		)
+		if err != nil {
+			t.Fatalf("ListTaskLabelIDs() error = %v", err)
+		}
+		if _, ok := labelIDs[b.taskID]; ok {
+			t.Errorf(
+				"ListTaskLabelIDs() listed the labels of another organization",
+			)
+		}
+		if !slices.Equal(labelIDs[a.taskID], []string{a.labelID}) {
+			t.Errorf(
+				"ListTaskLabelIDs() = %v, want %v",
+				labelIDs[a.taskID],
+				[]string{a.labelID},
+			)
+		}
+	})
+
+	t.Run("TaskRepo.SetTaskAssignees", func(t *testing.T) {
+		a, b := newRows(t), newRows(t)
+
+		if err := repos.Tasks.SetTaskAssignees(
+			a.ctx,
+			b.taskID,
+			[]string{a.userID},
+		); err != nil {
+			t.Fatalf("SetTaskAssignees() error = %v", err)
+		}
+
+		if got := getTask(t, b).AssigneeIDs; !slices.Equal(
+			got,
+			[]string{b.userID},
+		) {
+			t.Errorf(
+				"SetTaskAssignees() changed the assignees of another organization to %v",
+				got,
+			)
+		}
+	})
+
+	t.Run("TaskRepo.SignOffTask", func(t *testing.T) {
+		a, b := newRows(t), newRows(t)
+
+		signedOff, err := repos.Tasks.SignOffTask(
+			a.ctx,
+			b.taskID,
+			b.userID,
+			time.Now(),
+		)
+		if err != nil {
+			t.Fatalf("SignOffTask() error = %v", err)
+		}
+		if signedOff {
+			t.Errorf("SignOffTask() signed off the task of another organization")
+		}
+
+		if got := getTask(t, b).SignedOffUserIDs; len(got) > 0 {
+			t.Errorf(
+				"SignOffTask() changed the sign-offs of another organization to %v",
+				got,
+			)
+		}
+	})
+
+	t.Run("TaskRepo.ClearTaskSignOffs", func(t *testing.T) {
+		a, b := newRows(t), newRows(t)
+
+		if _, err := repos.Tasks.SignOffTask(
+			b.ctx,
+			b.taskID,
+			b.userID,
+			time.Now(),
+		); err != nil {
+			t.Fatalf("SignOffTask() error = %v", err)
+		}
+
+		if err := repos.Tasks.ClearTaskSignOffs(a.ctx, b.taskID); err != nil {
+			t.Fatalf("ClearTaskSignOffs() error = %v", err)
+		}
+
+		if got := getTask(t, b).SignedOffUserIDs; !slices.Equal(
+			got,
+			[]string{b.userID},
+		) {
+			t.Errorf(
+				"ClearTaskSignOffs() changed the sign-offs of another organization to %v",
+				got,
+			)
+		}
+	})
+
+	t.Run("TaskRepo.SetTaskWorkSessionsUnlocked", func(t *testing.T) {
+		a, b := newRows(t), newRows(t)
+
+		if err := repos.Tasks.SetTaskWorkSessionsUnlocked(
+			a.ctx,
+			b.taskID,
+			true,
+		); err != nil {
+			t.Fatalf("SetTaskWorkSessionsUnlocked() error = %v", err)
+		}
+
+		if getTask(t, b).WorkSessionsUnlocked {
+			t.Errorf(
+				"SetTaskWorkSessionsUnlocked() unlocked the task of another organization",
+			)
+		}
+	})
+
+	t.Run("TaskRepo.ListOverdueTasksToNotify", func(t *testing.T) {
+		a, _ := newRows(t), newRows(t)
+
+		tasks, err := repos.Tasks.ListOverdueTasksToNotify(
+			a.ctx,
+			time.Now(),
+			1000,
+		)
+		if err != nil {
+			t.Fatalf("ListOverdueTasksToNotify() error = %v", err)
+		}
+
+		for _, task := range tasks {
+			if task.OrganizationID != a.orgID {
+				t.Errorf(
+					"ListOverdueTasksToNotify() listed the task of another organization",
+				)
+			}
+		}
+
+		if !slices.ContainsFunc(tasks, func(task entity.Task) bool {
+			return task.ID == a.taskID
+		}) {
+			t.Errorf(
+				"ListOverdueTasksToNotify() did not list the task of the organization",
+			)
+		}
+	})
+
+	t.Run("TaskRepo.MarkTaskOverdueNotified", func(t *testing.T) {
+		a, b := newRows(t), newRows(t)
+
+		marked, err := repos.Tasks.MarkTaskOverdueNotified(
+			a.ctx,
+			b.taskID,
+			time.Now(),
+		)
+		if err != nil {
+			t.Fatalf("MarkTaskOverdueNotified() error = %v", err)
+		}
+		if marked {
+			t.Errorf(
+				"MarkTaskOverdueNotified() marked the task of another organization",
+			)
+		}
+
+		marked, err = repos.Tasks.MarkTaskOverdueNotified(
+			b.ctx,
+			b.taskID,
+			time.Now(),
+		)
+		if err != nil || !marked {
+			t.Errorf(
+				"MarkTaskOverdueNotified() = %v, %v, want the task marked",
+				marked,
+				err,
+			)
+		}
+	})
+}
@@ -8,32 +8,321 @@ import (
 )
 
 type CreateTaskParams struct {
-	Summary          string  `json:"summary"`
-	CreatedByUserID  string  `json:"created_by_user_id"`
-	AssignedToUserID *string `json:"assigned_to_user_id"`
+	ID              string                  `json:"id"`
+	Summary         string                  `json:"summary"`
+	CreatedByUserID string                  `json:"created_by_user_id"`
+	AssigneeIDs     []string                `json:"assignee_ids"`
+	DueAt           *time.Time              `json:"due_at"`
+	Priority        entity.TaskPriority     `json:"priority"`
+	FinishPolicy    entity.TaskFinishPolicy `json:"finish_policy"`
+	ProjectID       *string                 `json:"project_id"`
 }
 
+// UpdateTaskParams does not hold the assignees, which are replaced with
+// TaskRepo.SetTaskAssignees. Version is the version of the task the
+// changes were made on.
 type UpdateTaskParams struct {
-	ID               string    `json:"id"`
-	Summary          string    `json:"summary"`
-	AssignedToUserID *string   `json:"assigned_to_user_id"`
-	FinishedAt       time.Time `json:"finished_at"`
+	ID           string                  `json:"id"`
+	Summary      string                  `json:"summary"`
+	Status       entity.TaskStatus       `json:"status"`
+	FinishedAt   *time.Time              `json:"finished_at"`
+	ReopenReason *string                 `json:"reopen_reason"`
+	ReopenedAt   *time.Time              `json:"reopened_at"`
+	DueAt        *time.Time              `json:"due_at"`
+	Priority     entity.TaskPriority     `json:"priority"`
+	FinishPolicy entity.TaskFinishPolicy `json:"finish_policy"`
+	ProjectID    *string                 `json:"project_id"`
+	Version      int64                   `json:"version"`
+}
+
+type TaskSortField string
+
+const (
+	TaskSortByCreatedAt  TaskSortField = "created_at"
+	TaskSortByUpdatedAt  TaskSortField = "updated_at"
+	TaskSortByFinishedAt TaskSortField = "finished_at"
+	TaskSortByDueAt      TaskSortField = "due_at"
+	// TaskSortByPriority sorts tasks from the least to the most urgent
+	// priority in ascending order.
+	TaskSortByPriority TaskSortField = "priority"
+)
+
+type SortDirection string
+
+const (
+	SortAsc  SortDirection = "asc"
+	SortDesc SortDirection = "desc"
+)
+
+// MissingDateSortValue is the value tasks take when sorting by a date
+// they do not have, such as unfinished tasks by finished date, placing
+// them after the ones that have it.
+var MissingDateSortValue = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+
+// SortValue returns the task value for the sort fields that are dates,
+// and the zero time for the other ones.
+func (f TaskSortField) SortValue(task entity.Task) time.Time {
+	switch f {
+	case TaskSortByUpdatedAt:
+		return task.UpdatedAt
+	case TaskSortByFinishedAt:
+		if task.FinishedAt == nil {
+			return MissingDateSortValue
+		}
+		return *task.FinishedAt
+	case TaskSortByDueAt:
+		if task.DueAt == nil {
+			return MissingDateSortValue
+		}
+		return *task.DueAt
+	case TaskSortByPriority:
+		return time.Time{}
+	default:
+		return task.CreatedAt
+	}
+}
+
+// SortRank returns the task value for the sort fields that are not
+// dates, which is the priority rank, and 0 for the other ones.
+func (f TaskSortField) SortRank(task entity.Task) int {
+	if f == TaskSortByPriority {
+		return task.Priority.Rank()
+	}
+	return 0
+}
+
+// LabelMatch tells whether listed tasks must have any or all of the
+// labels filtered by.
+type LabelMatch string
+
+const (
+	LabelMatchAny LabelMatch = "any"
+	LabelMatchAll LabelMatch = "all"
+)
+
+// TaskCursor points to a task in the listing, which is ordered
+// by the sort field value and ID. Value holds the value of date sort
+// fields and Rank the value of the other ones.
+type TaskCursor struct {
+	Value time.Time `json:"value"`
+	Rank  int       `json:"rank,omitempty"`
+	ID    string    `json:"id"`
+}
+
+// NewTaskCursor returns the cursor pointing to the task in a listing
+// sorted by the field.
+func NewTaskCursor(field TaskSortField, task entity.Task) TaskCursor {
+	return TaskCursor{
+		Value: field.SortValue(task),
+		Rank:  field.SortRank(task),
+		ID:    task.ID,
+	}
 }
 
 type ListTasksParams struct {
-	AssignedToUserID string `json:"assigned_to_user_id"`
+	AssignedToUserID string                `json:"assigned_to_user_id"`
+	CreatedByUserID  string                `json:"created_by_user_id"`
+	Unassigned       bool                  `json:"unassigned"`
+	Finished         *bool                 `json:"finished"`
+	Deleted          bool                  `json:"deleted"`
+	CreatedFrom      *time.Time            `json:"created_from"`
+	CreatedTo        *time.Time            `json:"created_to"`
+	FinishedFrom     *time.Time            `json:"finished_from"`
+	FinishedTo       *time.Time            `json:"finished_to"`
+	DueFrom          *time.Time            `json:"due_from"`
+	DueTo            *time.Time            `json:"due_to"`
+	Priorities       []entity.TaskPriority `json:"priorities"`
+	// OverdueAt keeps only the tasks overdue at the time.
+	OverdueAt  *time.Time `json:"overdue_at"`
+	LabelIDs   []string   `json:"label_ids"`
+	LabelMatch LabelMatch `json:"label_match"`
+	ProjectID  string     `json:"project_id"`
+	// ProjectScoped keeps only the tasks without a project or in one of
+	// the ScopeProjectIDs.
+	ProjectScoped   bool          `json:"project_scoped"`
+	ScopeProjectIDs []string      `json:"scope_project_ids"`
+	SortBy          TaskSortField `json:"sort_by"`
+	SortDirection   SortDirection `json:"sort_direction"`
+	Limit           int           `json:"limit"`
+	After           *TaskCursor   `json:"after"`
+	Before          *TaskCursor   `json:"before"`
 }
 
 type ListTasksOption func(*ListTasksParams)
 
+// NewListTasksParams applies the options over the default
+// params, which sort tasks by creation date in ascending order.
+func NewListTasksParams(opts ...ListTasksOption) ListTasksParams {
+	params := ListTasksParams{
+		SortBy:        TaskSortByCreatedAt,
+		SortDirection: SortAsc,
+		LabelMatch:    LabelMatchAny,
+	}
+	for _, opt := range opts {
+		opt(&params)
+	}
+	return params
+}
+
+// WithAssignedToUserID returns only the tasks the user is one of the
+// assignees of.
 func WithAssignedToUserID(assignedToUserID string) ListTasksOption {
 	return func(params *ListTasksParams) {
 		params.AssignedToUserID = assignedToUserID
 	}
 }
 
+func WithCreatedByUserID(createdByUserID string) ListTasksOption {
+	return func(params *ListTasksParams) {
+		params.CreatedByUserID = createdByUserID
+	}
+}
+
+// WithUnassigned returns only the tasks not assigned to any user.
+func WithUnassigned() ListTasksOption {
+	return func(params *ListTasksParams) {
+		params.Unassigned = true
+	}
+}
+
+// WithFinished returns only the finished tasks if finished is true,
+// or only the open ones otherwise.
+func WithFinished(finished bool) ListTasksOption {
+	return func(params *ListTasksParams) {
+		params.Finished = &finished
+	}
+}
+
+// WithDeleted returns only the deleted tasks, which are
+// left out of the listing otherwise.
+func WithDeleted() ListTasksOption {
+	return func(params *ListTasksParams) {
+		params.Deleted = true
+	}
+}
+
+// WithCreatedFrom returns only the tasks created at or after the date.
+func WithCreatedFrom(from time.Time) ListTasksOption {
+	return func(params *ListTasksParams) {
+		params.CreatedFrom = &from
+	}
+}
+
+// WithCreatedTo returns only the tasks created at or before the date.
+func WithCreatedTo(to time.Time) ListTasksOption {
+	return func(params *ListTasksParams) {
+		params.CreatedTo = &to
+	}
+}
+
+// WithFinishedFrom returns only the tasks finished at or after the date.
+func WithFinishedFrom(from time.Time) ListTasksOption {
+	return func(params *ListTasksParams) {
+		params.FinishedFrom = &from
+	}
+}
+
+// WithFinishedTo returns only the tasks finished at or before the date.
+func WithFinishedTo(to time.Time) ListTasksOption {
+	return func(params *ListTasksParams) {
+		params.FinishedTo = &to
+	}
+}
+
+// WithDueFrom returns only the tasks due at or after the date.
+func WithDueFrom(from time.Time) ListTasksOption {
+	return func(params *ListTasksParams) {
+		params.DueFrom = &from
+	}
+}
+
+// WithDueTo returns only the tasks due at or before the date.
+func WithDueTo(to time.Time) ListTasksOption {
+	return func(params *ListTasksParams) {
+		params.DueTo = &to
+	}
+}
+
+// WithPriorities returns only the tasks with any of the priorities.
+func WithPriorities(priorities ...entity.TaskPriority) ListTasksOption {
+	return func(params *ListTasksParams) {
+		params.Priorities = priorities
+	}
+}
+
+// WithOverdue returns only the tasks past their due date at now and
+// not done yet.
+func WithOverdue(now time.Time) ListTasksOption {
+	return func(params *ListTasksParams) {
+		params.OverdueAt = &now
+	}
+}
+
+// WithProjectID returns only the tasks of the project.
+func WithProjectID(projectID string) ListTasksOption {
+	return func(params *ListTasksParams) {
+		params.ProjectID = projectID
+	}
+}
+
+// WithProjectScope returns only the tasks without a project or in one
+// of the projects, which are the tasks a project member can see.
+func WithProjectScope(projectIDs ...string) ListTasksOption {
+	return func(params *ListTasksParams) {
+		params.ProjectScoped = true
+		params.ScopeProjectIDs = projectIDs
+	}
+}
+
+// WithLabels filters tasks labeled with any or all of the labels.
+func WithLabels(match LabelMatch, labelIDs ...string) ListTasksOption {
+	return func(params *ListTasksParams) {
+		params.LabelMatch = match
+		params.LabelIDs = labelIDs
+	}
+}
+
+// WithSort sorts the tasks by the field in the given direction,
+// using the task ID to break ties.
+func WithSort(field TaskSortField, direction SortDirection) ListTasksOption {
+	return func(params *ListTasksParams) {
+		params.SortBy = field
+		params.SortDirection = direction
+	}
+}
+
+// WithLimit limits the number of tasks returned.
+func WithLimit(limit int) ListTasksOption {
+	return func(params *ListTasksParams) {
+		params.Limit = limit
+	}
+}
+
+// WithAfter returns only the tasks placed after the cursor.
+func WithAfter(cursor TaskCursor) ListTasksOption {
+	return func(params *ListTasksParams) {
+		params.After = &cursor
+		params.Before = nil
+	}
+}
+
+// WithBefore returns only the tasks placed before the cursor.
+// Tasks are still returned in the listing order, but the limit is
+// applied starting from the cursor.
+func WithBefore(cursor TaskCursor) ListTasksOption {
+	return func(params *ListTasksParams) {
+		params.Before = &cursor
+		params.After = nil
+	}
+}
+
+// TaskRepo methods ignore deleted tasks, unless stated otherwise. The
+// tasks they return hold their assignees and sign-offs.
 type TaskRepo interface {
 	GetTaskByID(ctx context.Context, id string) (entity.Task, error)
+	GetDeletedTaskByID(ctx context.Context, id string) (entity.Task, error)
+	// ListTasks lists tasks ordered by creation date and ID,
+	// unless a sort option is given.
 	ListTasks(
 		ctx context.Context,
 		opts ...ListTasksOption,
@@ -42,9 +331,74 @@ type TaskRepo interface {
 		ctx context.Context,
 		params CreateTaskParams,
 	) error
+	// UpdateTask replaces the task fields and increments its version.
+	// Changing the due date lets the task be notified as overdue again.
+	// It returns entity.ErrTaskVersionMismatch if the task is no longer
+	// at the given version.
 	UpdateTask(
 		ctx context.Context,
 		params UpdateTaskParams,
 	) error
+	// DeleteTask moves the task to the trash, from where it can be
+	// restored until it is purged.
 	DeleteTask(ctx context.Context, id string) error
+	RestoreTask(ctx context.Context, id string) error
+	// PurgeDeletedTasks permanently deletes the tasks deleted before
+	// the given date, returning how many were purged.
+	PurgeDeletedTasks(
+		ctx context.Context,
+		deletedBefore time.Time,
+	) (int64, error)
+	// SetTaskLabels replaces the labels of the task.
+	SetTaskLabels(
+		ctx context.Context,
+		taskID string,
+		labelIDs []string,
+	) error
+	// ListTaskLabelIDs returns the label IDs of the tasks by task ID,
+	// including deleted tasks.
+	ListTaskLabelIDs(
+		ctx context.Context,
+		taskIDs []string,
+	) (map[string][]string, error)
+	// SetTaskAssignees replaces the assignees of the task, in order,
+	// keeping the sign-offs of the ones that remain.
+	SetTaskAssignees(
+		ctx context.Context,
+		taskID string,
+		userIDs []string,
+	) error
+	// SignOffTask records that the assignee finished the task. It
+	// returns false if they already had.
+	SignOffTask(
+		ctx context.Context,
+		taskID string,
+		userID string,
+		signedOffAt time.Time,
+	) (bool, error)
+	// ClearTaskSignOffs removes the sign-offs of every task assignee.
+	ClearTaskSignOffs(ctx context.Context, taskID string) error
+	// SetTaskWorkSessionsUnlocked sets whether the work sessions of the
+	// task can be changed after it is done.
+	SetTaskWorkSessionsUnlocked(
+		ctx context.Context,
+		taskID string,
+		unlocked bool,
+	) error
+	// ListOverdueTasksToNotify lists up to limit tasks overdue at now
+	// that were not notified as overdue since their due date was set,
+	// ordered by due date.
+	ListOverdueTasksToNotify(
+		ctx context.Context,
+		now time.Time,
+		limit int,
+	) ([]entity.Task, error)
+	// MarkTaskOverdueNotified records that the task was notified as
+	// overdue. It returns false if it already was, so that only one
+	// replica notifies it.
+	MarkTaskOverdueNotified(
+		ctx context.Context,
+		id string,
+		notifiedAt time.Time,
+	) (bool, error)
 }
@@ -0,0 +1,36 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+)
+
+type TaskDependencyParams struct {
+	TaskID          string `json:"task_id"`
+	BlockedByTaskID string `json:"blocked_by_task_id"`
+}
+
+type TaskDependencyRepo interface {
+	// CreateTaskDependency does nothing if the dependency already exists.
+	CreateTaskDependency(
+		ctx context.Context,
+		params TaskDependencyParams,
+	) error
+	DeleteTaskDependency(
+		ctx context.Context,
+		params TaskDependencyParams,
+	) error
+	// ListTaskBlockers lists the dependencies on the tasks blocking
+	// the task, oldest first.
+	ListTaskBlockers(
+		ctx context.Context,
+		taskID string,
+	) ([]entity.TaskDependency, error)
+	// ListTaskDependents lists the dependencies of the tasks blocked
+	// by the task, oldest first.
+	ListTaskDependents(
+		ctx context.Context,
+		blockedByTaskID string,
+	) ([]entity.TaskDependency, error)
+}
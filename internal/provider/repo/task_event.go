@@ -0,0 +1,26 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+)
+
+type CreateTaskEventParams struct {
+	TaskID      string                   `json:"task_id"`
+	ActorUserID string                   `json:"actor_user_id"`
+	Type        entity.TaskEventType     `json:"type"`
+	Changes     []entity.TaskFieldChange `json:"changes"`
+}
+
+type TaskEventRepo interface {
+	CreateTaskEvent(
+		ctx context.Context,
+		params CreateTaskEventParams,
+	) error
+	// ListTaskEvents lists the events of the task, oldest first.
+	ListTaskEvents(
+		ctx context.Context,
+		taskID string,
+	) ([]entity.TaskEvent, error)
+}
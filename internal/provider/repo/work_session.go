@@ -0,0 +1,73 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/tasks-api/internal/domain/entity"
+)
+
+type CreateWorkSessionParams struct {
+	ID        string     `json:"id"`
+	TaskID    string     `json:"task_id"`
+	UserID    string     `json:"user_id"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at"`
+	Note      *string    `json:"note"`
+	Manual    bool       `json:"manual"`
+}
+
+type UpdateWorkSessionParams struct {
+	ID        string     `json:"id"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at"`
+	Note      *string    `json:"note"`
+}
+
+type SumWorkTimeByUserParams struct {
+	// StartedFrom and StartedTo bound the start of the sessions summed,
+	// StartedTo being exclusive.
+	StartedFrom time.Time `json:"started_from"`
+	StartedTo   time.Time `json:"started_to"`
+	// Now is the time running timers count up to.
+	Now time.Time `json:"now"`
+	// ProjectScoped keeps only the sessions of the tasks without a project
+	// or in one of the ScopeProjectIDs.
+	ProjectScoped   bool     `json:"project_scoped"`
+	ScopeProjectIDs []string `json:"scope_project_ids"`
+}
+
+type WorkSessionRepo interface {
+	CreateWorkSession(
+		ctx context.Context,
+		params CreateWorkSessionParams,
+	) error
+	GetWorkSessionByID(
+		ctx context.Context,
+		id string,
+	) (entity.WorkSession, error)
+	// GetRunningWorkSession returns the timer the user is running, on
+	// any task.
+	GetRunningWorkSession(
+		ctx context.Context,
+		userID string,
+	) (entity.WorkSession, error)
+	// ListWorkSessions lists the work sessions of the task, ordered by
+	// start.
+	ListWorkSessions(
+		ctx context.Context,
+		taskID string,
+	) ([]entity.WorkSession, error)
+	UpdateWorkSession(
+		ctx context.Context,
+		params UpdateWorkSessionParams,
+	) error
+	DeleteWorkSession(ctx context.Context, id string) error
+	// SumWorkTimeByUser returns the time worked by each technician in the
+	// sessions started in the period, ordered by user ID. The sessions of
+	// deleted tasks are left out.
+	SumWorkTimeByUser(
+		ctx context.Context,
+		params SumWorkTimeByUserParams,
+	) ([]entity.WorkTimeTotal, error)
+}
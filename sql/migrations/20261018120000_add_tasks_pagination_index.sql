@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX idx_tasks_created_at_id ON `tasks` (created_at, id);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_tasks_created_at_id ON `tasks`;
+-- +goose StatementEnd
\ No newline at end of file
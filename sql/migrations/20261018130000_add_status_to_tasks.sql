@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `tasks`
+ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'open',
+  ADD CONSTRAINT chk_status CHECK (
+    status IN ('open', 'in_progress', 'blocked', 'in_review', 'done')
+  );
+-- +goose StatementEnd
+-- +goose StatementBegin
+UPDATE `tasks`
+SET status = 'done'
+WHERE finished_at IS NOT NULL;
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `tasks` DROP CONSTRAINT chk_status,
+  DROP COLUMN status;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `tasks`
+ADD COLUMN reopen_reason TEXT,
+  ADD COLUMN reopened_at TIMESTAMP NULL;
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `tasks` DROP COLUMN reopen_reason,
+  DROP COLUMN reopened_at;
+-- +goose StatementEnd
\ No newline at end of file
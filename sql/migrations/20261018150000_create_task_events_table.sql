@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `task_events` (
+  id VARCHAR(36) NOT NULL PRIMARY KEY DEFAULT (UUID()),
+  task_id VARCHAR(36) NOT NULL,
+  actor_user_id VARCHAR(36) NOT NULL,
+  type VARCHAR(20) NOT NULL,
+  changes JSON NOT NULL,
+  created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  INDEX idx_task_events_task_id_created_at (task_id, created_at)
+);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `task_events`;
+-- +goose StatementEnd
\ No newline at end of file
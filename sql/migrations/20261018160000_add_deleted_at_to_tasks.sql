@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `tasks`
+ADD COLUMN deleted_at TIMESTAMP NULL;
+-- +goose StatementEnd
+-- +goose StatementBegin
+CREATE INDEX idx_tasks_deleted_at ON `tasks` (deleted_at);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_tasks_deleted_at ON `tasks`;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `tasks` DROP COLUMN deleted_at;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `task_attachments` (
+  id VARCHAR(36) NOT NULL PRIMARY KEY DEFAULT (UUID()),
+  task_id VARCHAR(36) NOT NULL,
+  user_id VARCHAR(36) NOT NULL,
+  file_name VARCHAR(255) NOT NULL,
+  content_type VARCHAR(255) NOT NULL,
+  size BIGINT NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  INDEX idx_task_attachments_task_id_created_at (task_id, created_at),
+  CONSTRAINT fk_task_attachments_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+  CONSTRAINT fk_task_attachments_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `task_attachments`;
+-- +goose StatementEnd
\ No newline at end of file
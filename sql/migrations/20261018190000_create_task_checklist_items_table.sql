@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `task_checklist_items` (
+  id VARCHAR(36) NOT NULL PRIMARY KEY DEFAULT (UUID()),
+  task_id VARCHAR(36) NOT NULL,
+  position INT NOT NULL,
+  title TEXT NOT NULL,
+  required BOOLEAN NOT NULL DEFAULT FALSE,
+  done BOOLEAN NOT NULL DEFAULT FALSE,
+  done_at TIMESTAMP NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  INDEX idx_task_checklist_items_task_id_position (task_id, position),
+  CONSTRAINT fk_task_checklist_items_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `task_checklist_items`;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `task_dependencies` (
+  task_id VARCHAR(36) NOT NULL,
+  blocked_by_task_id VARCHAR(36) NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (task_id, blocked_by_task_id),
+  INDEX idx_task_dependencies_blocked_by_task_id (blocked_by_task_id),
+  CONSTRAINT fk_task_dependencies_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+  CONSTRAINT fk_task_dependencies_blocked_by_task FOREIGN KEY (blocked_by_task_id) REFERENCES tasks(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `task_dependencies`;
+-- +goose StatementEnd
\ No newline at end of file
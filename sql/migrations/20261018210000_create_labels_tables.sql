@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `labels` (
+  id VARCHAR(36) NOT NULL PRIMARY KEY DEFAULT (UUID()),
+  name VARCHAR(50) NOT NULL UNIQUE,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `task_labels` (
+  task_id VARCHAR(36) NOT NULL,
+  label_id VARCHAR(36) NOT NULL,
+  PRIMARY KEY (task_id, label_id),
+  INDEX idx_task_labels_label_id (label_id),
+  CONSTRAINT fk_task_labels_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+  CONSTRAINT fk_task_labels_label FOREIGN KEY (label_id) REFERENCES labels(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `task_labels`;
+-- +goose StatementEnd
+-- +goose StatementBegin
+DROP TABLE `labels`;
+-- +goose StatementEnd
\ No newline at end of file
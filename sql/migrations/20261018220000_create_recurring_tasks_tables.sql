@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `recurring_tasks` (
+  id VARCHAR(36) NOT NULL PRIMARY KEY DEFAULT (UUID()),
+  summary TEXT NOT NULL,
+  schedule VARCHAR(100) NOT NULL,
+  assigned_to_user_id VARCHAR(36),
+  created_by_user_id VARCHAR(36) NOT NULL,
+  next_run_at TIMESTAMP NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  INDEX idx_recurring_tasks_next_run_at (next_run_at),
+  CONSTRAINT fk_recurring_tasks_assigned_to_user FOREIGN KEY (assigned_to_user_id) REFERENCES users(id) ON DELETE
+  SET NULL,
+    CONSTRAINT fk_recurring_tasks_created_by_user FOREIGN KEY (created_by_user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `recurring_task_occurrences` (
+  recurring_task_id VARCHAR(36) NOT NULL,
+  scheduled_at TIMESTAMP NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (recurring_task_id, scheduled_at),
+  CONSTRAINT fk_recurring_task_occurrences_recurring_task FOREIGN KEY (recurring_task_id) REFERENCES recurring_tasks(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `recurring_task_occurrences`;
+-- +goose StatementEnd
+-- +goose StatementBegin
+DROP TABLE `recurring_tasks`;
+-- +goose StatementEnd
\ No newline at end of file
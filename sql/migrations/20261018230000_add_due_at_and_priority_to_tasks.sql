@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `tasks`
+ADD COLUMN due_at TIMESTAMP NULL,
+  ADD COLUMN priority VARCHAR(20) NOT NULL DEFAULT 'normal',
+  ADD COLUMN overdue_notified_at TIMESTAMP NULL,
+  ADD CONSTRAINT chk_priority CHECK (
+    priority IN ('low', 'normal', 'high', 'critical')
+  );
+-- +goose StatementEnd
+-- +goose StatementBegin
+CREATE INDEX idx_tasks_due_at_id ON `tasks` (due_at, id);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_tasks_due_at_id ON `tasks`;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `tasks` DROP CONSTRAINT chk_priority,
+  DROP COLUMN overdue_notified_at,
+  DROP COLUMN priority,
+  DROP COLUMN due_at;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `task_assignees` (
+  task_id VARCHAR(36) NOT NULL,
+  user_id VARCHAR(36) NOT NULL,
+  position SMALLINT UNSIGNED NOT NULL,
+  signed_off_at TIMESTAMP NULL,
+  PRIMARY KEY (task_id, user_id),
+  INDEX idx_task_assignees_user_id (user_id),
+  CONSTRAINT fk_task_assignees_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+  CONSTRAINT fk_task_assignees_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+-- +goose StatementBegin
+INSERT INTO task_assignees (task_id, user_id, position, signed_off_at)
+SELECT id,
+  assigned_to_user_id,
+  0,
+  finished_at
+FROM tasks
+WHERE assigned_to_user_id IS NOT NULL;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `tasks` DROP FOREIGN KEY fk_assigned_to_user,
+  DROP COLUMN assigned_to_user_id,
+  ADD COLUMN finish_policy VARCHAR(10) NOT NULL DEFAULT 'any',
+  ADD CONSTRAINT chk_finish_policy CHECK (finish_policy IN ('any', 'all'));
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `tasks` DROP CHECK chk_finish_policy,
+  DROP COLUMN finish_policy,
+  ADD COLUMN assigned_to_user_id VARCHAR(36) AFTER summary,
+  ADD CONSTRAINT fk_assigned_to_user FOREIGN KEY (assigned_to_user_id) REFERENCES users(id) ON DELETE
+SET NULL;
+-- +goose StatementEnd
+-- +goose StatementBegin
+UPDATE tasks
+  JOIN task_assignees ON task_assignees.task_id = tasks.id
+  AND task_assignees.position = 0
+SET tasks.assigned_to_user_id = task_assignees.user_id;
+-- +goose StatementEnd
+-- +goose StatementBegin
+DROP TABLE `task_assignees`;
+-- +goose StatementEnd
\ No newline at end of file
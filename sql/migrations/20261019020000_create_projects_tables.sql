@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `projects` (
+  id VARCHAR(36) NOT NULL PRIMARY KEY DEFAULT (UUID()),
+  name VARCHAR(100) NOT NULL,
+  description VARCHAR(2500) NOT NULL DEFAULT '',
+  manager_user_id VARCHAR(36) NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  CONSTRAINT fk_projects_manager_user FOREIGN KEY (manager_user_id) REFERENCES users(id)
+);
+-- +goose StatementEnd
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `project_members` (
+  project_id VARCHAR(36) NOT NULL,
+  user_id VARCHAR(36) NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (project_id, user_id),
+  INDEX idx_project_members_user_id (user_id),
+  CONSTRAINT fk_project_members_project FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+  CONSTRAINT fk_project_members_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `tasks`
+ADD COLUMN project_id VARCHAR(36) NULL,
+  ADD INDEX idx_tasks_project_id (project_id),
+  ADD CONSTRAINT fk_task_project FOREIGN KEY (project_id) REFERENCES projects(id);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `tasks` DROP FOREIGN KEY fk_task_project,
+  DROP INDEX idx_tasks_project_id,
+  DROP COLUMN project_id;
+-- +goose StatementEnd
+-- +goose StatementBegin
+DROP TABLE `project_members`;
+-- +goose StatementEnd
+-- +goose StatementBegin
+DROP TABLE `projects`;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,134 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `organizations` (
+  id VARCHAR(36) NOT NULL PRIMARY KEY DEFAULT (UUID()),
+  name VARCHAR(255) NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+-- +goose StatementBegin
+INSERT INTO organizations (name)
+VALUES ('Default');
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `users`
+ADD COLUMN organization_id VARCHAR(36) NULL;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `tasks`
+ADD COLUMN organization_id VARCHAR(36) NULL;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `labels`
+ADD COLUMN organization_id VARCHAR(36) NULL;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `projects`
+ADD COLUMN organization_id VARCHAR(36) NULL;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `recurring_tasks`
+ADD COLUMN organization_id VARCHAR(36) NULL;
+-- +goose StatementEnd
+-- +goose StatementBegin
+UPDATE users
+SET organization_id = (
+    SELECT id
+    FROM organizations
+    LIMIT 1
+  );
+-- +goose StatementEnd
+-- +goose StatementBegin
+UPDATE tasks
+SET organization_id = (
+    SELECT id
+    FROM organizations
+    LIMIT 1
+  );
+-- +goose StatementEnd
+-- +goose StatementBegin
+UPDATE labels
+SET organization_id = (
+    SELECT id
+    FROM organizations
+    LIMIT 1
+  );
+-- +goose StatementEnd
+-- +goose StatementBegin
+UPDATE projects
+SET organization_id = (
+    SELECT id
+    FROM organizations
+    LIMIT 1
+  );
+-- +goose StatementEnd
+-- +goose StatementBegin
+UPDATE recurring_tasks
+SET organization_id = (
+    SELECT id
+    FROM organizations
+    LIMIT 1
+  );
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `users`
+MODIFY organization_id VARCHAR(36) NOT NULL,
+  ADD INDEX idx_users_organization_id (organization_id),
+  ADD CONSTRAINT fk_users_organization FOREIGN KEY (organization_id) REFERENCES organizations(id);
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `tasks`
+MODIFY organization_id VARCHAR(36) NOT NULL,
+  ADD INDEX idx_tasks_organization_id (organization_id, created_at, id),
+  ADD CONSTRAINT fk_tasks_organization FOREIGN KEY (organization_id) REFERENCES organizations(id);
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `labels`
+MODIFY organization_id VARCHAR(36) NOT NULL,
+  DROP INDEX name,
+  ADD UNIQUE INDEX idx_labels_organization_id_name (organization_id, name),
+  ADD CONSTRAINT fk_labels_organization FOREIGN KEY (organization_id) REFERENCES organizations(id);
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `projects`
+MODIFY organization_id VARCHAR(36) NOT NULL,
+  ADD INDEX idx_projects_organization_id (organization_id),
+  ADD CONSTRAINT fk_projects_organization FOREIGN KEY (organization_id) REFERENCES organizations(id);
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `recurring_tasks`
+MODIFY organization_id VARCHAR(36) NOT NULL,
+  ADD INDEX idx_recurring_tasks_organization_id (organization_id, next_run_at),
+  ADD CONSTRAINT fk_recurring_tasks_organization FOREIGN KEY (organization_id) REFERENCES organizations(id);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `recurring_tasks` DROP FOREIGN KEY fk_recurring_tasks_organization,
+  DROP INDEX idx_recurring_tasks_organization_id,
+  DROP COLUMN organization_id;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `projects` DROP FOREIGN KEY fk_projects_organization,
+  DROP INDEX idx_projects_organization_id,
+  DROP COLUMN organization_id;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `labels` DROP FOREIGN KEY fk_labels_organization,
+  DROP INDEX idx_labels_organization_id_name,
+  DROP COLUMN organization_id,
+  ADD UNIQUE INDEX name (name);
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `tasks` DROP FOREIGN KEY fk_tasks_organization,
+  DROP INDEX idx_tasks_organization_id,
+  DROP COLUMN organization_id;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `users` DROP FOREIGN KEY fk_users_organization,
+  DROP INDEX idx_users_organization_id,
+  DROP COLUMN organization_id;
+-- +goose StatementEnd
+-- +goose StatementBegin
+DROP TABLE `organizations`;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS `task_work_sessions` (
+  id VARCHAR(36) NOT NULL PRIMARY KEY DEFAULT (UUID()),
+  task_id VARCHAR(36) NOT NULL,
+  user_id VARCHAR(36) NOT NULL,
+  started_at TIMESTAMP NOT NULL,
+  ended_at TIMESTAMP NULL,
+  note TEXT NULL,
+  manual BOOLEAN NOT NULL DEFAULT FALSE,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  INDEX idx_task_work_sessions_task_id_started_at (task_id, started_at),
+  INDEX idx_task_work_sessions_user_id_ended_at (user_id, ended_at),
+  INDEX idx_task_work_sessions_started_at (started_at),
+  CONSTRAINT fk_task_work_sessions_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+  CONSTRAINT fk_task_work_sessions_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `tasks`
+ADD COLUMN work_sessions_unlocked BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `tasks` DROP COLUMN work_sessions_unlocked;
+-- +goose StatementEnd
+-- +goose StatementBegin
+DROP TABLE `task_work_sessions`;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `tasks`
+ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `tasks` DROP COLUMN version;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,33 @@
+-- name: CreateAttachment :exec
+INSERT INTO task_attachments (
+    id,
+    task_id,
+    user_id,
+    file_name,
+    content_type,
+    size
+  )
+SELECT sqlc.arg(id),
+  tasks.id,
+  sqlc.arg(user_id),
+  sqlc.arg(file_name),
+  sqlc.arg(content_type),
+  sqlc.arg(size)
+FROM tasks
+WHERE tasks.id = sqlc.arg(task_id)
+  AND tasks.organization_id = sqlc.arg(organization_id);
+-- name: GetAttachmentByID :one
+SELECT task_attachments.*
+FROM task_attachments
+  JOIN tasks ON tasks.id = task_attachments.task_id
+WHERE task_attachments.id = ?
+  AND tasks.organization_id = ?
+LIMIT 1;
+-- name: ListAttachmentsByTaskID :many
+SELECT task_attachments.*
+FROM task_attachments
+  JOIN tasks ON tasks.id = task_attachments.task_id
+WHERE task_attachments.task_id = ?
+  AND tasks.organization_id = ?
+ORDER BY task_attachments.created_at,
+  task_attachments.id;
\ No newline at end of file
@@ -0,0 +1,24 @@
+-- name: GetCalendarFeedTokenByHash :one
+SELECT calendar_feed_tokens.*
+FROM calendar_feed_tokens
+  JOIN users ON users.id = calendar_feed_tokens.user_id
+WHERE calendar_feed_tokens.token_hash = ?
+  AND users.organization_id = ?
+LIMIT 1;
+-- name: UpsertCalendarFeedToken :exec
+INSERT INTO calendar_feed_tokens (user_id, token_hash)
+SELECT users.id,
+  sqlc.arg(token_hash)
+FROM users
+WHERE users.id = sqlc.arg(user_id)
+  AND users.organization_id = sqlc.arg(organization_id) ON DUPLICATE KEY
+UPDATE token_hash = VALUES(token_hash),
+  created_at = CURRENT_TIMESTAMP;
+-- name: DeleteCalendarFeedToken :exec
+DELETE FROM calendar_feed_tokens
+WHERE user_id = ?
+  AND user_id IN (
+    SELECT id
+    FROM users
+    WHERE organization_id = ?
+  );
\ No newline at end of file
@@ -0,0 +1,54 @@
+-- name: CreateChecklistItem :exec
+INSERT INTO task_checklist_items (id, task_id, position, title, required)
+SELECT sqlc.arg(id),
+  tasks.id,
+  sqlc.arg(position),
+  sqlc.arg(title),
+  sqlc.arg(required)
+FROM tasks
+WHERE tasks.id = sqlc.arg(task_id)
+  AND tasks.organization_id = sqlc.arg(organization_id);
+-- name: GetChecklistItemByID :one
+SELECT task_checklist_items.*
+FROM task_checklist_items
+  JOIN tasks ON tasks.id = task_checklist_items.task_id
+WHERE task_checklist_items.id = ?
+  AND tasks.organization_id = ?
+LIMIT 1;
+-- name: ListChecklistItemsByTaskID :many
+SELECT task_checklist_items.*
+FROM task_checklist_items
+  JOIN tasks ON tasks.id = task_checklist_items.task_id
+WHERE task_checklist_items.task_id = ?
+  AND tasks.organization_id = ?
+ORDER BY task_checklist_items.position,
+  task_checklist_items.id;
+-- name: UpdateChecklistItem :exec
+UPDATE task_checklist_items
+SET title = ?,
+  required = ?,
+  done = ?,
+  done_at = ?
+WHERE task_checklist_items.id = ?
+  AND task_checklist_items.task_id IN (
+    SELECT tasks.id
+    FROM tasks
+    WHERE tasks.organization_id = ?
+  );
+-- name: DeleteChecklistItem :exec
+DELETE FROM task_checklist_items
+WHERE task_checklist_items.id = ?
+  AND task_checklist_items.task_id IN (
+    SELECT tasks.id
+    FROM tasks
+    WHERE tasks.organization_id = ?
+  );
+-- name: CountChecklistItemsByTaskIDs :many
+SELECT task_checklist_items.task_id,
+  COUNT(*) AS total,
+  CAST(COALESCE(SUM(task_checklist_items.done), 0) AS SIGNED) AS done
+FROM task_checklist_items
+  JOIN tasks ON tasks.id = task_checklist_items.task_id
+WHERE task_checklist_items.task_id IN (sqlc.slice(task_ids))
+  AND tasks.organization_id = sqlc.arg(organization_id)
+GROUP BY task_checklist_items.task_id;
\ No newline at end of file
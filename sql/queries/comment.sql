@@ -0,0 +1,17 @@
+-- name: CreateComment :exec
+INSERT INTO task_comments (id, task_id, user_id, body)
+SELECT sqlc.arg(id),
+  tasks.id,
+  sqlc.arg(user_id),
+  sqlc.arg(body)
+FROM tasks
+WHERE tasks.id = sqlc.arg(task_id)
+  AND tasks.organization_id = sqlc.arg(organization_id);
+-- name: ListCommentsByTaskID :many
+SELECT task_comments.*
+FROM task_comments
+  JOIN tasks ON tasks.id = task_comments.task_id
+WHERE task_comments.task_id = ?
+  AND tasks.organization_id = ?
+ORDER BY task_comments.created_at,
+  task_comments.id;
\ No newline at end of file
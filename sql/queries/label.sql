@@ -0,0 +1,29 @@
+-- name: CreateLabel :exec
+INSERT INTO labels (id, organization_id, name)
+VALUES (?, ?, ?);
+-- name: GetLabelByID :one
+SELECT *
+FROM labels
+WHERE id = ?
+  AND organization_id = ?
+LIMIT 1;
+-- name: GetLabelByName :one
+SELECT *
+FROM labels
+WHERE name = ?
+  AND organization_id = ?
+LIMIT 1;
+-- name: ListLabels :many
+SELECT *
+FROM labels
+WHERE organization_id = ?
+ORDER BY name;
+-- name: UpdateLabel :exec
+UPDATE labels
+SET name = ?
+WHERE id = ?
+  AND organization_id = ?;
+-- name: DeleteLabel :exec
+DELETE FROM labels
+WHERE id = ?
+  AND organization_id = ?;
\ No newline at end of file
@@ -0,0 +1,29 @@
+-- name: CreateOrganization :exec
+INSERT INTO organizations (id, name)
+VALUES (?, ?);
+-- name: GetOrganizationByID :one
+SELECT *
+FROM organizations
+WHERE id = ?
+LIMIT 1;
+-- name: ListOrganizations :many
+SELECT *
+FROM organizations
+ORDER BY name,
+  id;
+-- name: GetOrganizationIDByUserID :one
+SELECT organization_id
+FROM users
+WHERE id = ?
+LIMIT 1;
+-- name: GetOrganizationIDByUserEmail :one
+SELECT organization_id
+FROM users
+WHERE email = ?
+LIMIT 1;
+-- name: GetOrganizationIDByCalendarFeedTokenHash :one
+SELECT users.organization_id
+FROM calendar_feed_tokens
+  JOIN users ON users.id = calendar_feed_tokens.user_id
+WHERE calendar_feed_tokens.token_hash = ?
+LIMIT 1;
\ No newline at end of file
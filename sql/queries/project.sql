@@ -0,0 +1,75 @@
+-- name: CreateProject :exec
+INSERT INTO projects (
+    id,
+    organization_id,
+    name,
+    description,
+    manager_user_id
+  )
+VALUES (?, ?, ?, ?, ?);
+-- name: GetProjectByID :one
+SELECT *
+FROM projects
+WHERE id = ?
+  AND organization_id = ?
+LIMIT 1;
+-- name: ListProjectsByMemberUserID :many
+SELECT projects.*
+FROM projects
+  JOIN project_members ON project_members.project_id = projects.id
+WHERE project_members.user_id = ?
+  AND projects.organization_id = ?
+ORDER BY projects.name,
+  projects.id;
+-- name: UpdateProject :exec
+UPDATE projects
+SET name = ?,
+  description = ?,
+  manager_user_id = ?
+WHERE id = ?
+  AND organization_id = ?;
+-- name: DeleteProject :exec
+DELETE FROM projects
+WHERE id = ?
+  AND organization_id = ?;
+-- name: ProjectHasTasks :one
+SELECT EXISTS (
+    SELECT 1
+    FROM tasks
+    WHERE project_id = ?
+      AND organization_id = ?
+  );
+-- name: AddProjectMember :exec
+INSERT IGNORE INTO project_members (project_id, user_id)
+SELECT projects.id,
+  users.id
+FROM projects
+  JOIN users ON users.organization_id = projects.organization_id
+WHERE projects.id = sqlc.arg(project_id)
+  AND users.id = sqlc.arg(user_id)
+  AND projects.organization_id = sqlc.arg(organization_id);
+-- name: RemoveProjectMember :exec
+DELETE FROM project_members
+WHERE project_id = ?
+  AND user_id = ?
+  AND project_id IN (
+    SELECT id
+    FROM projects
+    WHERE organization_id = ?
+  );
+-- name: ListProjectMembers :many
+SELECT project_members.*
+FROM project_members
+  JOIN projects ON projects.id = project_members.project_id
+WHERE project_members.project_id = ?
+  AND projects.organization_id = ?
+ORDER BY project_members.created_at,
+  project_members.user_id;
+-- name: GetProjectMember :one
+SELECT project_members.*
+FROM project_members
+  JOIN projects ON projects.id = project_members.project_id
+WHERE project_members.project_id = ?
+  AND project_members.user_id = ?
+  AND projects.organization_id = ?
+LIMIT 1;
\ No newline at end of file
@@ -0,0 +1,46 @@
+-- name: CreateRecurringTask :exec
+INSERT INTO recurring_tasks (
+    id,
+    organization_id,
+    summary,
+    schedule,
+    assigned_to_user_id,
+    created_by_user_id,
+    next_run_at
+  )
+VALUES (?, ?, ?, ?, ?, ?, ?);
+-- name: GetRecurringTaskByID :one
+SELECT *
+FROM recurring_tasks
+WHERE id = ?
+  AND organization_id = ?
+LIMIT 1;
+-- name: ListRecurringTasks :many
+SELECT *
+FROM recurring_tasks
+WHERE organization_id = ?
+ORDER BY created_at,
+  id;
+-- name: ListDueRecurringTasks :many
+SELECT *
+FROM recurring_tasks
+WHERE next_run_at <= ?
+  AND organization_id = ?
+ORDER BY next_run_at,
+  id;
+-- name: UpdateRecurringTaskNextRunAt :exec
+UPDATE recurring_tasks
+SET next_run_at = ?
+WHERE id = ?
+  AND organization_id = ?;
+-- name: DeleteRecurringTask :exec
+DELETE FROM recurring_tasks
+WHERE id = ?
+  AND organization_id = ?;
+-- name: ClaimRecurringTaskOccurrence :execrows
+INSERT IGNORE INTO recurring_task_occurrences (recurring_task_id, scheduled_at)
+SELECT recurring_tasks.id,
+  sqlc.arg(scheduled_at)
+FROM recurring_tasks
+WHERE recurring_tasks.id = sqlc.arg(recurring_task_id)
+  AND recurring_tasks.organization_id = sqlc.arg(organization_id);
\ No newline at end of file
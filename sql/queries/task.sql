@@ -2,23 +2,161 @@
 SELECT *
 FROM tasks
 WHERE id = ?
+  AND organization_id = ?
+  AND deleted_at IS NULL
 LIMIT 1;
--- name: ListTasks :many
-SELECT *
-FROM tasks;
--- name: ListTasksWithFilters :many
+-- name: GetDeletedTaskByID :one
 SELECT *
 FROM tasks
-WHERE assigned_to_user_id = ?;
+WHERE id = ?
+  AND organization_id = ?
+  AND deleted_at IS NOT NULL
+LIMIT 1;
 -- name: CreateTask :exec
-INSERT INTO tasks (summary, created_by_user_id, assigned_to_user_id)
-VALUES (?, ?, ?);
--- name: UpdateTask :exec
+INSERT INTO tasks (
+    id,
+    organization_id,
+    summary,
+    created_by_user_id,
+    due_at,
+    priority,
+    finish_policy,
+    project_id
+  )
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+-- name: UpdateTask :execrows
 UPDATE tasks
-SET summary = ?,
-  assigned_to_user_id = ?,
-  finished_at = ?
-WHERE id = ?;
+SET summary = sqlc.arg(summary),
+  status = sqlc.arg(status),
+  finished_at = sqlc.arg(finished_at),
+  reopen_reason = sqlc.arg(reopen_reason),
+  reopened_at = sqlc.arg(reopened_at),
+  overdue_notified_at = IF(
+    due_at <=> sqlc.arg(due_at),
+    overdue_notified_at,
+    NULL
+  ),
+  due_at = sqlc.arg(due_at),
+  priority = sqlc.arg(priority),
+  finish_policy = sqlc.arg(finish_policy),
+  project_id = sqlc.arg(project_id),
+  version = version + 1
+WHERE id = sqlc.arg(id)
+  AND organization_id = sqlc.arg(organization_id)
+  AND version = sqlc.arg(version)
+  AND deleted_at IS NULL;
 -- name: DeleteTask :exec
+UPDATE tasks
+SET deleted_at = CURRENT_TIMESTAMP,
+  version = version + 1
+WHERE id = ?
+  AND organization_id = ?
+  AND deleted_at IS NULL;
+-- name: RestoreTask :exec
+UPDATE tasks
+SET deleted_at = NULL,
+  version = version + 1
+WHERE id = ?
+  AND organization_id = ?
+  AND deleted_at IS NOT NULL;
+-- name: PurgeDeletedTasks :execrows
 DELETE FROM tasks
-WHERE id = ?;
\ No newline at end of file
+WHERE deleted_at IS NOT NULL
+  AND deleted_at < ?
+  AND organization_id = ?;
+-- name: DeleteTaskLabels :exec
+DELETE FROM task_labels
+WHERE task_id = ?
+  AND task_id IN (
+    SELECT id
+    FROM tasks
+    WHERE organization_id = ?
+  );
+-- name: CreateTaskLabel :exec
+INSERT INTO task_labels (task_id, label_id)
+SELECT tasks.id,
+  labels.id
+FROM tasks
+  JOIN labels ON labels.organization_id = tasks.organization_id
+WHERE tasks.id = sqlc.arg(task_id)
+  AND labels.id = sqlc.arg(label_id)
+  AND tasks.organization_id = sqlc.arg(organization_id);
+-- name: ListTaskLabelsByTaskIDs :many
+SELECT task_labels.*
+FROM task_labels
+  JOIN tasks ON tasks.id = task_labels.task_id
+WHERE task_labels.task_id IN (sqlc.slice(task_ids))
+  AND tasks.organization_id = sqlc.arg(organization_id);
+-- name: ListOverdueTasksToNotify :many
+SELECT *
+FROM tasks
+WHERE deleted_at IS NULL
+  AND status <> 'done'
+  AND due_at < ?
+  AND overdue_notified_at IS NULL
+  AND organization_id = ?
+ORDER BY due_at,
+  id
+LIMIT ?;
+-- name: MarkTaskOverdueNotified :execrows
+UPDATE tasks
+SET overdue_notified_at = ?,
+  updated_at = updated_at
+WHERE id = ?
+  AND organization_id = ?
+  AND overdue_notified_at IS NULL;
+-- name: ListTaskAssigneesByTaskIDs :many
+SELECT task_assignees.*
+FROM task_assignees
+  JOIN tasks ON tasks.id = task_assignees.task_id
+WHERE task_assignees.task_id IN (sqlc.slice(task_ids))
+  AND tasks.organization_id = sqlc.arg(organization_id)
+ORDER BY task_assignees.task_id,
+  task_assignees.position;
+-- name: UpsertTaskAssignee :exec
+INSERT INTO task_assignees (task_id, user_id, position)
+SELECT tasks.id,
+  users.id,
+  sqlc.arg(position)
+FROM tasks
+  JOIN users ON users.organization_id = tasks.organization_id
+WHERE tasks.id = sqlc.arg(task_id)
+  AND users.id = sqlc.arg(user_id)
+  AND tasks.organization_id = sqlc.arg(organization_id) ON DUPLICATE KEY
+UPDATE position = VALUES(position);
+-- name: DeleteTaskAssignee :exec
+DELETE FROM task_assignees
+WHERE task_id = ?
+  AND user_id = ?
+  AND task_id IN (
+    SELECT id
+    FROM tasks
+    WHERE organization_id = ?
+  );
+-- name: SignOffTask :execrows
+UPDATE task_assignees
+SET signed_off_at = ?
+WHERE task_id = ?
+  AND user_id = ?
+  AND signed_off_at IS NULL
+  AND task_id IN (
+    SELECT id
+    FROM tasks
+    WHERE organization_id = ?
+  );
+-- name: ClearTaskSignOffs :exec
+UPDATE task_assignees
+SET signed_off_at = NULL
+WHERE task_id = ?
+  AND task_id IN (
+    SELECT id
+    FROM tasks
+    WHERE organization_id = ?
+  );
+-- name: SetTaskWorkSessionsUnlocked :exec
+UPDATE tasks
+SET work_sessions_unlocked = ?,
+  version = version + 1
+WHERE id = ?
+  AND organization_id = ?
+  AND deleted_at IS NULL;
\ No newline at end of file
@@ -0,0 +1,34 @@
+-- name: CreateTaskDependency :exec
+INSERT IGNORE INTO task_dependencies (task_id, blocked_by_task_id)
+SELECT tasks.id,
+  blocked_by_tasks.id
+FROM tasks
+  JOIN tasks AS blocked_by_tasks ON blocked_by_tasks.organization_id = tasks.organization_id
+WHERE tasks.id = sqlc.arg(task_id)
+  AND blocked_by_tasks.id = sqlc.arg(blocked_by_task_id)
+  AND tasks.organization_id = sqlc.arg(organization_id);
+-- name: DeleteTaskDependency :exec
+DELETE FROM task_dependencies
+WHERE task_id = ?
+  AND blocked_by_task_id = ?
+  AND task_id IN (
+    SELECT id
+    FROM tasks
+    WHERE organization_id = ?
+  );
+-- name: ListTaskDependenciesByTaskID :many
+SELECT task_dependencies.*
+FROM task_dependencies
+  JOIN tasks ON tasks.id = task_dependencies.task_id
+WHERE task_dependencies.task_id = ?
+  AND tasks.organization_id = ?
+ORDER BY task_dependencies.created_at,
+  task_dependencies.blocked_by_task_id;
+-- name: ListTaskDependenciesByBlockedByTaskID :many
+SELECT task_dependencies.*
+FROM task_dependencies
+  JOIN tasks ON tasks.id = task_dependencies.task_id
+WHERE task_dependencies.blocked_by_task_id = ?
+  AND tasks.organization_id = ?
+ORDER BY task_dependencies.created_at,
+  task_dependencies.task_id;
\ No newline at end of file
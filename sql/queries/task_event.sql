@@ -0,0 +1,17 @@
+-- name: CreateTaskEvent :exec
+INSERT INTO task_events (task_id, actor_user_id, type, changes)
+SELECT tasks.id,
+  sqlc.arg(actor_user_id),
+  sqlc.arg(type),
+  sqlc.arg(changes)
+FROM tasks
+WHERE tasks.id = sqlc.arg(task_id)
+  AND tasks.organization_id = sqlc.arg(organization_id);
+-- name: ListTaskEventsByTaskID :many
+SELECT task_events.*
+FROM task_events
+  JOIN tasks ON tasks.id = task_events.task_id
+WHERE task_events.task_id = ?
+  AND tasks.organization_id = ?
+ORDER BY task_events.created_at,
+  task_events.id;
\ No newline at end of file
@@ -2,12 +2,14 @@
 SELECT *
 FROM users
 WHERE id = ?
+  AND organization_id = ?
 LIMIT 1;
 -- name: GetUserByEmail :one
 SELECT *
 FROM users
 WHERE email = ?
+  AND organization_id = ?
 LIMIT 1;
 -- name: CreateUser :exec
-INSERT INTO users (role, name, email, password)
-VALUES (?, ?, ?, ?);
\ No newline at end of file
+INSERT INTO users (organization_id, role, name, email, password)
+VALUES (?, ?, ?, ?, ?);
\ No newline at end of file
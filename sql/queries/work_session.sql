@@ -0,0 +1,91 @@
+-- name: CreateWorkSession :exec
+INSERT INTO task_work_sessions (
+    id,
+    task_id,
+    user_id,
+    started_at,
+    ended_at,
+    note,
+    manual
+  )
+SELECT sqlc.arg(id),
+  tasks.id,
+  users.id,
+  sqlc.arg(started_at),
+  sqlc.arg(ended_at),
+  sqlc.arg(note),
+  sqlc.arg(manual)
+FROM tasks
+  JOIN users ON users.organization_id = tasks.organization_id
+WHERE tasks.id = sqlc.arg(task_id)
+  AND users.id = sqlc.arg(user_id)
+  AND tasks.organization_id = sqlc.arg(organization_id);
+-- name: GetWorkSessionByID :one
+SELECT task_work_sessions.*
+FROM task_work_sessions
+  JOIN tasks ON tasks.id = task_work_sessions.task_id
+WHERE task_work_sessions.id = ?
+  AND tasks.organization_id = ?
+LIMIT 1;
+-- name: GetRunningWorkSessionByUserID :one
+SELECT task_work_sessions.*
+FROM task_work_sessions
+  JOIN tasks ON tasks.id = task_work_sessions.task_id
+WHERE task_work_sessions.user_id = ?
+  AND task_work_sessions.ended_at IS NULL
+  AND tasks.organization_id = ?
+LIMIT 1;
+-- name: ListWorkSessionsByTaskID :many
+SELECT task_work_sessions.*
+FROM task_work_sessions
+  JOIN tasks ON tasks.id = task_work_sessions.task_id
+WHERE task_work_sessions.task_id = ?
+  AND tasks.organization_id = ?
+ORDER BY task_work_sessions.started_at,
+  task_work_sessions.id;
+-- name: UpdateWorkSession :exec
+UPDATE task_work_sessions
+SET started_at = ?,
+  ended_at = ?,
+  note = ?
+WHERE task_work_sessions.id = ?
+  AND task_work_sessions.task_id IN (
+    SELECT tasks.id
+    FROM tasks
+    WHERE tasks.organization_id = ?
+  );
+-- name: DeleteWorkSession :exec
+DELETE FROM task_work_sessions
+WHERE task_work_sessions.id = ?
+  AND task_work_sessions.task_id IN (
+    SELECT tasks.id
+    FROM tasks
+    WHERE tasks.organization_id = ?
+  );
+-- name: SumWorkSessionsByUserID :many
+SELECT task_work_sessions.user_id,
+  CAST(
+    COALESCE(
+      SUM(
+        TIMESTAMPDIFF(
+          SECOND,
+          task_work_sessions.started_at,
+          COALESCE(task_work_sessions.ended_at, sqlc.arg(now))
+        )
+      ),
+      0
+    ) AS SIGNED
+  ) AS seconds
+FROM task_work_sessions
+  JOIN tasks ON tasks.id = task_work_sessions.task_id
+WHERE tasks.organization_id = sqlc.arg(organization_id)
+  AND tasks.deleted_at IS NULL
+  AND task_work_sessions.started_at >= sqlc.arg(started_from)
+  AND task_work_sessions.started_at < sqlc.arg(started_to)
+  AND (
+    sqlc.arg(project_scoped) = FALSE
+    OR tasks.project_id IS NULL
+    OR tasks.project_id IN (sqlc.slice(scope_project_ids))
+  )
+GROUP BY task_work_sessions.user_id
+ORDER BY task_work_sessions.user_id;
\ No newline at end of file